@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/internal/serviceaccount"
+	"github.com/odpf/shield/internal/user"
+	"github.com/odpf/shield/model"
+	shieldError "github.com/odpf/shield/utils/errors"
+)
+
+// AdminCheckService tells if the caller of an admin endpoint, the user in
+// identity header or the service account of the api key, manages an
+// organization
+type AdminCheckService interface {
+	CheckOrgAdmin(ctx context.Context, orgId string) (bool, error)
+}
+
+// APIKeyAuthenticator resolves the service account of an api key
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, rawKey string) (model.ServiceAccount, error)
+}
+
+// authorizeAdmin replies with an error and returns false unless the caller
+// is an admin of the organization
+func authorizeAdmin(ctx context.Context, w http.ResponseWriter, admins AdminCheckService, orgId string) bool {
+	allowed, err := admins.CheckOrgAdmin(ctx, orgId)
+	switch {
+	case errors.Is(err, user.UserDoesntExist):
+		writeJSONError(w, http.StatusUnauthorized, err.Error())
+	case err != nil:
+		writeJSONError(w, http.StatusInternalServerError, "internal server error")
+	case !allowed:
+		writeJSONError(w, http.StatusForbidden, shieldError.Unauthorzied.Error())
+	default:
+		return true
+	}
+	return false
+}
+
+// subjectContext sets who the request is made by, the user in identity header
+// or the service account of the api key, as admin handlers are not behind
+// the grpc interceptors
+func subjectContext(r *http.Request, identityProxyHeader string, authenticator APIKeyAuthenticator) (context.Context, error) {
+	ctx := permission.SetEmailToContext(r.Context(), r.Header.Get(identityProxyHeader))
+	rawKey := r.Header.Get(serviceaccount.APIKeyHeader)
+	if rawKey == "" || authenticator == nil {
+		return ctx, nil
+	}
+
+	serviceAccount, err := authenticator.Authenticate(ctx, rawKey)
+	if err != nil {
+		return nil, err
+	}
+	return permission.SetServiceAccountToContext(ctx, serviceAccount), nil
+}
+
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{"message": message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -7,10 +7,23 @@ import (
 
 	"github.com/odpf/salt/server"
 	"github.com/odpf/shield/api/handler/v1beta1"
+	"github.com/odpf/shield/internal/ratelimit"
+	"github.com/odpf/shield/metrics"
 )
 
 type Deps struct {
 	V1beta1 v1beta1.Dep
+
+	// ConfigRepositories are rules and resource configs keyed by name which
+	// can be reloaded through admin api
+	ConfigRepositories map[string]ConfigRepository
+
+	// RateLimitStore shares rate limit buckets between shield instances
+	RateLimitStore ratelimit.Store
+
+	// ConfigAdminOrgId is the organization whose admins can reload configs
+	// and read their status
+	ConfigAdminOrgId string
 }
 
 func Register(ctx context.Context, s *server.MuxServer, gw *server.GRPCGateway, deps Deps) {
@@ -18,6 +31,19 @@ func Register(ctx context.Context, s *server.MuxServer, gw *server.GRPCGateway,
 		fmt.Fprintf(w, "pong")
 	}))
 
+	// scraped by prometheus, load status of config repositories is exported
+	// by the collector registered with NewConfigCollector
+	s.RegisterHandler("/metrics", metrics.Handler())
+
+	configAdmins := configAdmin{
+		admins:              deps.V1beta1.AdminCheckService,
+		adminOrgId:          deps.ConfigAdminOrgId,
+		identityProxyHeader: deps.V1beta1.IdentityProxyHeader,
+		authenticator:       deps.V1beta1.ServiceAccountService,
+	}
+	s.RegisterHandler("/admin/v1beta1/rules:reload", reloadConfigsHandler(deps.ConfigRepositories, configAdmins))
+	s.RegisterHandler("/admin/v1beta1/rules:status", configStatusHandler(deps.ConfigRepositories, configAdmins))
+
 	// grpc gateway api will have version endpoints
 	s.SetGateway("/admin", gw)
 	v1beta1.RegisterV1(ctx, s, gw, deps.V1beta1)
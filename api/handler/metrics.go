@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	configLoadsDesc = prometheus.NewDesc(
+		"shield_config_loads_total",
+		"Successful refreshes of a config cache.",
+		[]string{"config"}, nil,
+	)
+	configFailuresDesc = prometheus.NewDesc(
+		"shield_config_load_failures_total",
+		"Failed refreshes of a config cache.",
+		[]string{"config"}, nil,
+	)
+	configAgeDesc = prometheus.NewDesc(
+		"shield_config_age_seconds",
+		"Seconds since a config cache was last refreshed.",
+		[]string{"config"}, nil,
+	)
+)
+
+// configCollector exports load status of config repositories, it's read
+// when metrics are scraped
+type configCollector struct {
+	repositories map[string]ConfigRepository
+}
+
+// NewConfigCollector returns the collector of load status of the config
+// repositories, to be registered once per process
+func NewConfigCollector(repositories map[string]ConfigRepository) prometheus.Collector {
+	return configCollector{repositories: repositories}
+}
+
+func (c configCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- configLoadsDesc
+	ch <- configFailuresDesc
+	ch <- configAgeDesc
+}
+
+func (c configCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, repo := range c.repositories {
+		status := repo.Status()
+		ch <- prometheus.MustNewConstMetric(configLoadsDesc, prometheus.CounterValue, float64(status.Loads), name)
+		ch <- prometheus.MustNewConstMetric(configFailuresDesc, prometheus.CounterValue, float64(status.Failures), name)
+		if !status.LoadedAt.IsZero() {
+			ch <- prometheus.MustNewConstMetric(configAgeDesc, prometheus.GaugeValue, time.Since(status.LoadedAt).Seconds(), name)
+		}
+	}
+}
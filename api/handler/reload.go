@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	blobstore "github.com/odpf/shield/store/blob"
+)
+
+// ConfigRepository is a config cache which can be reloaded on demand,
+// e.g. rules of a proxy service or resource configs
+type ConfigRepository interface {
+	Reload(ctx context.Context) error
+	Status() blobstore.LoadStatus
+}
+
+type configStatus struct {
+	Name string `json:"name"`
+	blobstore.LoadStatus
+}
+
+type configStatusResponse struct {
+	Configs []configStatus `json:"configs"`
+}
+
+// configAdmin authorizes callers of config endpoints, configs are shared by
+// all organizations so only admins of adminOrgId are allowed
+type configAdmin struct {
+	admins              AdminCheckService
+	adminOrgId          string
+	identityProxyHeader string
+	authenticator       APIKeyAuthenticator
+}
+
+// authorize replies with an error and returns false unless the caller is an
+// admin of the config admin organization
+func (c configAdmin) authorize(w http.ResponseWriter, r *http.Request) bool {
+	if c.adminOrgId == "" {
+		writeJSONError(w, http.StatusForbidden, "config_admin_org is not configured")
+		return false
+	}
+	ctx, err := subjectContext(r, c.identityProxyHeader, c.authenticator)
+	if err != nil {
+		writeJSONError(w, http.StatusUnauthorized, "invalid api key")
+		return false
+	}
+	return authorizeAdmin(ctx, w, c.admins, c.adminOrgId)
+}
+
+// reloadConfigsHandler reloads all config repositories, or only the one
+// passed in `name` query param
+func reloadConfigsHandler(repositories map[string]ConfigRepository, admin configAdmin) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if !admin.authorize(w, r) {
+			return
+		}
+
+		name := r.URL.Query().Get("name")
+		if _, ok := repositories[name]; name != "" && !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		status := http.StatusOK
+		for repoName, repo := range repositories {
+			if name != "" && repoName != name {
+				continue
+			}
+			if err := repo.Reload(r.Context()); err != nil {
+				status = http.StatusInternalServerError
+			}
+		}
+		writeConfigStatus(w, status, repositories)
+	})
+}
+
+func configStatusHandler(repositories map[string]ConfigRepository, admin configAdmin) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if !admin.authorize(w, r) {
+			return
+		}
+		writeConfigStatus(w, http.StatusOK, repositories)
+	})
+}
+
+func writeConfigStatus(w http.ResponseWriter, status int, repositories map[string]ConfigRepository) {
+	response := configStatusResponse{Configs: []configStatus{}}
+	for name, repo := range repositories {
+		response.Configs = append(response.Configs, configStatus{Name: name, LoadStatus: repo.Status()})
+	}
+	sort.Slice(response.Configs, func(i, j int) bool {
+		return response.Configs[i].Name < response.Configs[j].Name
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,67 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"github.com/odpf/shield/internal/user"
+	"github.com/odpf/shield/model"
+	shieldError "github.com/odpf/shield/utils/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminCheckService tells if the caller, the user in identity header or the
+// service account of the api key, manages an organization or project
+type AdminCheckService interface {
+	CheckOrgAdmin(ctx context.Context, orgId string) (bool, error)
+	CheckProjectAdmin(ctx context.Context, projectId string) (bool, error)
+	CheckResourceAdmin(ctx context.Context, resource model.Resource) (bool, error)
+}
+
+var grpcPermissionDeniedError = status.Error(codes.PermissionDenied, shieldError.Unauthorzied.Error())
+
+// authorizeAdmin returns an error unless the caller is an admin of the
+// organization, or of the project if projectId is set
+func (v Dep) authorizeAdmin(ctx context.Context, orgId, projectId string) error {
+	allowed, err := v.isAdmin(ctx, orgId, projectId)
+	return adminCheckError(ctx, allowed, err)
+}
+
+// authorizeResourceAdmin returns an error unless the caller is an admin of
+// the organization or project of the resource, or has every action on it
+func (v Dep) authorizeResourceAdmin(ctx context.Context, resource model.Resource) error {
+	allowed, err := v.isAdmin(ctx, resource.OrganizationId, resource.ProjectId)
+	if err == nil && !allowed {
+		allowed, err = v.AdminCheckService.CheckResourceAdmin(ctx, resource)
+	}
+	return adminCheckError(ctx, allowed, err)
+}
+
+func (v Dep) isAdmin(ctx context.Context, orgId, projectId string) (bool, error) {
+	if orgId != "" {
+		allowed, err := v.AdminCheckService.CheckOrgAdmin(ctx, orgId)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+	if projectId == "" {
+		return false, nil
+	}
+	return v.AdminCheckService.CheckProjectAdmin(ctx, projectId)
+}
+
+func adminCheckError(ctx context.Context, allowed bool, err error) error {
+	switch {
+	case errors.Is(err, user.UserDoesntExist):
+		return status.Errorf(codes.Unauthenticated, err.Error())
+	case err != nil:
+		grpczap.Extract(ctx).Error(err.Error())
+		return grpcInternalServerError
+	case !allowed:
+		return grpcPermissionDeniedError
+	default:
+		return nil
+	}
+}
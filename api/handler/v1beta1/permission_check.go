@@ -2,10 +2,16 @@ package v1beta1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/odpf/shield/internal/authz/local"
+	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/internal/resource"
+	"github.com/odpf/shield/internal/user"
 	"github.com/odpf/shield/model"
 	shieldv1beta1 "github.com/odpf/shield/proto/v1beta1"
+	"github.com/odpf/shield/utils"
 
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"google.golang.org/grpc/codes"
@@ -18,8 +24,14 @@ var (
 	internalServerErr        = fmt.Errorf("internal server error")
 )
 
+// maxPermissionChecks caps the size of a batch, a page of a listing checks a
+// few hundred items at most
+const maxPermissionChecks = 1000
+
 type PermissionCheckService interface {
 	CheckAuthz(ctx context.Context, resource model.Resource, action model.Action) (bool, error)
+	CheckAuthzBatch(ctx context.Context, checks []permission.ResourceAction) ([]bool, error)
+	ExplainAuthz(ctx context.Context, resource model.Resource, action model.Action, userId string) (*model.Explanation, error)
 }
 
 func (v Dep) CheckResourcePermission(ctx context.Context, in *shieldv1beta1.ResourceActionAuthzRequest) (*shieldv1beta1.ResourceActionAuthzResponse, error) {
@@ -47,6 +59,115 @@ func (v Dep) CheckResourcePermission(ctx context.Context, in *shieldv1beta1.Reso
 	return &shieldv1beta1.ResourceActionAuthzResponse{Status: "OK"}, nil
 }
 
+// CheckResourcePermissions is the batch variant of CheckResourcePermission,
+// it checks permissions of the caller on many resources at once
+func (v Dep) CheckResourcePermissions(ctx context.Context, in *shieldv1beta1.CheckResourcePermissionsRequest) (*shieldv1beta1.CheckResourcePermissionsResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if len(in.GetChecks()) > maxPermissionChecks {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d checks are allowed", maxPermissionChecks)
+	}
+
+	checks := make([]permission.ResourceAction, len(in.GetChecks()))
+	for i, c := range in.GetChecks() {
+		if c.GetResourceId() == "" || c.GetNamespaceId() == "" || c.GetActionId() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "checks[%d]: resource_id, namespace_id and action_id are required", i)
+		}
+		checks[i] = permission.ResourceAction{
+			Resource: model.Resource{Name: c.GetResourceId(), NamespaceId: c.GetNamespaceId()},
+			Action:   model.Action{Id: c.GetActionId()},
+		}
+	}
+
+	allowed, err := v.PermissionCheckService.CheckAuthzBatch(ctx, checks)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil, status.Errorf(codes.Canceled, err.Error())
+		}
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	results := make([]*shieldv1beta1.PermissionCheckResult, len(in.GetChecks()))
+	for i, c := range in.GetChecks() {
+		results[i] = &shieldv1beta1.PermissionCheckResult{
+			ResourceId:  c.GetResourceId(),
+			NamespaceId: c.GetNamespaceId(),
+			ActionId:    c.GetActionId(),
+			Allowed:     allowed[i],
+		}
+	}
+
+	return &shieldv1beta1.CheckResourcePermissionsResponse{Results: results}, nil
+}
+
+// ExplainResourcePermission returns the tree of permissions and relations
+// evaluated for a single resource and action, to debug denied requests.
+// Explaining it for another user is left to admins of the organization or
+// project of the resource.
+func (v Dep) ExplainResourcePermission(ctx context.Context, in *shieldv1beta1.ExplainResourcePermissionRequest) (*shieldv1beta1.ExplainResourcePermissionResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if in.GetResourceId() == "" || in.GetNamespaceId() == "" || in.GetActionId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "resource_id, namespace_id and action_id are required")
+	}
+
+	target := model.Resource{
+		Name:        in.GetResourceId(),
+		NamespaceId: in.GetNamespaceId(),
+	}
+	if in.GetUserId() != "" {
+		fetchedResource, err := v.ResourceService.Get(ctx, utils.CreateResourceId(target))
+		if err != nil {
+			logger.Error(err.Error())
+			if errors.Is(err, resource.ResourceDoesntExist) {
+				return nil, grpcResourceNotFoundErr
+			}
+			return nil, grpcInternalServerError
+		}
+		if err := v.authorizeAdmin(ctx, fetchedResource.OrganizationId, fetchedResource.ProjectId); err != nil {
+			return nil, err
+		}
+	}
+
+	explanation, err := v.PermissionCheckService.ExplainAuthz(ctx, target, model.Action{Id: in.GetActionId()}, in.GetUserId())
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, local.ErrUnknownNamespace):
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		case errors.Is(err, user.UserDoesntExist):
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	return &shieldv1beta1.ExplainResourcePermissionResponse{
+		Allowed:     explanation.Allowed,
+		Explanation: transformExplanationToPB(explanation),
+	}, nil
+}
+
+func transformExplanationToPB(from *model.Explanation) *shieldv1beta1.PermissionExplanation {
+	if from == nil {
+		return nil
+	}
+
+	var children []*shieldv1beta1.PermissionExplanation
+	for _, child := range from.Children {
+		children = append(children, transformExplanationToPB(child))
+	}
+
+	return &shieldv1beta1.PermissionExplanation{
+		Kind:       from.Kind,
+		Object:     from.Object,
+		Name:       from.Name,
+		Expression: from.Expression,
+		Allowed:    from.Allowed,
+		Children:   children,
+		Mismatch:   from.Mismatch,
+	}
+}
+
 func getValidationErrorMessage(err error) error {
 	consolidateInvalidFields := ""
 	for _, validationErr := range err.(shieldv1beta1.ResourceActionAuthzRequestMultiError) {
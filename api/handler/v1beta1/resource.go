@@ -7,8 +7,10 @@ import (
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 	"github.com/odpf/shield/internal/relation"
 	"github.com/odpf/shield/internal/resource"
+	"github.com/odpf/shield/internal/user"
 	"github.com/odpf/shield/model"
 	shieldv1beta1 "github.com/odpf/shield/proto/v1beta1"
+	"github.com/odpf/shield/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -19,6 +21,8 @@ type ResourceService interface {
 	List(ctx context.Context) ([]model.Resource, error)
 	Create(ctx context.Context, resource model.Resource) (model.Resource, error)
 	Update(ctx context.Context, id string, resource model.Resource) (model.Resource, error)
+	Delete(ctx context.Context, id string) error
+	ListAuthorized(ctx context.Context, filter resource.AuthorizedFilter) ([]model.Resource, string, error)
 }
 
 var grpcResourceNotFoundErr = status.Errorf(codes.NotFound, "resource doesn't exist")
@@ -147,6 +151,98 @@ func (v Dep) UpdateResource(ctx context.Context, request *shieldv1beta1.UpdateRe
 	}, nil
 }
 
+// DeleteResource deletes a resource along with every relation it is the
+// object or subject of, callers have to be admins of the resource or of its
+// organization or project
+func (v Dep) DeleteResource(ctx context.Context, request *shieldv1beta1.DeleteResourceRequest) (*shieldv1beta1.DeleteResourceResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	id := request.GetId()
+	if id == "" && request.GetNamespaceId() != "" && request.GetName() != "" {
+		id = utils.CreateResourceId(model.Resource{Name: request.GetName(), NamespaceId: request.GetNamespaceId()})
+	}
+	if id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "id or namespace_id and name are required")
+	}
+
+	fetchedResource, err := v.ResourceService.Get(ctx, id)
+	if err == nil {
+		if err := v.authorizeResourceAdmin(ctx, fetchedResource); err != nil {
+			return nil, err
+		}
+		err = v.ResourceService.Delete(ctx, id)
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, resource.ResourceDoesntExist):
+			return nil, grpcResourceNotFoundErr
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	return &shieldv1beta1.DeleteResourceResponse{}, nil
+}
+
+// ListAuthorizedResources lists resources of a namespace on which the caller
+// has the action. Admins of the organization in org_id can list resources of
+// the organization for the user in user_id.
+func (v Dep) ListAuthorizedResources(ctx context.Context, request *shieldv1beta1.ListAuthorizedResourcesRequest) (*shieldv1beta1.ListAuthorizedResourcesResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if request.GetNamespaceId() == "" || request.GetActionId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "namespace_id and action_id are required")
+	}
+	if request.GetUserId() != "" && request.GetOrgId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "org_id is required with user_id")
+	}
+	if request.GetPageSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must be a positive number")
+	}
+	if request.GetUserId() != "" {
+		if err := v.authorizeAdmin(ctx, request.GetOrgId(), ""); err != nil {
+			return nil, err
+		}
+	}
+
+	resourcesList, nextPageToken, err := v.ResourceService.ListAuthorized(ctx, resource.AuthorizedFilter{
+		NamespaceId:    request.GetNamespaceId(),
+		ActionId:       request.GetActionId(),
+		UserId:         request.GetUserId(),
+		OrganizationId: request.GetOrgId(),
+		PageSize:       int(request.GetPageSize()),
+		PageToken:      request.GetPageToken(),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		switch {
+		case errors.Is(err, resource.InvalidPageToken):
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		case errors.Is(err, user.UserDoesntExist):
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		default:
+			return nil, grpcInternalServerError
+		}
+	}
+
+	var resources []*shieldv1beta1.Resource
+	for _, r := range resourcesList {
+		resourcePB, err := transformResourceToPB(r)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, grpcInternalServerError
+		}
+
+		resources = append(resources, &resourcePB)
+	}
+
+	return &shieldv1beta1.ListAuthorizedResourcesResponse{
+		Resources:     resources,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 func transformResourceToPB(from model.Resource) (shieldv1beta1.Resource, error) {
 	namespace, err := transformNamespaceToPB(from.Namespace)
 	if err != nil {
@@ -0,0 +1,156 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/odpf/shield/internal/resource"
+	"github.com/odpf/shield/model"
+
+	shieldv1beta1 "github.com/odpf/shield/proto/v1beta1"
+)
+
+var testResource = model.Resource{
+	Id:             "r/library_book/relativity",
+	Name:           "relativity",
+	NamespaceId:    "library_book",
+	OrganizationId: "9f256f86-31a3-11ec-8d3d-0242ac130003",
+	ProjectId:      testProjectID,
+}
+
+func TestDeleteResource(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title           string
+		mockResourceSrv mockResourceSrv
+		mockAdminSrv    mockAdminCheckSrv
+		req             *shieldv1beta1.DeleteResourceRequest
+		deleted         string
+		err             error
+	}{
+		{
+			title: "missing id",
+			req:   &shieldv1beta1.DeleteResourceRequest{NamespaceId: "library_book"},
+			err:   status.Errorf(codes.InvalidArgument, "id or namespace_id and name are required"),
+		},
+		{
+			title: "resource doesn't exist",
+			req:   &shieldv1beta1.DeleteResourceRequest{Id: testResource.Id},
+			mockResourceSrv: mockResourceSrv{GetFunc: func(ctx context.Context, id string) (model.Resource, error) {
+				return model.Resource{}, resource.ResourceDoesntExist
+			}},
+			err: grpcResourceNotFoundErr,
+		},
+		{
+			title: "caller isn't an admin",
+			req:   &shieldv1beta1.DeleteResourceRequest{Id: testResource.Id},
+			mockResourceSrv: mockResourceSrv{GetFunc: func(ctx context.Context, id string) (model.Resource, error) {
+				return testResource, nil
+			}},
+			err: grpcPermissionDeniedError,
+		},
+		{
+			title: "error in admin check",
+			req:   &shieldv1beta1.DeleteResourceRequest{Id: testResource.Id},
+			mockResourceSrv: mockResourceSrv{GetFunc: func(ctx context.Context, id string) (model.Resource, error) {
+				return testResource, nil
+			}},
+			mockAdminSrv: mockAdminCheckSrv{OrgAdminErr: errors.New("some service error")},
+			err:          grpcInternalServerError,
+		},
+		{
+			title: "project admin deletes resource named by namespace and name",
+			req:   &shieldv1beta1.DeleteResourceRequest{NamespaceId: "library_book", Name: "relativity"},
+			mockResourceSrv: mockResourceSrv{GetFunc: func(ctx context.Context, id string) (model.Resource, error) {
+				return testResource, nil
+			}},
+			mockAdminSrv: mockAdminCheckSrv{ProjectAdmin: true},
+			deleted:      testResource.Id,
+		},
+		{
+			title: "resource admin deletes resource",
+			req:   &shieldv1beta1.DeleteResourceRequest{Id: testResource.Id},
+			mockResourceSrv: mockResourceSrv{GetFunc: func(ctx context.Context, id string) (model.Resource, error) {
+				return testResource, nil
+			}},
+			mockAdminSrv: mockAdminCheckSrv{ResourceAdmin: true},
+			deleted:      testResource.Id,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			var deleted string
+			tt.mockResourceSrv.DeleteFunc = func(ctx context.Context, id string) error {
+				deleted = id
+				return nil
+			}
+
+			mockDep := Dep{ResourceService: tt.mockResourceSrv, AdminCheckService: tt.mockAdminSrv}
+			resp, err := mockDep.DeleteResource(context.Background(), tt.req)
+			assert.EqualValues(t, tt.err, err)
+			assert.Equal(t, tt.deleted, deleted)
+			if tt.err == nil {
+				assert.EqualValues(t, &shieldv1beta1.DeleteResourceResponse{}, resp)
+			}
+		})
+	}
+}
+
+type mockResourceSrv struct {
+	GetFunc            func(ctx context.Context, id string) (model.Resource, error)
+	ListFunc           func(ctx context.Context) ([]model.Resource, error)
+	CreateFunc         func(ctx context.Context, resource model.Resource) (model.Resource, error)
+	UpdateFunc         func(ctx context.Context, id string, resource model.Resource) (model.Resource, error)
+	DeleteFunc         func(ctx context.Context, id string) error
+	ListAuthorizedFunc func(ctx context.Context, filter resource.AuthorizedFilter) ([]model.Resource, string, error)
+}
+
+func (m mockResourceSrv) Get(ctx context.Context, id string) (model.Resource, error) {
+	return m.GetFunc(ctx, id)
+}
+
+func (m mockResourceSrv) List(ctx context.Context) ([]model.Resource, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m mockResourceSrv) Create(ctx context.Context, resource model.Resource) (model.Resource, error) {
+	return m.CreateFunc(ctx, resource)
+}
+
+func (m mockResourceSrv) Update(ctx context.Context, id string, resource model.Resource) (model.Resource, error) {
+	return m.UpdateFunc(ctx, id, resource)
+}
+
+func (m mockResourceSrv) Delete(ctx context.Context, id string) error {
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m mockResourceSrv) ListAuthorized(ctx context.Context, filter resource.AuthorizedFilter) ([]model.Resource, string, error) {
+	return m.ListAuthorizedFunc(ctx, filter)
+}
+
+type mockAdminCheckSrv struct {
+	OrgAdmin      bool
+	OrgAdminErr   error
+	ProjectAdmin  bool
+	ResourceAdmin bool
+}
+
+func (m mockAdminCheckSrv) CheckOrgAdmin(ctx context.Context, orgId string) (bool, error) {
+	return m.OrgAdmin, m.OrgAdminErr
+}
+
+func (m mockAdminCheckSrv) CheckProjectAdmin(ctx context.Context, projectId string) (bool, error) {
+	return m.ProjectAdmin, nil
+}
+
+func (m mockAdminCheckSrv) CheckResourceAdmin(ctx context.Context, resource model.Resource) (bool, error) {
+	return m.ResourceAdmin, nil
+}
@@ -0,0 +1,320 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+
+	"github.com/odpf/shield/internal/project"
+	"github.com/odpf/shield/internal/serviceaccount"
+	"github.com/odpf/shield/model"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	shieldv1beta1 "github.com/odpf/shield/proto/v1beta1"
+)
+
+type ServiceAccountService interface {
+	Get(ctx context.Context, id string) (model.ServiceAccount, error)
+	Create(ctx context.Context, serviceAccount model.ServiceAccount) (model.ServiceAccount, error)
+	List(ctx context.Context, orgId string) ([]model.ServiceAccount, error)
+	Delete(ctx context.Context, id string) error
+	CreateKey(ctx context.Context, serviceAccountId string, name string, expiresAt time.Time) (model.APIKey, error)
+	ListKeys(ctx context.Context, serviceAccountId string) ([]model.APIKey, error)
+	RevokeKey(ctx context.Context, id string) (model.APIKey, error)
+	RotateKey(ctx context.Context, id string, gracePeriod time.Duration) (model.APIKey, error)
+	Authenticate(ctx context.Context, rawKey string) (model.ServiceAccount, error)
+}
+
+var grpcServiceAccountOrgRequiredErr = status.Errorf(codes.InvalidArgument, "name and org_id are required")
+
+// ListServiceAccounts lists service accounts of an organization to its admins
+func (v Dep) ListServiceAccounts(ctx context.Context, request *shieldv1beta1.ListServiceAccountsRequest) (*shieldv1beta1.ListServiceAccountsResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if request.GetOrgId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "org_id is required")
+	}
+	if err := v.authorizeAdmin(ctx, request.GetOrgId(), ""); err != nil {
+		return nil, err
+	}
+
+	serviceAccountList, err := v.ServiceAccountService.List(ctx, request.GetOrgId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, serviceAccountError(err)
+	}
+
+	var serviceAccounts []*shieldv1beta1.ServiceAccount
+	for _, sa := range serviceAccountList {
+		serviceAccountPB, err := transformServiceAccountToPB(sa)
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, grpcInternalServerError
+		}
+
+		serviceAccounts = append(serviceAccounts, &serviceAccountPB)
+	}
+
+	return &shieldv1beta1.ListServiceAccountsResponse{ServiceAccounts: serviceAccounts}, nil
+}
+
+// CreateServiceAccount creates a service account in an organization, or in a
+// project of it, callers have to be admins of the organization or project
+func (v Dep) CreateServiceAccount(ctx context.Context, request *shieldv1beta1.CreateServiceAccountRequest) (*shieldv1beta1.CreateServiceAccountResponse, error) {
+	logger := grpczap.Extract(ctx)
+	body := request.GetBody()
+	if body.GetName() == "" {
+		return nil, grpcServiceAccountOrgRequiredErr
+	}
+
+	metaDataMap, err := mapOfStringValues(body.GetMetadata().AsMap())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcBadBodyError
+	}
+
+	orgId := body.GetOrgId()
+	if body.GetProjectId() != "" {
+		prj, err := v.ProjectService.Get(ctx, body.GetProjectId())
+		if err != nil {
+			logger.Error(err.Error())
+			return nil, serviceAccountError(err)
+		}
+		if orgId == "" {
+			orgId = prj.Organization.Id
+		}
+		if orgId != prj.Organization.Id {
+			return nil, status.Errorf(codes.InvalidArgument, "project doesn't belong to org_id")
+		}
+	}
+	if orgId == "" {
+		return nil, grpcServiceAccountOrgRequiredErr
+	}
+	if err := v.authorizeAdmin(ctx, orgId, body.GetProjectId()); err != nil {
+		return nil, err
+	}
+
+	newServiceAccount, err := v.ServiceAccountService.Create(ctx, model.ServiceAccount{
+		Name:           body.GetName(),
+		OrganizationId: orgId,
+		ProjectId:      body.GetProjectId(),
+		Metadata:       metaDataMap,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, serviceAccountError(err)
+	}
+
+	serviceAccountPB, err := transformServiceAccountToPB(newServiceAccount)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.CreateServiceAccountResponse{ServiceAccount: &serviceAccountPB}, nil
+}
+
+func (v Dep) GetServiceAccount(ctx context.Context, request *shieldv1beta1.GetServiceAccountRequest) (*shieldv1beta1.GetServiceAccountResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	fetchedServiceAccount, err := v.authorizeServiceAccount(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccountPB, err := transformServiceAccountToPB(fetchedServiceAccount)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, grpcInternalServerError
+	}
+
+	return &shieldv1beta1.GetServiceAccountResponse{ServiceAccount: &serviceAccountPB}, nil
+}
+
+// DeleteServiceAccount deletes a service account, revoking its keys and
+// removing the relations it is the subject of
+func (v Dep) DeleteServiceAccount(ctx context.Context, request *shieldv1beta1.DeleteServiceAccountRequest) (*shieldv1beta1.DeleteServiceAccountResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if _, err := v.authorizeServiceAccount(ctx, request.GetId()); err != nil {
+		return nil, err
+	}
+	if err := v.ServiceAccountService.Delete(ctx, request.GetId()); err != nil {
+		logger.Error(err.Error())
+		return nil, serviceAccountError(err)
+	}
+
+	return &shieldv1beta1.DeleteServiceAccountResponse{}, nil
+}
+
+func (v Dep) ListAPIKeys(ctx context.Context, request *shieldv1beta1.ListAPIKeysRequest) (*shieldv1beta1.ListAPIKeysResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if _, err := v.authorizeServiceAccount(ctx, request.GetId()); err != nil {
+		return nil, err
+	}
+	keyList, err := v.ServiceAccountService.ListKeys(ctx, request.GetId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, serviceAccountError(err)
+	}
+
+	var keys []*shieldv1beta1.APIKey
+	for _, k := range keyList {
+		keys = append(keys, transformAPIKeyToPB(k))
+	}
+
+	return &shieldv1beta1.ListAPIKeysResponse{Keys: keys}, nil
+}
+
+// CreateAPIKey creates a key of a service account, its secret is returned
+// only in this response
+func (v Dep) CreateAPIKey(ctx context.Context, request *shieldv1beta1.CreateAPIKeyRequest) (*shieldv1beta1.CreateAPIKeyResponse, error) {
+	logger := grpczap.Extract(ctx)
+	if request.GetBody().GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	var expiresAt time.Time
+	if request.GetBody().GetExpiresIn() != "" {
+		expiresIn, err := time.ParseDuration(request.GetBody().GetExpiresIn())
+		if err != nil || expiresIn <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "expires_in must be a positive duration")
+		}
+		expiresAt = time.Now().Add(expiresIn)
+	}
+
+	if _, err := v.authorizeServiceAccount(ctx, request.GetId()); err != nil {
+		return nil, err
+	}
+	key, err := v.ServiceAccountService.CreateKey(ctx, request.GetId(), request.GetBody().GetName(), expiresAt)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, serviceAccountError(err)
+	}
+
+	return &shieldv1beta1.CreateAPIKeyResponse{Key: transformAPIKeyToPB(key)}, nil
+}
+
+// RotateAPIKey replaces a key with a new one, the old key keeps working for
+// the grace period
+func (v Dep) RotateAPIKey(ctx context.Context, request *shieldv1beta1.RotateAPIKeyRequest) (*shieldv1beta1.RotateAPIKeyResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	var gracePeriod time.Duration
+	if request.GetGracePeriod() != "" {
+		var err error
+		if gracePeriod, err = time.ParseDuration(request.GetGracePeriod()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "grace_period must be a duration")
+		}
+	}
+
+	if err := v.authorizeAPIKey(ctx, request.GetId(), request.GetKeyId()); err != nil {
+		return nil, err
+	}
+	key, err := v.ServiceAccountService.RotateKey(ctx, request.GetKeyId(), gracePeriod)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, serviceAccountError(err)
+	}
+
+	return &shieldv1beta1.RotateAPIKeyResponse{Key: transformAPIKeyToPB(key)}, nil
+}
+
+func (v Dep) RevokeAPIKey(ctx context.Context, request *shieldv1beta1.RevokeAPIKeyRequest) (*shieldv1beta1.RevokeAPIKeyResponse, error) {
+	logger := grpczap.Extract(ctx)
+
+	if err := v.authorizeAPIKey(ctx, request.GetId(), request.GetKeyId()); err != nil {
+		return nil, err
+	}
+	key, err := v.ServiceAccountService.RevokeKey(ctx, request.GetKeyId())
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, serviceAccountError(err)
+	}
+
+	return &shieldv1beta1.RevokeAPIKeyResponse{Key: transformAPIKeyToPB(key)}, nil
+}
+
+// authorizeServiceAccount returns the service account if the caller is an
+// admin of its organization or project
+func (v Dep) authorizeServiceAccount(ctx context.Context, id string) (model.ServiceAccount, error) {
+	fetchedServiceAccount, err := v.ServiceAccountService.Get(ctx, id)
+	if err != nil {
+		grpczap.Extract(ctx).Error(err.Error())
+		return model.ServiceAccount{}, serviceAccountError(err)
+	}
+	if err := v.authorizeAdmin(ctx, fetchedServiceAccount.OrganizationId, fetchedServiceAccount.ProjectId); err != nil {
+		return model.ServiceAccount{}, err
+	}
+	return fetchedServiceAccount, nil
+}
+
+// authorizeAPIKey makes sure the caller manages the service account and the
+// key belongs to it
+func (v Dep) authorizeAPIKey(ctx context.Context, serviceAccountId, keyId string) error {
+	if _, err := v.authorizeServiceAccount(ctx, serviceAccountId); err != nil {
+		return err
+	}
+	keys, err := v.ServiceAccountService.ListKeys(ctx, serviceAccountId)
+	if err != nil {
+		grpczap.Extract(ctx).Error(err.Error())
+		return serviceAccountError(err)
+	}
+	for _, k := range keys {
+		if k.Id == keyId {
+			return nil
+		}
+	}
+	return serviceAccountError(serviceaccount.APIKeyDoesntExist)
+}
+
+func serviceAccountError(err error) error {
+	switch {
+	case errors.Is(err, serviceaccount.ServiceAccountDoesntExist), errors.Is(err, serviceaccount.APIKeyDoesntExist), errors.Is(err, project.ProjectDoesntExist):
+		return status.Errorf(codes.NotFound, err.Error())
+	case errors.Is(err, serviceaccount.InvalidUUID), errors.Is(err, project.InvalidUUID), errors.Is(err, serviceaccount.OrgRequired), errors.Is(err, serviceaccount.APIKeyExpired):
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	default:
+		return grpcInternalServerError
+	}
+}
+
+func transformServiceAccountToPB(from model.ServiceAccount) (shieldv1beta1.ServiceAccount, error) {
+	metaData, err := structpb.NewStruct(mapOfInterfaceValues(from.Metadata))
+	if err != nil {
+		return shieldv1beta1.ServiceAccount{}, err
+	}
+
+	return shieldv1beta1.ServiceAccount{
+		Id:        from.Id,
+		Name:      from.Name,
+		OrgId:     from.OrganizationId,
+		ProjectId: from.ProjectId,
+		Metadata:  metaData,
+		CreatedAt: timestamppb.New(from.CreatedAt),
+		UpdatedAt: timestamppb.New(from.UpdatedAt),
+	}, nil
+}
+
+func transformAPIKeyToPB(from model.APIKey) *shieldv1beta1.APIKey {
+	key := &shieldv1beta1.APIKey{
+		Id:               from.Id,
+		Name:             from.Name,
+		ServiceAccountId: from.ServiceAccountId,
+		Key:              from.Secret,
+		CreatedAt:        timestamppb.New(from.CreatedAt),
+	}
+	if !from.ExpiresAt.IsZero() {
+		key.ExpiresAt = timestamppb.New(from.ExpiresAt)
+	}
+	if !from.RevokedAt.IsZero() {
+		key.RevokedAt = timestamppb.New(from.RevokedAt)
+	}
+	return key
+}
@@ -0,0 +1,298 @@
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/odpf/shield/internal/project"
+	"github.com/odpf/shield/internal/serviceaccount"
+	"github.com/odpf/shield/model"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	shieldv1beta1 "github.com/odpf/shield/proto/v1beta1"
+)
+
+var testServiceAccount = model.ServiceAccount{
+	Id:             "2ac7e5a8-0a3f-4b52-b6a4-2c2f0d5e7f5c",
+	Name:           "ci",
+	OrganizationId: "9f256f86-31a3-11ec-8d3d-0242ac130003",
+	ProjectId:      testProjectID,
+	Metadata:       map[string]string{},
+}
+
+var testAPIKey = model.APIKey{
+	Id:               "5d1c7d4e-2f0b-4c51-9c2a-6a3e2f7f1b0d",
+	Name:             "deploy",
+	ServiceAccountId: testServiceAccount.Id,
+	Secret:           "shield_secret",
+}
+
+func TestCreateServiceAccount(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title                 string
+		mockServiceAccountSrv mockServiceAccountSrv
+		mockProjectSrv        mockProject
+		mockAdminSrv          mockAdminCheckSrv
+		req                   *shieldv1beta1.CreateServiceAccountRequest
+		want                  *shieldv1beta1.CreateServiceAccountResponse
+		err                   error
+	}{
+		{
+			title: "missing org",
+			req: &shieldv1beta1.CreateServiceAccountRequest{Body: &shieldv1beta1.ServiceAccountRequestBody{
+				Name: "ci",
+			}},
+			err: grpcServiceAccountOrgRequiredErr,
+		},
+		{
+			title: "project doesn't exist",
+			req: &shieldv1beta1.CreateServiceAccountRequest{Body: &shieldv1beta1.ServiceAccountRequestBody{
+				Name:      "ci",
+				ProjectId: testProjectID,
+			}},
+			mockProjectSrv: mockProject{GetProjectFunc: func(ctx context.Context, id string) (model.Project, error) {
+				return model.Project{}, project.ProjectDoesntExist
+			}},
+			err: status.Errorf(codes.NotFound, project.ProjectDoesntExist.Error()),
+		},
+		{
+			title: "project of another org",
+			req: &shieldv1beta1.CreateServiceAccountRequest{Body: &shieldv1beta1.ServiceAccountRequestBody{
+				Name:      "ci",
+				OrgId:     "some-other-org",
+				ProjectId: testProjectID,
+			}},
+			mockProjectSrv: mockProject{GetProjectFunc: func(ctx context.Context, id string) (model.Project, error) {
+				return model.Project{Id: id, Organization: model.Organization{Id: testServiceAccount.OrganizationId}}, nil
+			}},
+			err: status.Errorf(codes.InvalidArgument, "project doesn't belong to org_id"),
+		},
+		{
+			title: "caller isn't an admin",
+			req: &shieldv1beta1.CreateServiceAccountRequest{Body: &shieldv1beta1.ServiceAccountRequestBody{
+				Name:  "ci",
+				OrgId: testServiceAccount.OrganizationId,
+			}},
+			err: grpcPermissionDeniedError,
+		},
+		{
+			title: "project admin creates service account in org of the project",
+			req: &shieldv1beta1.CreateServiceAccountRequest{Body: &shieldv1beta1.ServiceAccountRequestBody{
+				Name:      "ci",
+				ProjectId: testProjectID,
+			}},
+			mockProjectSrv: mockProject{GetProjectFunc: func(ctx context.Context, id string) (model.Project, error) {
+				return model.Project{Id: id, Organization: model.Organization{Id: testServiceAccount.OrganizationId}}, nil
+			}},
+			mockAdminSrv: mockAdminCheckSrv{ProjectAdmin: true},
+			mockServiceAccountSrv: mockServiceAccountSrv{CreateFunc: func(ctx context.Context, sa model.ServiceAccount) (model.ServiceAccount, error) {
+				sa.Id = testServiceAccount.Id
+				return sa, nil
+			}},
+			want: &shieldv1beta1.CreateServiceAccountResponse{ServiceAccount: &shieldv1beta1.ServiceAccount{
+				Id:        testServiceAccount.Id,
+				Name:      "ci",
+				OrgId:     testServiceAccount.OrganizationId,
+				ProjectId: testProjectID,
+				Metadata:  &structpb.Struct{Fields: map[string]*structpb.Value{}},
+				CreatedAt: timestamppb.New(time.Time{}),
+				UpdatedAt: timestamppb.New(time.Time{}),
+			}},
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			mockDep := Dep{
+				ServiceAccountService: tt.mockServiceAccountSrv,
+				ProjectService:        tt.mockProjectSrv,
+				AdminCheckService:     tt.mockAdminSrv,
+			}
+			resp, err := mockDep.CreateServiceAccount(context.Background(), tt.req)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.err, err)
+		})
+	}
+}
+
+func TestDeleteServiceAccount(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title                 string
+		mockServiceAccountSrv mockServiceAccountSrv
+		mockAdminSrv          mockAdminCheckSrv
+		deleted               string
+		err                   error
+	}{
+		{
+			title: "service account doesn't exist",
+			mockServiceAccountSrv: mockServiceAccountSrv{GetFunc: func(ctx context.Context, id string) (model.ServiceAccount, error) {
+				return model.ServiceAccount{}, serviceaccount.ServiceAccountDoesntExist
+			}},
+			err: status.Errorf(codes.NotFound, serviceaccount.ServiceAccountDoesntExist.Error()),
+		},
+		{
+			title: "caller isn't an admin",
+			mockServiceAccountSrv: mockServiceAccountSrv{GetFunc: func(ctx context.Context, id string) (model.ServiceAccount, error) {
+				return testServiceAccount, nil
+			}},
+			err: grpcPermissionDeniedError,
+		},
+		{
+			title: "error in admin check",
+			mockServiceAccountSrv: mockServiceAccountSrv{GetFunc: func(ctx context.Context, id string) (model.ServiceAccount, error) {
+				return testServiceAccount, nil
+			}},
+			mockAdminSrv: mockAdminCheckSrv{OrgAdminErr: errors.New("some service error")},
+			err:          grpcInternalServerError,
+		},
+		{
+			title: "org admin deletes service account",
+			mockServiceAccountSrv: mockServiceAccountSrv{GetFunc: func(ctx context.Context, id string) (model.ServiceAccount, error) {
+				return testServiceAccount, nil
+			}},
+			mockAdminSrv: mockAdminCheckSrv{OrgAdmin: true},
+			deleted:      testServiceAccount.Id,
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			var deleted string
+			tt.mockServiceAccountSrv.DeleteFunc = func(ctx context.Context, id string) error {
+				deleted = id
+				return nil
+			}
+
+			mockDep := Dep{ServiceAccountService: tt.mockServiceAccountSrv, AdminCheckService: tt.mockAdminSrv}
+			resp, err := mockDep.DeleteServiceAccount(context.Background(), &shieldv1beta1.DeleteServiceAccountRequest{Id: testServiceAccount.Id})
+			assert.EqualValues(t, tt.err, err)
+			assert.Equal(t, tt.deleted, deleted)
+			if tt.err == nil {
+				assert.EqualValues(t, &shieldv1beta1.DeleteServiceAccountResponse{}, resp)
+			}
+		})
+	}
+}
+
+func TestRotateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		title   string
+		req     *shieldv1beta1.RotateAPIKeyRequest
+		keys    []model.APIKey
+		rotated time.Duration
+		want    *shieldv1beta1.RotateAPIKeyResponse
+		err     error
+	}{
+		{
+			title: "invalid grace period",
+			req:   &shieldv1beta1.RotateAPIKeyRequest{Id: testServiceAccount.Id, KeyId: testAPIKey.Id, GracePeriod: "a day"},
+			err:   status.Errorf(codes.InvalidArgument, "grace_period must be a duration"),
+		},
+		{
+			title: "key of another service account",
+			req:   &shieldv1beta1.RotateAPIKeyRequest{Id: testServiceAccount.Id, KeyId: testAPIKey.Id},
+			keys:  []model.APIKey{},
+			err:   status.Errorf(codes.NotFound, serviceaccount.APIKeyDoesntExist.Error()),
+		},
+		{
+			title:   "rotate key with grace period",
+			req:     &shieldv1beta1.RotateAPIKeyRequest{Id: testServiceAccount.Id, KeyId: testAPIKey.Id, GracePeriod: "24h"},
+			keys:    []model.APIKey{testAPIKey},
+			rotated: 24 * time.Hour,
+			want: &shieldv1beta1.RotateAPIKeyResponse{Key: &shieldv1beta1.APIKey{
+				Id:               testAPIKey.Id,
+				Name:             testAPIKey.Name,
+				ServiceAccountId: testServiceAccount.Id,
+				Key:              testAPIKey.Secret,
+				CreatedAt:        timestamppb.New(time.Time{}),
+			}},
+		},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			var rotated time.Duration
+			mockDep := Dep{
+				AdminCheckService: mockAdminCheckSrv{OrgAdmin: true},
+				ServiceAccountService: mockServiceAccountSrv{
+					GetFunc: func(ctx context.Context, id string) (model.ServiceAccount, error) {
+						return testServiceAccount, nil
+					},
+					ListKeysFunc: func(ctx context.Context, serviceAccountId string) ([]model.APIKey, error) {
+						return tt.keys, nil
+					},
+					RotateKeyFunc: func(ctx context.Context, id string, gracePeriod time.Duration) (model.APIKey, error) {
+						rotated = gracePeriod
+						return testAPIKey, nil
+					},
+				},
+			}
+			resp, err := mockDep.RotateAPIKey(context.Background(), tt.req)
+			assert.EqualValues(t, tt.want, resp)
+			assert.EqualValues(t, tt.err, err)
+			assert.Equal(t, tt.rotated, rotated)
+		})
+	}
+}
+
+type mockServiceAccountSrv struct {
+	GetFunc          func(ctx context.Context, id string) (model.ServiceAccount, error)
+	CreateFunc       func(ctx context.Context, serviceAccount model.ServiceAccount) (model.ServiceAccount, error)
+	ListFunc         func(ctx context.Context, orgId string) ([]model.ServiceAccount, error)
+	DeleteFunc       func(ctx context.Context, id string) error
+	CreateKeyFunc    func(ctx context.Context, serviceAccountId string, name string, expiresAt time.Time) (model.APIKey, error)
+	ListKeysFunc     func(ctx context.Context, serviceAccountId string) ([]model.APIKey, error)
+	RevokeKeyFunc    func(ctx context.Context, id string) (model.APIKey, error)
+	RotateKeyFunc    func(ctx context.Context, id string, gracePeriod time.Duration) (model.APIKey, error)
+	AuthenticateFunc func(ctx context.Context, rawKey string) (model.ServiceAccount, error)
+}
+
+func (m mockServiceAccountSrv) Get(ctx context.Context, id string) (model.ServiceAccount, error) {
+	return m.GetFunc(ctx, id)
+}
+
+func (m mockServiceAccountSrv) Create(ctx context.Context, serviceAccount model.ServiceAccount) (model.ServiceAccount, error) {
+	return m.CreateFunc(ctx, serviceAccount)
+}
+
+func (m mockServiceAccountSrv) List(ctx context.Context, orgId string) ([]model.ServiceAccount, error) {
+	return m.ListFunc(ctx, orgId)
+}
+
+func (m mockServiceAccountSrv) Delete(ctx context.Context, id string) error {
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m mockServiceAccountSrv) CreateKey(ctx context.Context, serviceAccountId string, name string, expiresAt time.Time) (model.APIKey, error) {
+	return m.CreateKeyFunc(ctx, serviceAccountId, name, expiresAt)
+}
+
+func (m mockServiceAccountSrv) ListKeys(ctx context.Context, serviceAccountId string) ([]model.APIKey, error) {
+	return m.ListKeysFunc(ctx, serviceAccountId)
+}
+
+func (m mockServiceAccountSrv) RevokeKey(ctx context.Context, id string) (model.APIKey, error) {
+	return m.RevokeKeyFunc(ctx, id)
+}
+
+func (m mockServiceAccountSrv) RotateKey(ctx context.Context, id string, gracePeriod time.Duration) (model.APIKey, error) {
+	return m.RotateKeyFunc(ctx, id, gracePeriod)
+}
+
+func (m mockServiceAccountSrv) Authenticate(ctx context.Context, rawKey string) (model.ServiceAccount, error) {
+	return m.AuthenticateFunc(ctx, rawKey)
+}
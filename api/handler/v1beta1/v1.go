@@ -23,6 +23,8 @@ type Dep struct {
 	ResourceService        ResourceService
 	IdentityProxyHeader    string
 	PermissionCheckService PermissionCheckService
+	AdminCheckService      AdminCheckService
+	ServiceAccountService  ServiceAccountService
 }
 
 var (
@@ -3,16 +3,42 @@ package cmd
 import (
 	"github.com/odpf/salt/log"
 	"github.com/odpf/shield/config"
+	"github.com/odpf/shield/pipeline"
 	cli "github.com/spf13/cobra"
 )
 
-func New(logger log.Logger, appConfig *config.Shield) *cli.Command {
+// Option customizes the commands when shield is built as a library
+type Option func(*options)
+
+type options struct {
+	pipelines []func(service config.Service, registry *pipeline.Registry)
+}
+
+// WithPipeline calls fn with the registry of every proxy service once the
+// built-in middlewares and hooks are registered, before rules of the service
+// are loaded. Custom middlewares and hooks are registered with it, a custom
+// one registered with a built-in name replaces the built-in.
+func WithPipeline(fn func(service config.Service, registry *pipeline.Registry)) Option {
+	return func(o *options) {
+		o.pipelines = append(o.pipelines, fn)
+	}
+}
+
+func applyOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+func New(logger log.Logger, appConfig *config.Shield, opts ...Option) *cli.Command {
 	var cmd = &cli.Command{
 		Use:          "shield",
 		SilenceUsage: true,
 	}
 
-	cmd.AddCommand(serveCommand(logger, appConfig))
+	cmd.AddCommand(serveCommand(logger, appConfig, applyOptions(opts)))
 	cmd.AddCommand(migrationsCommand(logger, appConfig))
 	cmd.AddCommand(migrationsRollbackCommand(logger, appConfig))
 	cmd.AddCommand(NamespaceCommand(logger, appConfig))
@@ -23,5 +49,8 @@ func New(logger log.Logger, appConfig *config.Shield) *cli.Command {
 	cmd.AddCommand(RoleCommand(logger, appConfig))
 	cmd.AddCommand(ActionCommand(logger, appConfig))
 	cmd.AddCommand(PolicyCommand(logger, appConfig))
+	cmd.AddCommand(ServiceAccountCommand(logger, appConfig))
+	cmd.AddCommand(ResourceCommand(logger, appConfig))
+	cmd.AddCommand(PermissionCommand(logger, appConfig))
 	return cmd
 }
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/log"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/shield/config"
+	shieldv1beta1 "github.com/odpf/shield/proto/v1beta1"
+	cli "github.com/spf13/cobra"
+)
+
+func PermissionCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	cmd := &cli.Command{
+		Use:     "permission",
+		Aliases: []string{"permissions"},
+		Short:   "Debug permissions",
+		Long: heredoc.Doc(`
+			Work with permissions of users on resources.
+		`),
+		Example: heredoc.Doc(`
+			$ shield permission explain
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+	}
+
+	cmd.AddCommand(explainPermissionCommand(logger, appConfig))
+
+	return cmd
+}
+
+func explainPermissionCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	var namespaceID, resourceID, actionID, userID, header string
+
+	cmd := &cli.Command{
+		Use:   "explain",
+		Short: "Explain why a user can or can't perform an action on a resource",
+		Args:  cli.NoArgs,
+		Example: heredoc.Doc(`
+			$ shield permission explain --namespace=<namespace-id> --resource=<resource-name> --action=<action-id> --user=<user-id> --header=<key>:<value>
+			$ shield permission explain --namespace=<namespace-id> --resource=<resource-name> --action=<action-id> --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			host := appConfig.App.Host + ":" + strconv.Itoa(appConfig.App.Port)
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			if header != "" {
+				ctx = setCtxHeader(ctx, header)
+			}
+
+			res, err := client.ExplainResourcePermission(ctx, &shieldv1beta1.ExplainResourcePermissionRequest{
+				ResourceId:  resourceID,
+				NamespaceId: namespaceID,
+				ActionId:    actionID,
+				UserId:      userID,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+
+			result := "DENIED"
+			if res.GetAllowed() {
+				result = "ALLOWED"
+			}
+			fmt.Printf(" \n%s %s on %s\n \n", result, actionID, resourceID)
+			if res.GetExplanation().GetMismatch() {
+				fmt.Printf("WARNING relations in shield don't lead to the SpiceDB decision, they are out of sync\n \n")
+			}
+			printExplanation(os.Stdout, res.GetExplanation(), 0)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespaceID, "namespace", "n", "", "Namespace id of the resource")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().StringVarP(&resourceID, "resource", "r", "", "Name of the resource")
+	cmd.MarkFlagRequired("resource")
+	cmd.Flags().StringVarP(&actionID, "action", "a", "", "Action id to explain")
+	cmd.MarkFlagRequired("action")
+	cmd.Flags().StringVarP(&userID, "user", "u", "", "User id to explain the permission for, the user in header if not set")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+
+	return cmd
+}
+
+// printExplanation prints the tree with a mark telling if each node
+// granted the permission
+func printExplanation(w io.Writer, node *shieldv1beta1.PermissionExplanation, depth int) {
+	if node == nil {
+		return
+	}
+
+	mark := "✗"
+	if node.Allowed {
+		mark = "✓"
+	}
+	line := fmt.Sprintf("%s%s %s %s", strings.Repeat("  ", depth), mark, node.Kind, node.Object)
+	if node.Name != "" {
+		line += " " + node.Name
+	}
+	if node.Expression != "" {
+		line += " = " + node.Expression
+	}
+	fmt.Fprintln(w, line)
+
+	for _, child := range node.Children {
+		printExplanation(w, child, depth+1)
+	}
+}
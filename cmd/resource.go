@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/log"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/shield/config"
+	shieldv1beta1 "github.com/odpf/shield/proto/v1beta1"
+	cli "github.com/spf13/cobra"
+)
+
+func ResourceCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	cmd := &cli.Command{
+		Use:     "resource",
+		Aliases: []string{"resources"},
+		Short:   "Manage resources",
+		Long: heredoc.Doc(`
+			Work with resources.
+		`),
+		Example: heredoc.Doc(`
+			$ shield resource list
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+	}
+
+	cmd.AddCommand(listAuthorizedResourceCommand(logger, appConfig))
+
+	return cmd
+}
+
+func listAuthorizedResourceCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	var namespaceID, actionID, userID, orgID, pageToken, header string
+	var pageSize int
+
+	cmd := &cli.Command{
+		Use:   "list",
+		Short: "List resources of a namespace the user can perform an action on",
+		Args:  cli.NoArgs,
+		Example: heredoc.Doc(`
+			$ shield resource list --namespace=<namespace-id> --action=<action-id> --header=<key>:<value>
+			$ shield resource list --namespace=<namespace-id> --action=<action-id> --user=<user-id> --org=<organization-id> --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			host := appConfig.App.Host + ":" + strconv.Itoa(appConfig.App.Port)
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			if header != "" {
+				ctx = setCtxHeader(ctx, header)
+			}
+
+			res, err := client.ListAuthorizedResources(ctx, &shieldv1beta1.ListAuthorizedResourcesRequest{
+				NamespaceId: namespaceID,
+				ActionId:    actionID,
+				UserId:      userID,
+				OrgId:       orgID,
+				PageSize:    int32(pageSize),
+				PageToken:   pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+
+			resources := res.GetResources()
+			fmt.Printf(" \nShowing %d resources\n \n", len(resources))
+
+			report := [][]string{}
+			report = append(report, []string{"ID", "NAME", "PROJECT ID", "ORG ID"})
+			for _, r := range resources {
+				report = append(report, []string{r.GetId(), r.GetName(), r.GetProject().GetId(), r.GetOrganization().GetId()})
+			}
+			printer.Table(os.Stdout, report)
+
+			if res.GetNextPageToken() != "" {
+				fmt.Printf(" \nNext page: --page-token=%s\n", res.GetNextPageToken())
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&namespaceID, "namespace", "n", "", "Namespace id of the resources")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().StringVarP(&actionID, "action", "a", "", "Action id to check")
+	cmd.MarkFlagRequired("action")
+	cmd.Flags().StringVarP(&userID, "user", "u", "", "User id to list resources for, the user in header if not set")
+	cmd.Flags().StringVarP(&orgID, "org", "o", "", "Organization id of the resources, required with --user")
+	cmd.Flags().IntVarP(&pageSize, "page-size", "s", 0, "Number of resources in a page")
+	cmd.Flags().StringVarP(&pageToken, "page-token", "t", "", "Token of the page to fetch")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+
+	return cmd
+}
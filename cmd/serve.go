@@ -2,15 +2,18 @@ package cmd
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/internal/ratelimit"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/odpf/shield/internal/bootstrap"
@@ -23,22 +26,29 @@ import (
 	v1 "github.com/odpf/shield/api/handler/v1beta1"
 	"github.com/odpf/shield/config"
 	"github.com/odpf/shield/hook"
-	authz_hook "github.com/odpf/shield/hook/authz"
 	"github.com/odpf/shield/internal/authz"
 	"github.com/odpf/shield/internal/org"
 	"github.com/odpf/shield/internal/project"
 	"github.com/odpf/shield/internal/roles"
 	"github.com/odpf/shield/internal/schema"
+	"github.com/odpf/shield/internal/serviceaccount"
 	"github.com/odpf/shield/internal/user"
+	shieldlogger "github.com/odpf/shield/logger"
+	"github.com/odpf/shield/metrics"
+	"github.com/odpf/shield/middleware/accesslog"
+	"github.com/odpf/shield/middleware/prefix"
+	"github.com/odpf/shield/pipeline"
 	"github.com/odpf/shield/pkg/sql"
 	"github.com/odpf/shield/proxy"
 	blobstore "github.com/odpf/shield/store/blob"
 	"github.com/odpf/shield/store/postgres"
+	"github.com/odpf/shield/tracing"
 
 	"github.com/odpf/salt/log"
 	"github.com/odpf/salt/server"
 	"github.com/pkg/errors"
 	"github.com/pkg/profile"
+	"github.com/prometheus/client_golang/prometheus"
 	cli "github.com/spf13/cobra"
 
 	"golang.org/x/net/http2"
@@ -48,21 +58,29 @@ import (
 var (
 	proxyTermChan         = make(chan os.Signal, 1)
 	ruleCacheRefreshDelay = time.Minute * 2
+
+	// rateLimitSweepInterval is how often full rate limit buckets are
+	// deleted from postgres
+	rateLimitSweepInterval = time.Minute * 10
+
+	// resourceLookupTTL is how long pages after the first of authorized
+	// resources are cut from the ids looked up for the first page
+	resourceLookupTTL = time.Minute
 )
 
-func serveCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+func serveCommand(logger log.Logger, appConfig *config.Shield, opts options) *cli.Command {
 	c := &cli.Command{
 		Use:     "serve",
 		Short:   "Start server and proxy default on port 8080",
 		Example: "shield serve",
 		RunE: func(cmd *cli.Command, args []string) error {
-			return serve(logger, appConfig)
+			return serve(logger, appConfig, opts)
 		},
 	}
 	return c
 }
 
-func serve(logger log.Logger, appConfig *config.Shield) error {
+func serve(logger log.Logger, appConfig *config.Shield, opts options) error {
 	if profiling := os.Getenv("SHIELD_PROFILE"); profiling == "true" || profiling == "1" {
 		defer profile.Start(profile.CPUProfile, profile.ProfilePath("."), profile.NoShutdownHook).Stop()
 	}
@@ -73,6 +91,22 @@ func serve(logger log.Logger, appConfig *config.Shield) error {
 
 	db, dbShutdown := setupDB(appConfig.DB, logger)
 	defer dbShutdown()
+	if err := metrics.RegisterDB(db.DB.DB, "shield"); err != nil {
+		return err
+	}
+
+	// spans left are flushed once proxies and the api are shut down
+	shutdownTracing, err := tracing.Init(ctx, appConfig.Tracing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer shutdownCancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			logger.Warn("failed to flush spans", "err", err)
+		}
+	}()
 
 	var cleanUpFunc []func() error
 	var cleanUpProxies []func(ctx context.Context) error
@@ -83,12 +117,14 @@ func serve(logger log.Logger, appConfig *config.Shield) error {
 	}
 
 	serviceStore := postgres.NewStore(db)
-	authzService := authz.New(appConfig, logger)
+	authzService := authz.New(appConfig, logger, serviceStore)
 	deps, err := apiDependencies(ctx, db, appConfig, resourceConfig, logger, serviceStore, authzService)
 	if err != nil {
 		return err
 	}
 
+	go ratelimit.Sweep(ctx, logger, serviceStore, rateLimitSweepInterval)
+
 	AuthzCheckService := permission.NewCheckService(permission.Service{
 		Authz:               authzService,
 		Store:               serviceStore,
@@ -96,11 +132,17 @@ func serve(logger log.Logger, appConfig *config.Shield) error {
 		ResourcesRepository: resourceConfig,
 	})
 
-	cleanUpFunc, cleanUpProxies, err = startProxy(logger, appConfig, ctx, deps, cleanUpFunc, cleanUpProxies, AuthzCheckService)
+	cleanUpFunc, cleanUpProxies, err = startProxy(logger, appConfig, ctx, deps, cleanUpFunc, cleanUpProxies, AuthzCheckService, opts)
 	if err != nil {
 		return err
 	}
 
+	// registered once proxies added their rules, the collector reads them
+	// when metrics are scraped
+	if err := prometheus.Register(handler.NewConfigCollector(deps.ConfigRepositories)); err != nil {
+		return err
+	}
+
 	muxServer := startServer(logger, appConfig, err, ctx, deps)
 
 	waitForTermSignal(ctx)
@@ -134,13 +176,13 @@ func cleanup(logger log.Logger, ctx context.Context, cleanUpFunc []func() error,
 func startServer(logger log.Logger, appConfig *config.Shield, err error, ctx context.Context, deps handler.Deps) *server.MuxServer {
 	s, err := server.NewMux(server.Config{
 		Port: appConfig.App.Port,
-	}, server.WithMuxGRPCServerOptions(getGRPCMiddleware(appConfig, logger)))
+	}, server.WithMuxGRPCServerOptions(getGRPCMiddleware(appConfig, logger, deps.V1beta1.ServiceAccountService)))
 	if err != nil {
 		panic(err)
 	}
 
 	gw, err := server.NewGateway("", appConfig.App.Port, server.WithGatewayMuxOptions(
-		runtime.WithIncomingHeaderMatcher(customHeaderMatcherFunc(map[string]bool{appConfig.App.IdentityProxyHeader: true}))),
+		runtime.WithIncomingHeaderMatcher(customHeaderMatcherFunc(map[string]bool{appConfig.App.IdentityProxyHeader: true, serviceaccount.APIKeyHeader: true}))),
 	)
 	if err != nil {
 		panic(err)
@@ -179,13 +221,41 @@ func loadResourceConfig(ctx context.Context, logger log.Logger, appConfig *confi
 	if err := resourceRepo.InitCache(ctx, ruleCacheRefreshDelay); err != nil {
 		return nil, err
 	}
+	if err := watchFileConfig(ctx, logger, appConfig.App.ResourcesConfigPath, resourceRepo); err != nil {
+		return nil, err
+	}
 	return resourceRepo, nil
 }
 
-func startProxy(logger log.Logger, appConfig *config.Shield, ctx context.Context, deps handler.Deps, cleanUpFunc []func() error, cleanUpProxies []func(ctx context.Context) error, authzCheckService permission.CheckService) ([]func() error, []func(ctx context.Context) error, error) {
-	for _, service := range appConfig.Proxy.Services {
-		h2cProxy := proxy.NewH2c(proxy.NewH2cRoundTripper(logger, buildHookPipeline(logger, deps)), proxy.NewDirector())
+// watchFileConfig reloads the repository as soon as files change for file://
+// storage, other storages are only refreshed periodically
+func watchFileConfig(ctx context.Context, logger log.Logger, storagePath string, repo interface {
+	Watch(ctx context.Context, dir string) error
+}) error {
+	parsedStorageURL, err := url.Parse(storagePath)
+	if err != nil || parsedStorageURL.Scheme != "file" {
+		return nil
+	}
+	if err := repo.Watch(ctx, parsedStorageURL.Path); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", storagePath, err)
+	}
+	logger.Info("watching config files for changes", "path", parsedStorageURL.Path)
+	return nil
+}
+
+func startProxy(logger log.Logger, appConfig *config.Shield, ctx context.Context, deps handler.Deps, cleanUpFunc []func() error, cleanUpProxies []func(ctx context.Context) error, authzCheckService permission.CheckService, opts options) ([]func() error, []func(ctx context.Context) error, error) {
+	// access logs of all proxy services share the file
+	var accessLogger log.Logger = logger
+	if fileConf := appConfig.Proxy.AccessLog.File; appConfig.Proxy.AccessLog.Enabled && fileConf.Path != "" {
+		file, err := shieldlogger.NewRotatingFile(fileConf.Path, fileConf.MaxSizeMB, fileConf.MaxBackups)
+		if err != nil {
+			return nil, nil, fmt.Errorf("access log file: %w", err)
+		}
+		accessLogger = shieldlogger.InitAccessLogger(appConfig, file)
+		cleanUpFunc = append(cleanUpFunc, file.Close)
+	}
 
+	for _, service := range appConfig.Proxy.Services {
 		// load rules sets
 		if service.RulesPath == "" {
 			return nil, nil, errors.New("ruleset field cannot be left empty")
@@ -195,19 +265,51 @@ func startProxy(logger log.Logger, appConfig *config.Shield, ctx context.Context
 			return nil, nil, err
 		}
 
+		// endpoints of backends with multiple targets are balanced and
+		// health checked per proxy service
+		balancer := proxy.NewBalancer(logger)
+
+		// middlewares and hooks of a rule are chained while loading rules
+		registry := newRegistry(logger, appConfig.App.IdentityProxyHeader, deps, authzCheckService, service, opts)
+		hooks := pipeline.NewHooks(registry, hook.New())
+		h2cProxy := proxy.NewH2c(proxy.NewH2cRoundTripper(logger, hooks), proxy.NewDirector())
+		middlewares := pipeline.NewMiddlewares(registry, prefix.New(logger, h2cProxy))
+
 		ruleRepo := blobstore.NewRuleRepository(logger, blobFS)
+		ruleRepo.RegisterRuleCompiler(middlewares.Compile)
+		ruleRepo.RegisterRuleCompiler(hooks.Compile)
+		ruleRepo.RegisterBackendCompiler(balancer.CompileBackend)
+		ruleRepo.RegisterBackendTLSCompiler(proxy.BackendClientTLSConfig)
+		ruleRepo.RegisterLoadListener(balancer.Sync)
 		if err := ruleRepo.InitCache(ctx, ruleCacheRefreshDelay); err != nil {
 			return nil, nil, err
 		}
+		if err := watchFileConfig(ctx, logger, service.RulesPath, ruleRepo); err != nil {
+			return nil, nil, err
+		}
+		deps.ConfigRepositories[fmt.Sprintf("rules/%s", service.Name)] = ruleRepo
 
-		cleanUpFunc = append(cleanUpFunc, ruleRepo.Close)
-		middlewarePipeline := buildMiddlewarePipeline(logger, h2cProxy, ruleRepo, appConfig.App.IdentityProxyHeader, deps, authzCheckService)
-		go func(thisService config.Service, handler http.Handler) {
+		cleanUpFunc = append(cleanUpFunc, ruleRepo.Close, balancer.Close)
+		middlewarePipeline := buildMiddlewarePipeline(logger, middlewares, ruleRepo)
+
+		var tlsConfig *tls.Config
+		if service.TLS.Enabled() {
+			if tlsConfig, err = proxy.NewServerTLSConfig(service.TLS); err != nil {
+				return nil, nil, fmt.Errorf("tls of proxy %s: %w", service.Name, err)
+			}
+			middlewarePipeline = proxy.ClientCertIdentity(appConfig.App.IdentityProxyHeader, service.TLS.ClientIdentity, middlewarePipeline)
+		}
+		if appConfig.Proxy.AccessLog.Enabled {
+			middlewarePipeline = accesslog.New(accessLogger, service.Name, appConfig.App.IdentityProxyHeader, appConfig.Proxy.AccessLog, middlewarePipeline)
+		}
+		middlewarePipeline = tracing.Proxy(service.Name, middlewarePipeline)
+		middlewarePipeline = metrics.Proxy(service.Name, middlewarePipeline)
+		go func(thisService config.Service, handler http.Handler, tlsConfig *tls.Config) {
 			proxyURL := fmt.Sprintf("%s:%d", thisService.Host, thisService.Port)
-			logger.Info("starting h2c proxy", "url", proxyURL)
 
 			mux := http.NewServeMux()
 			mux.Handle("/ping", healthCheck())
+			mux.Handle("/metrics", metrics.Handler())
 			mux.Handle("/", handler)
 
 			//create a tcp listener
@@ -220,22 +322,29 @@ func startProxy(logger log.Logger, appConfig *config.Shield, ctx context.Context
 				Addr:    proxyURL,
 				Handler: h2c.NewHandler(mux, &http2.Server{}),
 			}
+			if tlsConfig != nil {
+				// h2 is negotiated over tls, grpc clients don't need h2c
+				logger.Info("starting tls proxy", "url", proxyURL)
+				proxySrv.Handler = mux
+				proxySrv.TLSConfig = tlsConfig
+				if err := http2.ConfigureServer(&proxySrv, &http2.Server{}); err != nil {
+					logger.Fatal("failed to configure h2", "err", err)
+				}
+				proxyListener = tls.NewListener(proxyListener, proxySrv.TLSConfig)
+			} else {
+				logger.Info("starting h2c proxy", "url", proxyURL)
+			}
 			if err := proxySrv.Serve(proxyListener); err != nil && err != http.ErrServerClosed {
 				logger.Fatal("failed to serve", "err", err)
 			}
 			cleanUpProxies = append(cleanUpProxies, proxySrv.Shutdown)
-		}(service, middlewarePipeline)
+		}(service, middlewarePipeline, tlsConfig)
 	}
 	time.Sleep(100 * time.Millisecond)
 	logger.Info("[shield] proxy is up")
 	return cleanUpFunc, cleanUpProxies, nil
 }
 
-func buildHookPipeline(log log.Logger, deps handler.Deps) hook.Service {
-	rootHook := hook.New()
-	return authz_hook.New(log, rootHook, rootHook, deps)
-}
-
 func waitForTermSignal(ctx context.Context) {
 	for {
 		select {
@@ -285,7 +394,13 @@ func apiDependencies(ctx context.Context, db *sql.SQL, appConfig *config.Shield,
 		return handler.Deps{}, err
 	}
 
+	checkService := permission.NewCheckService(permissions)
 	dependencies := handler.Deps{
+		ConfigRepositories: map[string]handler.ConfigRepository{
+			"resources": resourceConfig,
+		},
+		RateLimitStore:   serviceStore,
+		ConfigAdminOrgId: appConfig.App.ConfigAdminOrg,
 		V1beta1: v1.Dep{
 			OrgService: org.Service{
 				Store:       serviceStore,
@@ -309,13 +424,19 @@ func apiDependencies(ctx context.Context, db *sql.SQL, appConfig *config.Shield,
 			ResourceService: resource.Service{
 				Store:       serviceStore,
 				Permissions: permissions,
+				Lookups:     resource.NewLookupCache(resourceLookupTTL),
 			},
 			RoleService:            roleService,
 			PolicyService:          schemaService,
 			ActionService:          schemaService,
 			NamespaceService:       schemaService,
 			IdentityProxyHeader:    appConfig.App.IdentityProxyHeader,
-			PermissionCheckService: permission.NewCheckService(permissions),
+			PermissionCheckService: checkService,
+			AdminCheckService:      checkService,
+			ServiceAccountService: serviceaccount.Service{
+				Store:     serviceStore,
+				Relations: permissions,
+			},
 		},
 	}
 	return dependencies, nil
@@ -10,11 +10,21 @@ import (
 	"strings"
 
 	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/config"
+	"github.com/odpf/shield/hook"
+	authz_hook "github.com/odpf/shield/hook/authz"
+	"github.com/odpf/shield/hook/filter"
+	headers_hook "github.com/odpf/shield/hook/headers"
+	"github.com/odpf/shield/hook/resource_delete"
 	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/middleware/api_key"
 	"github.com/odpf/shield/middleware/authz"
 	"github.com/odpf/shield/middleware/basic_auth"
-	"github.com/odpf/shield/middleware/prefix"
+	"github.com/odpf/shield/middleware/headers"
+	"github.com/odpf/shield/middleware/jwt_auth"
+	"github.com/odpf/shield/middleware/rate_limit"
 	"github.com/odpf/shield/middleware/rulematch"
+	"github.com/odpf/shield/pipeline"
 
 	"github.com/odpf/salt/log"
 	"github.com/odpf/shield/store"
@@ -29,14 +39,50 @@ import (
 	"golang.org/x/oauth2/google"
 )
 
-// buildPipeline builds middleware sequence
-func buildMiddlewarePipeline(logger log.Logger, proxy http.Handler, ruleRepo store.RuleRepository, identityProxyHeader string, deps handler.Deps, authZCheckService permission.CheckService) http.Handler {
-	// Note: execution order is bottom up
-	prefixWare := prefix.New(logger, proxy)
-	casbinAuthz := authz.New(logger, identityProxyHeader, deps, prefixWare, authZCheckService)
-	basicAuthn := basic_auth.New(logger, casbinAuthz)
-	matchWare := rulematch.New(logger, basicAuthn, rulematch.NewRouteMatcher(ruleRepo))
-	return matchWare
+// buildMiddlewarePipeline matches requests with rules, middlewares of the
+// matched rule run in the order they are declared before it is proxied
+func buildMiddlewarePipeline(logger log.Logger, middlewares *pipeline.Middlewares, ruleRepo store.RuleRepository) http.Handler {
+	return rulematch.New(logger, middlewares, rulematch.NewRouteMatcher(ruleRepo))
+}
+
+// newRegistry registers middlewares and hooks rules of the service can use
+// by name along with compilers decoding and validating their configs once
+// while loading rules instead of on every request. Custom ones of opts are
+// registered last.
+func newRegistry(logger log.Logger, identityProxyHeader string, deps handler.Deps, authzCheckService permission.CheckService, service config.Service, opts options) *pipeline.Registry {
+	registry := pipeline.NewRegistry()
+	registry.RegisterMiddleware("api_key", func(next http.Handler) http.Handler {
+		return api_key.New(logger, identityProxyHeader, deps.V1beta1.ServiceAccountService, next)
+	}, api_key.CompileConfig)
+	registry.RegisterMiddleware("authz", func(next http.Handler) http.Handler {
+		return authz.New(logger, identityProxyHeader, deps, next, authzCheckService)
+	}, authz.CompileConfig)
+	registry.RegisterMiddleware("basic_auth", func(next http.Handler) http.Handler {
+		return basic_auth.New(logger, next)
+	}, basic_auth.CompileConfig)
+	registry.RegisterMiddleware("headers", func(next http.Handler) http.Handler {
+		return headers.New(logger, identityProxyHeader, deps, next)
+	}, headers.CompileConfig)
+	registry.RegisterMiddleware("jwt_auth", jwt_auth.NewFactory(logger, identityProxyHeader), jwt_auth.CompileConfig)
+	registry.RegisterMiddleware("rate_limit", rate_limit.NewFactory(logger, identityProxyHeader, deps), rate_limit.NewCompiler(deps))
+
+	registry.RegisterHook("authz", func(next, escape hook.Service) hook.Service {
+		return authz_hook.New(logger, next, escape, deps)
+	}, authz_hook.CompileConfig)
+	registry.RegisterHook("filter", func(next, escape hook.Service) hook.Service {
+		return filter.New(logger, next, escape, deps, authzCheckService)
+	}, filter.CompileConfig)
+	registry.RegisterHook("headers", func(next, escape hook.Service) hook.Service {
+		return headers_hook.New(logger, next, escape, deps)
+	}, headers.CompileConfig)
+	registry.RegisterHook("resource_delete", func(next, escape hook.Service) hook.Service {
+		return resource_delete.New(logger, next, escape, deps)
+	}, resource_delete.CompileConfig)
+
+	for _, customize := range opts.pipelines {
+		customize(service, registry)
+	}
+	return registry
 }
 
 type blobFactory struct{}
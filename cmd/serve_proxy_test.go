@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/config"
+	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/pipeline"
+	"github.com/odpf/shield/structs"
+
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRegistryWithPipeline(t *testing.T) {
+	opts := applyOptions([]Option{WithPipeline(func(service config.Service, registry *pipeline.Registry) {
+		if service.Name != "library" {
+			return
+		}
+		registry.RegisterMiddleware("tenant", func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				spec, _ := middleware.ExtractMiddleware(req, "tenant")
+				req.Header.Set("X-Tenant", spec.Compiled.(string))
+				next.ServeHTTP(rw, req)
+			})
+		}, func(config map[string]interface{}) (interface{}, error) {
+			return config["name"], nil
+		})
+	})})
+
+	var forwarded *http.Request
+	next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		forwarded = req
+	})
+	newRule := func() *structs.Rule {
+		return &structs.Rule{Middlewares: structs.MiddlewareSpecs{
+			{Name: "tenant", Config: map[string]interface{}{"name": "odpf"}},
+			{Name: "headers", Config: map[string]interface{}{"set": map[string]interface{}{"X-Built-In": "yes"}}},
+		}}
+	}
+
+	registry := newRegistry(log.NewNoop(), "X-Shield-Email", handler.Deps{}, permission.CheckService{}, config.Service{Name: "library"}, opts)
+	middlewares := pipeline.NewMiddlewares(registry, next)
+	rule := newRule()
+	assert.NoError(t, middlewares.Compile(rule))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/books", nil)
+	middleware.EnrichRule(req, rule)
+	middlewares.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "odpf", forwarded.Header.Get("X-Tenant"))
+	assert.Equal(t, "yes", forwarded.Header.Get("X-Built-In"))
+
+	// only the service the option registered it for knows the middleware
+	registry = newRegistry(log.NewNoop(), "X-Shield-Email", handler.Deps{}, permission.CheckService{}, config.Service{Name: "other"}, opts)
+	assert.Error(t, pipeline.NewMiddlewares(registry, next).Compile(newRule()))
+}
@@ -0,0 +1,518 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/log"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/shield/config"
+	shieldv1beta1 "github.com/odpf/shield/proto/v1beta1"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ServiceAccountCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	cmd := &cli.Command{
+		Use:     "serviceaccount",
+		Aliases: []string{"serviceaccounts"},
+		Short:   "Manage service accounts",
+		Long: heredoc.Doc(`
+			Work with service accounts and their API keys.
+		`),
+		Example: heredoc.Doc(`
+			$ shield serviceaccount create
+			$ shield serviceaccount view
+			$ shield serviceaccount list
+			$ shield serviceaccount delete
+			$ shield serviceaccount key create
+			$ shield serviceaccount key list
+			$ shield serviceaccount key rotate
+			$ shield serviceaccount key revoke
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+	}
+
+	cmd.AddCommand(createServiceAccountCommand(logger, appConfig))
+	cmd.AddCommand(viewServiceAccountCommand(logger, appConfig))
+	cmd.AddCommand(listServiceAccountCommand(logger, appConfig))
+	cmd.AddCommand(deleteServiceAccountCommand(logger, appConfig))
+	cmd.AddCommand(apiKeyCommand(logger, appConfig))
+
+	return cmd
+}
+
+func createServiceAccountCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	var filePath, header string
+
+	cmd := &cli.Command{
+		Use:   "create",
+		Short: "Create a service account",
+		Args:  cli.NoArgs,
+		Example: heredoc.Doc(`
+			$ shield serviceaccount create --file=<service-account-body> --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			var reqBody shieldv1beta1.ServiceAccountRequestBody
+			if err := parseFile(filePath, &reqBody); err != nil {
+				return err
+			}
+			if reqBody.GetName() == "" || reqBody.GetOrgId() == "" {
+				return fmt.Errorf("name and org_id are required")
+			}
+
+			host := appConfig.App.Host + ":" + strconv.Itoa(appConfig.App.Port)
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx = setCtxHeader(ctx, header)
+
+			res, err := client.CreateServiceAccount(ctx, &shieldv1beta1.CreateServiceAccountRequest{
+				Body: &reqBody,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			logger.Info(fmt.Sprintf("successfully created service account %s with id %s", res.GetServiceAccount().GetName(), res.GetServiceAccount().GetId()))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the service account body file")
+	cmd.MarkFlagRequired("file")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
+
+func viewServiceAccountCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	var header string
+	var metadata bool
+
+	cmd := &cli.Command{
+		Use:   "view",
+		Short: "View a service account",
+		Args:  cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ shield serviceaccount view <service-account-id> --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			host := appConfig.App.Host + ":" + strconv.Itoa(appConfig.App.Port)
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx = setCtxHeader(ctx, header)
+
+			res, err := client.GetServiceAccount(ctx, &shieldv1beta1.GetServiceAccountRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+
+			sa := res.GetServiceAccount()
+			report := [][]string{}
+			report = append(report, []string{"ID", "NAME", "ORG ID", "PROJECT ID"})
+			report = append(report, []string{sa.GetId(), sa.GetName(), sa.GetOrgId(), sa.GetProjectId()})
+			printer.Table(os.Stdout, report)
+
+			if metadata {
+				fmt.Print("\nMETADATA\n")
+
+				metaReport := [][]string{}
+				metaReport = append(metaReport, []string{"KEY", "VALUE"})
+				for k, v := range sa.GetMetadata().AsMap() {
+					metaReport = append(metaReport, []string{k, fmt.Sprint(v)})
+				}
+				printer.Table(os.Stdout, metaReport)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&metadata, "metadata", "m", false, "Set this flag to see metadata")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
+
+func listServiceAccountCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	var orgID, header string
+
+	cmd := &cli.Command{
+		Use:   "list",
+		Short: "List service accounts of an organization",
+		Args:  cli.NoArgs,
+		Example: heredoc.Doc(`
+			$ shield serviceaccount list --org=<organization-id> --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			host := appConfig.App.Host + ":" + strconv.Itoa(appConfig.App.Port)
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx = setCtxHeader(ctx, header)
+
+			res, err := client.ListServiceAccounts(ctx, &shieldv1beta1.ListServiceAccountsRequest{
+				OrgId: orgID,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+
+			serviceAccounts := res.GetServiceAccounts()
+			fmt.Printf(" \nShowing %d service accounts\n \n", len(serviceAccounts))
+
+			report := [][]string{}
+			report = append(report, []string{"ID", "NAME", "ORG ID", "PROJECT ID"})
+			for _, sa := range serviceAccounts {
+				report = append(report, []string{sa.GetId(), sa.GetName(), sa.GetOrgId(), sa.GetProjectId()})
+			}
+			printer.Table(os.Stdout, report)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&orgID, "org", "o", "", "Organization id of the service accounts")
+	cmd.MarkFlagRequired("org")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
+
+func deleteServiceAccountCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	var header string
+
+	cmd := &cli.Command{
+		Use:   "delete",
+		Short: "Delete a service account and revoke its keys",
+		Args:  cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ shield serviceaccount delete <service-account-id> --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			host := appConfig.App.Host + ":" + strconv.Itoa(appConfig.App.Port)
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx = setCtxHeader(ctx, header)
+
+			_, err = client.DeleteServiceAccount(ctx, &shieldv1beta1.DeleteServiceAccountRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			logger.Info(fmt.Sprintf("successfully deleted service account with id %s", args[0]))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
+
+func apiKeyCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	cmd := &cli.Command{
+		Use:     "key",
+		Aliases: []string{"keys"},
+		Short:   "Manage API keys of a service account",
+		Example: heredoc.Doc(`
+			$ shield serviceaccount key create
+			$ shield serviceaccount key list
+			$ shield serviceaccount key rotate
+			$ shield serviceaccount key revoke
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+	}
+
+	cmd.AddCommand(createAPIKeyCommand(logger, appConfig))
+	cmd.AddCommand(listAPIKeyCommand(logger, appConfig))
+	cmd.AddCommand(rotateAPIKeyCommand(logger, appConfig))
+	cmd.AddCommand(revokeAPIKeyCommand(logger, appConfig))
+
+	return cmd
+}
+
+func createAPIKeyCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	var name, expiresIn, header string
+
+	cmd := &cli.Command{
+		Use:   "create",
+		Short: "Create an API key, the key is printed only once",
+		Args:  cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ shield serviceaccount key create <service-account-id> --name=<key-name> --expires-in=720h --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			host := appConfig.App.Host + ":" + strconv.Itoa(appConfig.App.Port)
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx = setCtxHeader(ctx, header)
+
+			res, err := client.CreateAPIKey(ctx, &shieldv1beta1.CreateAPIKeyRequest{
+				Id: args[0],
+				Body: &shieldv1beta1.APIKeyRequestBody{
+					Name:      name,
+					ExpiresIn: expiresIn,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			printAPIKeys(os.Stdout, []*shieldv1beta1.APIKey{res.GetKey()}, true)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Name of the key")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "", "Duration after which the key expires e.g. 720h, never if not set")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
+
+func listAPIKeyCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	var header string
+
+	cmd := &cli.Command{
+		Use:   "list",
+		Short: "List API keys of a service account",
+		Args:  cli.ExactArgs(1),
+		Example: heredoc.Doc(`
+			$ shield serviceaccount key list <service-account-id> --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			host := appConfig.App.Host + ":" + strconv.Itoa(appConfig.App.Port)
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx = setCtxHeader(ctx, header)
+
+			res, err := client.ListAPIKeys(ctx, &shieldv1beta1.ListAPIKeysRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			fmt.Printf(" \nShowing %d keys\n \n", len(res.GetKeys()))
+			printAPIKeys(os.Stdout, res.GetKeys(), false)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
+
+func rotateAPIKeyCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	var gracePeriod, header string
+
+	cmd := &cli.Command{
+		Use:   "rotate",
+		Short: "Replace an API key with a new one",
+		Args:  cli.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ shield serviceaccount key rotate <service-account-id> <key-id> --grace-period=24h --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			host := appConfig.App.Host + ":" + strconv.Itoa(appConfig.App.Port)
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx = setCtxHeader(ctx, header)
+
+			res, err := client.RotateAPIKey(ctx, &shieldv1beta1.RotateAPIKeyRequest{
+				Id:          args[0],
+				KeyId:       args[1],
+				GracePeriod: gracePeriod,
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			printAPIKeys(os.Stdout, []*shieldv1beta1.APIKey{res.GetKey()}, true)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&gracePeriod, "grace-period", "g", "", "Duration for which the old key keeps working, revoked at once if not set")
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
+
+func revokeAPIKeyCommand(logger log.Logger, appConfig *config.Shield) *cli.Command {
+	var header string
+
+	cmd := &cli.Command{
+		Use:   "revoke",
+		Short: "Revoke an API key",
+		Args:  cli.ExactArgs(2),
+		Example: heredoc.Doc(`
+			$ shield serviceaccount key revoke <service-account-id> <key-id> --header=<key>:<value>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cli.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			host := appConfig.App.Host + ":" + strconv.Itoa(appConfig.App.Port)
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			ctx = setCtxHeader(ctx, header)
+
+			_, err = client.RevokeAPIKey(ctx, &shieldv1beta1.RevokeAPIKeyRequest{
+				Id:    args[0],
+				KeyId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			spinner.Stop()
+			logger.Info(fmt.Sprintf("successfully revoked key with id %s", args[1]))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&header, "header", "H", "", "Header <key>:<value>")
+	cmd.MarkFlagRequired("header")
+
+	return cmd
+}
+
+func printAPIKeys(out *os.File, keys []*shieldv1beta1.APIKey, withSecret bool) {
+	formatTime := func(t *timestamppb.Timestamp) string {
+		if t == nil {
+			return "-"
+		}
+		return t.AsTime().Format(time.RFC3339)
+	}
+
+	report := [][]string{}
+	header := []string{"ID", "NAME", "CREATED AT", "EXPIRES AT", "REVOKED AT"}
+	if withSecret {
+		header = append(header, "KEY")
+	}
+	report = append(report, header)
+	for _, k := range keys {
+		row := []string{k.GetId(), k.GetName(), formatTime(k.GetCreatedAt()), formatTime(k.GetExpiresAt()), formatTime(k.GetRevokedAt())}
+		if withSecret {
+			row = append(row, k.GetKey())
+		}
+		report = append(report, row)
+	}
+	printer.Table(out, report)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/odpf/salt/log"
 	"github.com/odpf/shield/config"
 	"github.com/odpf/shield/grpc_interceptors"
+	"github.com/odpf/shield/internal/serviceaccount"
+	"github.com/odpf/shield/metrics"
 	"github.com/odpf/shield/pkg/sql"
 
 	"go.uber.org/zap"
@@ -33,7 +35,7 @@ func setupNewRelic(cfg config.NewRelic, logger log.Logger) newrelic.Application
 }
 
 // REVISIT: passing config.Shield as reference
-func getGRPCMiddleware(cfg *config.Shield, logger log.Logger) grpc.ServerOption {
+func getGRPCMiddleware(cfg *config.Shield, logger log.Logger, apiKeyAuthenticator grpc_interceptors.APIKeyAuthenticator) grpc.ServerOption {
 	customFunc := func(p interface{}) (err error) {
 		return status.Errorf(codes.Internal, "internal server error")
 	}
@@ -43,7 +45,9 @@ func getGRPCMiddleware(cfg *config.Shield, logger log.Logger) grpc.ServerOption
 
 	return grpc.UnaryInterceptor(
 		grpcMiddleware.ChainUnaryServer(
+			metrics.APIUnaryInterceptor(),
 			grpc_interceptors.EnrichCtxWithIdentity(cfg.App.IdentityProxyHeader),
+			grpc_interceptors.EnrichCtxWithServiceAccount(serviceaccount.APIKeyHeader, apiKeyAuthenticator),
 			grpczap.UnaryServerInterceptor(zap.NewExample()),
 			grpcRecovery.UnaryServerInterceptor(opts...),
 			grpcctxtags.UnaryServerInterceptor(),
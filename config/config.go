@@ -18,6 +18,8 @@ type Shield struct {
 	App      Service       `yaml:"app"`
 	DB       DBConfig      `yaml:"db"`
 	SpiceDB  SpiceDBConfig `yaml:"spice_db"`
+	Authz    AuthzConfig   `yaml:"authz" mapstructure:"authz"`
+	Tracing  TracingConfig `yaml:"tracing" mapstructure:"tracing"`
 }
 
 type LogConfig struct {
@@ -30,6 +32,40 @@ type LogConfig struct {
 
 type ProxyConfig struct {
 	Services []Service `yaml:"services" mapstructure:"services"`
+
+	// AccessLog logs a record of every proxied request
+	AccessLog AccessLogConfig `yaml:"access_log" mapstructure:"access_log"`
+}
+
+type AccessLogConfig struct {
+	Enabled bool `yaml:"enabled" mapstructure:"enabled"`
+
+	// Headers adds request headers to records, values of redacted headers
+	// are masked
+	Headers bool `yaml:"headers" mapstructure:"headers"`
+	// Body adds buffered json request bodies up to MaxBodySize bytes to
+	// records, values of redacted keys are masked
+	Body        bool `yaml:"body" mapstructure:"body"`
+	MaxBodySize int  `yaml:"max_body_size" mapstructure:"max_body_size" default:"4096"`
+
+	Redact AccessLogRedact `yaml:"redact" mapstructure:"redact"`
+
+	// File also writes records as json lines to a file rotated by size
+	File AccessLogFile `yaml:"file" mapstructure:"file"`
+}
+
+// AccessLogRedact lists what is masked in addition to credentials like the
+// Authorization and Cookie headers
+type AccessLogRedact struct {
+	Headers []string `yaml:"headers" mapstructure:"headers"`
+	// BodyKeys are json object keys masked at any depth of the body
+	BodyKeys []string `yaml:"body_keys" mapstructure:"body_keys"`
+}
+
+type AccessLogFile struct {
+	Path       string `yaml:"path" mapstructure:"path"`
+	MaxSizeMB  int    `yaml:"max_size_mb" mapstructure:"max_size_mb" default:"100"`
+	MaxBackups int    `yaml:"max_backups" mapstructure:"max_backups" default:"5"`
 }
 
 type SpiceDBConfig struct {
@@ -38,6 +74,31 @@ type SpiceDBConfig struct {
 	PreSharedKey string `yaml:"pre_shared_key" mapstructure:"pre_shared_key"`
 }
 
+type AuthzConfig struct {
+	// engine used to store schema and evaluate permissions - spicedb, local
+	// local evaluates permissions in-process over relations stored in db
+	Engine string `yaml:"engine" mapstructure:"engine" default:"spicedb"`
+}
+
+type TracingConfig struct {
+	Enabled bool `yaml:"enabled" mapstructure:"enabled"`
+
+	// exporter of spans - otlp, stdout
+	Exporter    string `yaml:"exporter" mapstructure:"exporter" default:"otlp"`
+	ServiceName string `yaml:"service_name" mapstructure:"service_name" default:"shield"`
+	// SampleRatio of traces started by shield, sampling decisions of
+	// incoming trace context are kept
+	SampleRatio float64 `yaml:"sample_ratio" mapstructure:"sample_ratio" default:"1"`
+
+	OTLP OTLPConfig `yaml:"otlp" mapstructure:"otlp"`
+}
+
+// OTLPConfig is the grpc endpoint of an OpenTelemetry collector
+type OTLPConfig struct {
+	Endpoint string `yaml:"endpoint" mapstructure:"endpoint" default:"localhost:4317"`
+	Insecure bool   `yaml:"insecure" mapstructure:"insecure"`
+}
+
 type Service struct {
 	// port to listen on
 	Port int `yaml:"port" mapstructure:"port" default:"8080"`
@@ -62,6 +123,32 @@ type Service struct {
 	// ResourcesPathSecretSecret could be a env name, file path or actual value required
 	// to access ResourcesPathSecretPath files
 	ResourcesConfigPathSecret string `yaml:"resources_config_path_secret" mapstructure:"resources_config_path_secret"`
+
+	// TLS terminates tls on the listener, plaintext h2c is served if not set
+	TLS ServiceTLS `yaml:"tls" mapstructure:"tls"`
+
+	// ConfigAdminOrg is the id of the organization whose admins can reload
+	// rules and resource configs and read their status through the admin
+	// api, nobody can if not set
+	ConfigAdminOrg string `yaml:"config_admin_org" mapstructure:"config_admin_org"`
+}
+
+type ServiceTLS struct {
+	CertFile string `yaml:"cert_file" mapstructure:"cert_file"`
+	KeyFile  string `yaml:"key_file" mapstructure:"key_file"`
+
+	// ClientCAFile verifies client certificates against the CAs in it
+	ClientCAFile string `yaml:"client_ca_file" mapstructure:"client_ca_file"`
+	// ClientAuth is require or optional, clients must present a certificate
+	// by default once ClientCAFile is set
+	ClientAuth string `yaml:"client_auth" mapstructure:"client_auth"`
+	// ClientIdentity sets the identity proxy header from the verified client
+	// certificate, one of email or common_name
+	ClientIdentity string `yaml:"client_identity" mapstructure:"client_identity"`
+}
+
+func (t ServiceTLS) Enabled() bool {
+	return t.CertFile != "" || t.KeyFile != ""
 }
 
 type NewRelic struct {
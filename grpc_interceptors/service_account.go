@@ -0,0 +1,41 @@
+package grpc_interceptors
+
+import (
+	"context"
+	"strings"
+
+	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/model"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, rawKey string) (model.ServiceAccount, error)
+}
+
+// EnrichCtxWithServiceAccount authenticates requests carrying an API key in
+// apiKeyHeader metadata, requests without one are passed as is
+func EnrichCtxWithServiceAccount(apiKeyHeader string, authenticator APIKeyAuthenticator) grpc.UnaryServerInterceptor {
+	apiKeyHeader = strings.ToLower(apiKeyHeader)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		metadataValues := md.Get(apiKeyHeader)
+		if len(metadataValues) == 0 || metadataValues[0] == "" {
+			return handler(ctx, req)
+		}
+
+		serviceAccount, err := authenticator.Authenticate(ctx, metadataValues[0])
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid api key")
+		}
+		return handler(permission.SetServiceAccountToContext(ctx, serviceAccount), req)
+	}
+}
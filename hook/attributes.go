@@ -0,0 +1,123 @@
+package hook
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/pkg/body_extractor"
+	"github.com/odpf/shield/structs"
+)
+
+// ErrGraphQLFailed is returned when a response attribute of a graphql
+// operation is missing because the operation failed, nothing was created or
+// changed by it
+var ErrGraphQLFailed = errors.New("graphql operation failed")
+
+// ExtractAttributes reads attributes of a hook config out of the response,
+// or out of the request if their source is request, path params of the rule
+// are added to them
+func ExtractAttributes(res *http.Response, rule *structs.Rule, attributes map[string]Attribute) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	for id, attr := range attributes {
+		value, err := extractAttribute(res, rule, attr)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", id, err)
+		}
+		values[id] = value
+	}
+
+	paramMap, _ := middleware.ExtractPathParams(res.Request)
+	for key, value := range paramMap {
+		values[key] = value
+	}
+	return values, nil
+}
+
+func extractAttribute(res *http.Response, rule *structs.Rule, attr Attribute) (interface{}, error) {
+	bdy := middleware.RequestPayload(res.Request)
+	bodySource := &res.Body
+	if attr.Source == string(SourceRequest) {
+		bodySource = &bdy
+	}
+
+	headerSource := &res.Header
+	if attr.Source == string(SourceRequest) {
+		headerSource = &res.Request.Header
+	}
+
+	switch attr.Type {
+	case AttributeTypeGRPCPayload:
+		if !strings.HasPrefix(res.Header.Get("Content-Type"), "application/grpc") {
+			return nil, fmt.Errorf("invalid header for http request: %s", res.Header.Get("Content-Type"))
+		}
+
+		handler := body_extractor.GRPCPayloadHandler{
+			Encoding: headerSource.Get("Grpc-Encoding"),
+			Method:   res.Request.URL.Path,
+			Response: attr.Source != string(SourceRequest),
+		}
+		if attr.Path == "" {
+			return handler.Extract(bodySource, attr.Index)
+		}
+		handler.Resolver, _ = rule.Backend.Descriptors.(body_extractor.MethodResolver)
+		return handler.ExtractPath(bodySource, attr.Path)
+
+	case AttributeTypeJSONPayload:
+		if attr.Key == "" {
+			return nil, errors.New("payload key field empty")
+		}
+		return body_extractor.JSONPayloadHandler{}.Extract(bodySource, attr.Key)
+
+	case AttributeTypeGraphQL:
+		op, err := middleware.GraphQLOperation(res.Request, rule.Frontend.Body.MaxSize)
+		if err != nil {
+			return nil, err
+		}
+		if attr.Source == string(SourceRequest) {
+			return op.Argument(attr.Key)
+		}
+
+		key, err := op.ResultPath(attr.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := body_extractor.JSONPayloadHandler{}.Extract(bodySource, key)
+		// a failed operation is answered with errors instead of data
+		if err != nil {
+			if _, lookupErr := (body_extractor.JSONPayloadHandler{}).Extract(bodySource, "errors"); lookupErr == nil {
+				return nil, ErrGraphQLFailed
+			}
+		}
+		return value, err
+
+	case AttributeTypeHeader:
+		if attr.Key == "" {
+			return nil, errors.New("header key field empty")
+		}
+		headerAttr := headerSource.Get(attr.Key)
+		if headerAttr == "" {
+			return nil, fmt.Errorf("header %s is empty", attr.Key)
+		}
+		return headerAttr, nil
+
+	case AttributeTypeQuery:
+		if attr.Key == "" {
+			return nil, errors.New("query key field empty")
+		}
+		queryAttr := res.Request.URL.Query().Get(attr.Key)
+		if queryAttr == "" {
+			return nil, fmt.Errorf("query %s is empty", attr.Key)
+		}
+		return queryAttr, nil
+
+	case AttributeTypeConstant:
+		if attr.Value == "" {
+			return nil, errors.New("constant value empty")
+		}
+		return attr.Value, nil
+	}
+	return nil, fmt.Errorf("unknown attribute type: %v", attr)
+}
@@ -1,16 +1,14 @@
 package authz
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/odpf/shield/api/handler"
 	"github.com/odpf/shield/hook"
 	"github.com/odpf/shield/internal/permission"
-	"github.com/odpf/shield/middleware"
 	"github.com/odpf/shield/model"
-	"github.com/odpf/shield/pkg/body_extractor"
 	"github.com/odpf/shield/utils"
 
 	"github.com/mitchellh/mapstructure"
@@ -43,6 +41,24 @@ type Config struct {
 	Attributes map[string]hook.Attribute `yaml:"attributes" mapstructure:"attributes"`
 }
 
+// CompileConfig decodes and validates authz hook config while loading rules
+func CompileConfig(config map[string]interface{}) (interface{}, error) {
+	return parseConfig(config)
+}
+
+func parseConfig(rawConfig map[string]interface{}) (*Config, error) {
+	config := &Config{}
+	if err := mapstructure.Decode(rawConfig, config); err != nil {
+		return nil, err
+	}
+	for id, attr := range config.Attributes {
+		if err := attr.Validate(); err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", id, err)
+		}
+	}
+	return config, nil
+}
+
 func (a Authz) Info() hook.Info {
 	return hook.Info{
 		Name:        "authz",
@@ -65,107 +81,37 @@ func (a Authz) ServeHook(res *http.Response, err error) (*http.Response, error)
 		return a.next.ServeHook(res, nil)
 	}
 
-	config := Config{}
-	if err := mapstructure.Decode(hookSpec.Config, &config); err != nil {
-		return a.next.ServeHook(res, nil)
+	config, ok := hookSpec.Compiled.(*Config)
+	if !ok {
+		var err error
+		if config, err = parseConfig(hookSpec.Config); err != nil {
+			return a.next.ServeHook(res, nil)
+		}
 	}
 
 	if rule.Backend.Namespace == "" {
 		return a.next.ServeHook(res, fmt.Errorf("namespace variable not defined in rules"))
 	}
 
-	attributes := map[string]interface{}{}
-	attributes["namespace"] = rule.Backend.Namespace
-
-	attributes["user"] = res.Request.Header.Get(a.Deps.V1beta1.IdentityProxyHeader)
 	res.Request = res.Request.WithContext(permission.SetEmailToContext(res.Request.Context(), res.Request.Header.Get(a.Deps.V1beta1.IdentityProxyHeader)))
 
-	for id, attr := range config.Attributes {
-		bdy, _ := middleware.ExtractRequestBody(res.Request)
-		bodySource := &res.Body
-		if attr.Source == string(hook.SourceRequest) {
-			bodySource = &bdy
-		}
-
-		headerSource := &res.Header
-		if attr.Source == string(hook.SourceRequest) {
-			headerSource = &res.Request.Header
-		}
-
-		switch attr.Type {
-		case hook.AttributeTypeGRPCPayload:
-			if !strings.HasPrefix(res.Header.Get("Content-Type"), "application/grpc") {
-				a.log.Error("middleware: not a grpc request", "attr", attr)
-				return a.escape.ServeHook(res, fmt.Errorf("invalid header for http request: %s", res.Header.Get("Content-Type")))
-			}
-
-			payloadField, err := body_extractor.GRPCPayloadHandler{}.Extract(bodySource, attr.Index)
-			if err != nil {
-				a.log.Error("middleware: failed to parse grpc payload", "err", err)
-				return a.escape.ServeHook(res, fmt.Errorf("unable to parse grpc payload"))
-			}
-			attributes[id] = payloadField
-
-			a.log.Info("middleware: extracted", "field", payloadField, "attr", attr)
-		case hook.AttributeTypeJSONPayload:
-			if attr.Key == "" {
-				a.log.Error("middleware: payload key field empty")
-				return a.escape.ServeHook(res, fmt.Errorf("payload key field empty"))
-			}
-			payloadField, err := body_extractor.JSONPayloadHandler{}.Extract(bodySource, attr.Key)
-			if err != nil {
-				a.log.Error("middleware: failed to parse json payload", "err", err)
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-			attributes[id] = payloadField
-
-			a.log.Info("middleware: extracted", "field", payloadField, "attr", attr)
-		case hook.AttributeTypeHeader:
-			if attr.Key == "" {
-				a.log.Error("middleware: header key field empty", "err", err)
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-			headerAttr := headerSource.Get(attr.Key)
-			if headerAttr == "" {
-				a.log.Error(fmt.Sprintf("middleware: header %s is empty", attr.Key))
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-
-			attributes[id] = headerAttr
-			a.log.Info("middleware: extracted", "field", headerAttr, "attr", attr)
-
-		case hook.AttributeTypeQuery:
-			if attr.Key == "" {
-				a.log.Error("middleware: query key field empty")
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-			queryAttr := res.Request.URL.Query().Get(attr.Key)
-			if queryAttr == "" {
-				a.log.Error(fmt.Sprintf("middleware: query %s is empty", attr.Key))
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-
-			attributes[id] = queryAttr
-			a.log.Info("middleware: extracted", "field", queryAttr, "attr", attr)
-
-		case hook.AttributeTypeConstant:
-			if attr.Value == "" {
-				a.log.Error("middleware: constant value empty")
-				return a.escape.ServeHook(res, fmt.Errorf("failed to parse json payload"))
-			}
-
-			attributes[id] = attr.Value
-			a.log.Info("middleware: extracted", "constant_key", res, "attr", attributes[id])
-
-		default:
-			a.log.Error("middleware: unknown attribute type", "attr", attr)
-			return a.escape.ServeHook(res, fmt.Errorf("unknown attribute type: %v", attr))
-		}
+	attributes, err := hook.ExtractAttributes(res, rule, config.Attributes)
+	if errors.Is(err, hook.ErrGraphQLFailed) {
+		a.log.Info("middleware: graphql operation failed, skipping resources", "err", err)
+		return a.next.ServeHook(res, nil)
 	}
+	if err != nil {
+		a.log.Error("middleware: failed to extract attributes", "err", err)
+		return a.escape.ServeHook(res, err)
+	}
+	a.log.Info("middleware: extracted", "attributes", attributes)
 
-	paramMap, _ := middleware.ExtractPathParams(res.Request)
-	for key, value := range paramMap {
-		attributes[key] = value
+	// attributes of the config and path params take precedence
+	if _, ok := attributes["namespace"]; !ok {
+		attributes["namespace"] = rule.Backend.Namespace
+	}
+	if _, ok := attributes["user"]; !ok {
+		attributes["user"] = res.Request.Header.Get(a.Deps.V1beta1.IdentityProxyHeader)
 	}
 
 	resources, err := createResources(attributes)
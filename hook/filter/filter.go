@@ -0,0 +1,173 @@
+package filter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/hook"
+	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/model"
+	"github.com/odpf/shield/pkg/body_extractor"
+	"github.com/odpf/shield/utils"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/salt/log"
+)
+
+type AuthzCheckService interface {
+	CheckAuthzBatch(ctx context.Context, checks []permission.ResourceAction) ([]bool, error)
+}
+
+// Filter removes items of a list response the user isn't allowed to see
+type Filter struct {
+	log log.Logger
+
+	// To go to next hook
+	next hook.Service
+
+	// To skip all the next hooks and just respond back
+	escape hook.Service
+
+	Deps              handler.Deps
+	AuthzCheckService AuthzCheckService
+}
+
+func New(log log.Logger, next, escape hook.Service, deps handler.Deps, authzCheckService AuthzCheckService) Filter {
+	return Filter{
+		log:               log,
+		next:              next,
+		escape:            escape,
+		Deps:              deps,
+		AuthzCheckService: authzCheckService,
+	}
+}
+
+type Config struct {
+	// Action has to be allowed on the resource of an item to keep it
+	Action       string `yaml:"action" mapstructure:"action"`
+	ResourceType string `yaml:"resource_type" mapstructure:"resource_type"`
+
+	// Path is the list in the response, a json path like data.books or
+	// field names of a grpc response like books
+	Path string `yaml:"path" mapstructure:"path"`
+
+	// Key is the path of the resource id in an item, items are ids if empty
+	Key string `yaml:"key" mapstructure:"key"`
+}
+
+// CompileConfig decodes and validates filter hook config while loading rules
+func CompileConfig(config map[string]interface{}) (interface{}, error) {
+	return parseConfig(config)
+}
+
+func parseConfig(rawConfig map[string]interface{}) (*Config, error) {
+	config := &Config{}
+	if err := mapstructure.Decode(rawConfig, config); err != nil {
+		return nil, err
+	}
+	switch {
+	case config.Action == "":
+		return nil, errors.New("action is required")
+	case config.ResourceType == "":
+		return nil, errors.New("resource_type is required")
+	case config.Path == "":
+		return nil, errors.New("path is required")
+	}
+	return config, nil
+}
+
+func (f Filter) Info() hook.Info {
+	return hook.Info{
+		Name:        "filter",
+		Description: "hook to remove items of a list the user isn't allowed to see",
+	}
+}
+
+func (f Filter) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	if err != nil || res.StatusCode >= 400 {
+		return f.escape.ServeHook(res, err)
+	}
+
+	rule, ok := hook.ExtractRule(res.Request)
+	if !ok {
+		return f.next.ServeHook(res, nil)
+	}
+
+	hookSpec, ok := hook.ExtractHook(res.Request, f.Info().Name)
+	if !ok {
+		return f.next.ServeHook(res, nil)
+	}
+
+	// the full list is never sent back when it can't be filtered
+	config, ok := hookSpec.Compiled.(*Config)
+	if !ok {
+		var err error
+		if config, err = parseConfig(hookSpec.Config); err != nil {
+			f.log.Error("hook: failed to decode filter config", "config", hookSpec.Config, "err", err)
+			return f.deny(res, fmt.Errorf("invalid filter hook config"))
+		}
+	}
+
+	if rule.Backend.Namespace == "" {
+		return f.deny(res, fmt.Errorf("namespace variable not defined in rules"))
+	}
+	if encoding := res.Header.Get("Content-Encoding"); encoding != "" && encoding != "identity" {
+		f.log.Error("hook: can't filter encoded response", "encoding", encoding)
+		return f.deny(res, fmt.Errorf("unsupported content encoding %s", encoding))
+	}
+
+	req := res.Request.WithContext(permission.SetEmailToContext(res.Request.Context(), res.Request.Header.Get(f.Deps.V1beta1.IdentityProxyHeader)))
+	namespaceId := utils.CreateNamespaceID(rule.Backend.Namespace, config.ResourceType)
+	allowed := func(ids []string) ([]bool, error) {
+		checks := make([]permission.ResourceAction, len(ids))
+		for i, id := range ids {
+			checks[i] = permission.ResourceAction{
+				Resource: model.Resource{Name: id, NamespaceId: namespaceId},
+				Action:   model.Action{Id: config.Action},
+			}
+		}
+		return f.AuthzCheckService.CheckAuthzBatch(req.Context(), checks)
+	}
+
+	var length int
+	if strings.HasPrefix(res.Header.Get("Content-Type"), "application/grpc") {
+		handler := body_extractor.GRPCPayloadHandler{
+			Encoding: res.Header.Get("Grpc-Encoding"),
+			Method:   res.Request.URL.Path,
+			Response: true,
+		}
+		handler.Resolver, _ = rule.Backend.Descriptors.(body_extractor.MethodResolver)
+		length, err = handler.Filter(&res.Body, config.Path, config.Key, allowed)
+	} else {
+		length, err = body_extractor.JSONPayloadHandler{}.Filter(&res.Body, config.Path, config.Key, allowed)
+	}
+	if err != nil {
+		f.log.Error("hook: failed to filter response", "path", config.Path, "err", err)
+		return f.deny(res, fmt.Errorf("failed to filter response"))
+	}
+
+	res.ContentLength = int64(length)
+	if res.Header.Get("Content-Length") != "" {
+		res.Header.Set("Content-Length", strconv.Itoa(length))
+	}
+	return f.next.ServeHook(res, nil)
+}
+
+// deny drops the response body before escaping so the unfiltered list
+// doesn't reach the client
+func (f Filter) deny(res *http.Response, err error) (*http.Response, error) {
+	if res.Body != nil {
+		res.Body.Close()
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(nil))
+	res.ContentLength = 0
+	res.Header.Del("Content-Length")
+	return f.escape.ServeHook(res, err)
+}
@@ -0,0 +1,90 @@
+package filter
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/odpf/salt/log"
+	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/hook"
+	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+// allowedResources allows actions on resources named in allowed
+type allowedResources struct {
+	allowed map[string]bool
+}
+
+func (a allowedResources) CheckAuthzBatch(ctx context.Context, checks []permission.ResourceAction) ([]bool, error) {
+	results := make([]bool, len(checks))
+	for i, check := range checks {
+		results[i] = a.allowed[check.Action.Id+" "+check.Resource.NamespaceId+" "+check.Resource.Name]
+	}
+	return results, nil
+}
+
+func filterResponse(config map[string]interface{}, status int, body string) *http.Response {
+	req := httptest.NewRequest(http.MethodGet, "/api/books", nil)
+	middleware.EnrichRule(req, &structs.Rule{
+		Backend: structs.Backend{Namespace: "library"},
+		Hooks:   structs.HookSpecs{{Name: "filter", Config: config}},
+	})
+	return &http.Response{
+		StatusCode:    status,
+		Header:        http.Header{"Content-Type": []string{"application/json"}, "Content-Length": []string{"1"}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func TestFilter(t *testing.T) {
+	checks := allowedResources{allowed: map[string]bool{
+		"read library_book b1": true,
+		"read library_book b3": true,
+	}}
+	rootHook := hook.New()
+	filter := New(log.NewNoop(), rootHook, rootHook, handler.Deps{}, checks)
+	config := map[string]interface{}{
+		"action":        "read",
+		"resource_type": "book",
+		"path":          "books",
+		"key":           "urn",
+	}
+
+	res, err := filter.ServeHook(filterResponse(config, http.StatusOK, `{"books":[{"urn":"b1"},{"urn":"b2"},{"urn":"b3"}]}`), nil)
+	assert.NoError(t, err)
+	body, _ := ioutil.ReadAll(res.Body)
+	assert.Equal(t, `{"books":[{"urn":"b1"},{"urn":"b3"}]}`, string(body))
+	assert.Equal(t, int64(len(body)), res.ContentLength)
+	assert.Equal(t, "37", res.Header.Get("Content-Length"))
+
+	// error responses have no list
+	res, err = filter.ServeHook(filterResponse(config, http.StatusNotFound, `{"error":"not found"}`), nil)
+	assert.NoError(t, err)
+	body, _ = ioutil.ReadAll(res.Body)
+	assert.Equal(t, `{"error":"not found"}`, string(body))
+
+	// the list isn't sent back when it can't be filtered
+	res, err = filter.ServeHook(filterResponse(config, http.StatusOK, `{"books":[{"id":"b1"}]}`), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	body, _ = ioutil.ReadAll(res.Body)
+	assert.Empty(t, body)
+}
+
+func TestParseConfig(t *testing.T) {
+	_, err := parseConfig(map[string]interface{}{"action": "read", "resource_type": "book", "path": "books"})
+	assert.NoError(t, err)
+	_, err = parseConfig(map[string]interface{}{"action": "read", "resource_type": "book"})
+	assert.Error(t, err)
+	_, err = parseConfig(map[string]interface{}{"resource_type": "book", "path": "books"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package headers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/hook"
+	"github.com/odpf/shield/middleware/headers"
+
+	"github.com/odpf/salt/log"
+)
+
+// Headers rewrites response headers sent back to the client, it accepts
+// same config as headers middleware
+type Headers struct {
+	log log.Logger
+
+	// To go to next hook
+	next hook.Service
+
+	// To skip all the next hooks and just respond back
+	escape hook.Service
+
+	Deps handler.Deps
+}
+
+func New(log log.Logger, next, escape hook.Service, deps handler.Deps) Headers {
+	return Headers{
+		log:    log,
+		next:   next,
+		escape: escape,
+		Deps:   deps,
+	}
+}
+
+func (h Headers) Info() hook.Info {
+	return hook.Info{
+		Name:        "headers",
+		Description: "hook to set, append and remove response headers",
+	}
+}
+
+func (h Headers) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	if err != nil {
+		return h.escape.ServeHook(res, err)
+	}
+
+	hookSpec, ok := hook.ExtractHook(res.Request, h.Info().Name)
+	if !ok {
+		return h.next.ServeHook(res, nil)
+	}
+
+	rewriter, ok := hookSpec.Compiled.(*headers.Rewriter)
+	if !ok {
+		var err error
+		if rewriter, err = headers.ParseConfig(hookSpec.Config); err != nil {
+			h.log.Error("hook: failed to decode headers config", "config", hookSpec.Config, "err", err)
+			return h.escape.ServeHook(res, fmt.Errorf("invalid headers hook config"))
+		}
+	}
+
+	data := headers.NewTemplateData(res.Request, h.Deps.V1beta1.IdentityProxyHeader, h.Deps)
+	if err := rewriter.Rewrite(res.Header, data); err != nil {
+		h.log.Error("hook: failed to rewrite headers", "err", err)
+		return h.escape.ServeHook(res, err)
+	}
+
+	return h.next.ServeHook(res, nil)
+}
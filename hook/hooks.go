@@ -1,6 +1,8 @@
 package hook
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/odpf/shield/middleware"
@@ -23,6 +25,7 @@ const (
 	AttributeTypeQuery       AttributeType = "query"
 	AttributeTypeHeader      AttributeType = "header"
 	AttributeTypeConstant    AttributeType = "constant"
+	AttributeTypeGraphQL     AttributeType = "graphql"
 
 	SourceRequest  AttributeType = "request"
 	SourceResponse AttributeType = "response"
@@ -34,10 +37,50 @@ type Attribute struct {
 	Key    string        `yaml:"key" mapstructure:"key"`
 	Type   AttributeType `yaml:"type" mapstructure:"type"`
 	Index  string        `yaml:"index" mapstructure:"index"` // proto index
+	Path   string        `yaml:"path" mapstructure:"path"`   // proto field names
 	Source string        `yaml:"source" mapstructure:"source"`
 	Value  string        `yaml:"value" mapstructure:"value"`
 }
 
+// Validate checks the fields required by the attribute type are set
+func (a Attribute) Validate() error {
+	switch a.Type {
+	case AttributeTypeGRPCPayload:
+		if a.Index == "" && a.Path == "" {
+			return errors.New("index or path is required for grpc_payload attribute")
+		}
+	case AttributeTypeJSONPayload, AttributeTypeHeader, AttributeTypeQuery, AttributeTypeGraphQL:
+		if a.Key == "" {
+			return fmt.Errorf("key is required for %s attribute", a.Type)
+		}
+	case AttributeTypeConstant:
+		if a.Value == "" {
+			return errors.New("value is required for constant attribute")
+		}
+	default:
+		return fmt.Errorf("unknown attribute type %q", a.Type)
+	}
+	if a.Source != "" && a.Source != string(SourceRequest) && a.Source != string(SourceResponse) {
+		return fmt.Errorf("unknown attribute source %q", a.Source)
+	}
+	return nil
+}
+
+// ReadsRequestPayload tells if a hook config has attributes read from the
+// request payload, attributes without a source are read from the response.
+// graphql attributes always need the query document of the request.
+func ReadsRequestPayload(config map[string]interface{}) bool {
+	return middleware.HasAttribute(config, func(attr map[string]interface{}) bool {
+		switch AttributeType(fmt.Sprint(attr["type"])) {
+		case AttributeTypeJSONPayload, AttributeTypeGRPCPayload:
+			return attr["source"] == string(SourceRequest)
+		case AttributeTypeGraphQL:
+			return true
+		}
+		return false
+	})
+}
+
 func ExtractHook(r *http.Request, name string) (structs.HookSpec, bool) {
 	rl, ok := ExtractRule(r)
 	if !ok {
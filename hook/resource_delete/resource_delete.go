@@ -0,0 +1,168 @@
+package resource_delete
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/hook"
+	"github.com/odpf/shield/internal/resource"
+	"github.com/odpf/shield/model"
+	"github.com/odpf/shield/utils"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/salt/log"
+)
+
+// ResourceDelete removes the resource and its relations once the backend
+// has deleted it
+type ResourceDelete struct {
+	log log.Logger
+
+	// To go to next hook
+	next hook.Service
+
+	// To skip all the next hooks and just respond back
+	escape hook.Service
+
+	Deps handler.Deps
+}
+
+func New(log log.Logger, next, escape hook.Service, deps handler.Deps) ResourceDelete {
+	return ResourceDelete{
+		log:    log,
+		next:   next,
+		escape: escape,
+		Deps:   deps,
+	}
+}
+
+// Config names the deleted resource, `resource` and `resource_type`
+// attributes are required, path params of the rule can provide them too
+type Config struct {
+	Attributes map[string]hook.Attribute `yaml:"attributes" mapstructure:"attributes"`
+}
+
+// CompileConfig decodes and validates resource_delete hook config while
+// loading rules
+func CompileConfig(config map[string]interface{}) (interface{}, error) {
+	return parseConfig(config)
+}
+
+func parseConfig(rawConfig map[string]interface{}) (*Config, error) {
+	config := &Config{}
+	if err := mapstructure.Decode(rawConfig, config); err != nil {
+		return nil, err
+	}
+	for id, attr := range config.Attributes {
+		if err := attr.Validate(); err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", id, err)
+		}
+	}
+	return config, nil
+}
+
+func (d ResourceDelete) Info() hook.Info {
+	return hook.Info{
+		Name:        "resource_delete",
+		Description: "hook to delete the resource and its relations",
+	}
+}
+
+func (d ResourceDelete) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	if err != nil {
+		return d.escape.ServeHook(res, err)
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return d.next.ServeHook(res, nil)
+	}
+
+	rule, ok := hook.ExtractRule(res.Request)
+	if !ok {
+		return d.next.ServeHook(res, nil)
+	}
+
+	hookSpec, ok := hook.ExtractHook(res.Request, d.Info().Name)
+	if !ok {
+		return d.next.ServeHook(res, nil)
+	}
+
+	config, ok := hookSpec.Compiled.(*Config)
+	if !ok {
+		var err error
+		if config, err = parseConfig(hookSpec.Config); err != nil {
+			return d.next.ServeHook(res, nil)
+		}
+	}
+
+	// the backend has deleted the resource already, failing here would only
+	// hide that from the client, so errors are logged and the chain goes on
+	if rule.Backend.Namespace == "" {
+		d.log.Error("hook: namespace variable not defined in rules")
+		return d.next.ServeHook(res, nil)
+	}
+
+	attributes, err := hook.ExtractAttributes(res, rule, config.Attributes)
+	if err != nil {
+		d.log.Error("hook: failed to extract attributes", "err", err)
+		return d.next.ServeHook(res, nil)
+	}
+
+	ids, err := resourceIds(rule.Backend.Namespace, attributes)
+	if err != nil {
+		d.log.Error("hook: failed to resolve resource", "err", err)
+		return d.next.ServeHook(res, nil)
+	}
+
+	for _, id := range ids {
+		err := d.Deps.V1beta1.ResourceService.Delete(res.Request.Context(), id)
+		switch {
+		case errors.Is(err, resource.ResourceDoesntExist):
+			d.log.Info("hook: resource not found, nothing to delete", "resource", id)
+		case err != nil:
+			d.log.Error("hook: failed to delete resource", "resource", id, "err", err)
+		default:
+			d.log.Info(fmt.Sprintf("Resource %s deleted", id))
+		}
+	}
+
+	return d.next.ServeHook(res, nil)
+}
+
+// resourceIds resolves ids of the resources named by the attributes, the
+// resource attribute may hold a list
+func resourceIds(backendNamespace string, attributes map[string]interface{}) ([]string, error) {
+	resourceType, ok := attributes["resource_type"].(string)
+	if !ok || resourceType == "" {
+		return nil, errors.New("resource_type is required")
+	}
+
+	var names []string
+	switch value := attributes["resource"].(type) {
+	case string:
+		names = append(names, value)
+	case []string:
+		names = append(names, value...)
+	case []interface{}:
+		for _, name := range value {
+			str, ok := name.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported resource name %v", name)
+			}
+			names = append(names, str)
+		}
+	}
+	if len(names) == 0 {
+		return nil, errors.New("resource is required")
+	}
+
+	var ids []string
+	for _, name := range names {
+		ids = append(ids, utils.CreateResourceId(model.Resource{
+			Name:        name,
+			NamespaceId: utils.CreateNamespaceID(backendNamespace, resourceType),
+		}))
+	}
+	return ids, nil
+}
@@ -0,0 +1,93 @@
+package resource_delete
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/odpf/salt/log"
+	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/api/handler/v1beta1"
+	"github.com/odpf/shield/hook"
+	"github.com/odpf/shield/internal/resource"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockResourceService struct {
+	v1beta1.ResourceService
+	deleted *[]string
+	err     error
+}
+
+func (m mockResourceService) Delete(ctx context.Context, id string) error {
+	*m.deleted = append(*m.deleted, id)
+	return m.err
+}
+
+func deleteResponse(status int) *http.Response {
+	req := httptest.NewRequest(http.MethodDelete, "/api/books/b1", nil)
+	middleware.EnrichPathParams(req, map[string]string{"resource": "b1"})
+	middleware.EnrichRule(req, &structs.Rule{
+		Backend: structs.Backend{Namespace: "library"},
+		Hooks: structs.HookSpecs{{Name: "resource_delete", Config: map[string]interface{}{
+			"attributes": map[string]interface{}{
+				"resource_type": map[string]interface{}{"type": "constant", "value": "book"},
+			},
+		}}},
+	})
+	return &http.Response{StatusCode: status, Header: http.Header{}, Request: req}
+}
+
+func TestResourceDelete(t *testing.T) {
+	var deleted []string
+	deps := handler.Deps{V1beta1: v1beta1.Dep{ResourceService: mockResourceService{deleted: &deleted}}}
+	rootHook := hook.New()
+	resourceDelete := New(log.NewNoop(), rootHook, rootHook, deps)
+
+	res, err := resourceDelete.ServeHook(deleteResponse(http.StatusNoContent), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+	assert.Equal(t, []string{"r/library_book/b1"}, deleted)
+
+	// nothing is deleted when the backend failed
+	deleted = nil
+	_, err = resourceDelete.ServeHook(deleteResponse(http.StatusForbidden), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, deleted)
+
+	// the response is kept when the resource can't be deleted
+	for _, deleteErr := range []error{resource.ResourceDoesntExist, errors.New("db down")} {
+		deps.V1beta1.ResourceService = mockResourceService{deleted: &deleted, err: deleteErr}
+		resourceDelete = New(log.NewNoop(), rootHook, rootHook, deps)
+		res, err = resourceDelete.ServeHook(deleteResponse(http.StatusOK), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+	}
+
+	// the response is kept when the resource can't be resolved
+	deleted = nil
+	unresolved := deleteResponse(http.StatusOK)
+	middleware.EnrichPathParams(unresolved.Request, map[string]string{})
+	res, err = resourceDelete.ServeHook(unresolved, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Empty(t, deleted)
+}
+
+func TestResourceIds(t *testing.T) {
+	ids, err := resourceIds("library", map[string]interface{}{
+		"resource":      []interface{}{"b1", "b2"},
+		"resource_type": "book",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"r/library_book/b1", "r/library_book/b2"}, ids)
+
+	_, err = resourceIds("library", map[string]interface{}{"resource": "b1"})
+	assert.Error(t, err)
+	_, err = resourceIds("library", map[string]interface{}{"resource_type": "book"})
+	assert.Error(t, err)
+}
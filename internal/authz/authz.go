@@ -2,14 +2,21 @@ package authz
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/odpf/shield/model"
 
 	"github.com/odpf/salt/log"
 	"github.com/odpf/shield/config"
+	"github.com/odpf/shield/internal/authz/local"
 	"github.com/odpf/shield/internal/authz/spicedb"
 )
 
+const (
+	EngineSpiceDB = "spicedb"
+	EngineLocal   = "local"
+)
+
 type Policy interface {
 	AddPolicy(ctx context.Context, schema string) error
 }
@@ -18,22 +25,85 @@ type Permission interface {
 	AddRelation(ctx context.Context, relation model.Relation) error
 	DeleteRelation(ctx context.Context, relation model.Relation) error
 	CheckRelation(ctx context.Context, relation model.Relation, action model.Action) (bool, error)
+	// LookupResources returns ids of objects in the object namespace of
+	// relation on which its subject has the action
+	LookupResources(ctx context.Context, relation model.Relation, action model.Action) ([]string, error)
+}
+
+// Explainer tells how a permission check was evaluated, it walks the schema
+// against relations stored by shield whichever engine is used for checks
+// and reports the decision of that engine
+type Explainer interface {
+	ExplainRelation(ctx context.Context, relation model.Relation, action model.Action) (*model.Explanation, error)
 }
 
 type Authz struct {
 	Policy
 	Permission
+	Explainer
+}
+
+// mirroredPolicy writes the schema to SpiceDB and to the local evaluator
+// used for explaining permissions
+type mirroredPolicy struct {
+	primary Policy
+	mirror  Policy
 }
 
-func New(config *config.Shield, logger log.Logger) *Authz {
+func (p mirroredPolicy) AddPolicy(ctx context.Context, schema string) error {
+	if err := p.primary.AddPolicy(ctx, schema); err != nil {
+		return err
+	}
+	return p.mirror.AddPolicy(ctx, schema)
+}
+
+func New(config *config.Shield, logger log.Logger, relationStore local.RelationStore) *Authz {
+	switch config.Authz.Engine {
+	case "", EngineSpiceDB:
+	case EngineLocal:
+		logger.Info("using local authz engine backed by shield relations")
+		engine := local.New(relationStore, logger)
+		return &Authz{
+			engine.Policy,
+			engine.Permission,
+			engine.Permission,
+		}
+	default:
+		logger.Fatal(fmt.Sprintf("unknown authz engine: %s", config.Authz.Engine))
+	}
+
 	spice, err := spicedb.New(config.SpiceDB, logger)
 
 	if err != nil {
 		logger.Fatal(err.Error())
 	}
 
+	explainer := local.New(relationStore, logger)
 	return &Authz{
-		spice.Policy,
+		mirroredPolicy{primary: spice.Policy, mirror: explainer.Policy},
 		spice.Permission,
+		checkedExplainer{permission: spice.Permission, explainer: explainer.Permission},
+	}
+}
+
+// checkedExplainer explains with the local evaluator, which only sees the
+// relations mirrored in shield, so the decision is taken from SpiceDB and a
+// tree which doesn't agree with it is flagged
+type checkedExplainer struct {
+	permission Permission
+	explainer  Explainer
+}
+
+func (e checkedExplainer) ExplainRelation(ctx context.Context, relation model.Relation, action model.Action) (*model.Explanation, error) {
+	explanation, err := e.explainer.ExplainRelation(ctx, relation, action)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := e.permission.CheckRelation(ctx, relation, action)
+	if err != nil {
+		return nil, err
 	}
+	explanation.Mismatch = explanation.Allowed != allowed
+	explanation.Allowed = allowed
+	return explanation, nil
 }
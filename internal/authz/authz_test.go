@@ -0,0 +1,50 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/odpf/shield/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type staticPermission struct {
+	Permission
+	allowed bool
+}
+
+func (p staticPermission) CheckRelation(ctx context.Context, relation model.Relation, action model.Action) (bool, error) {
+	return p.allowed, nil
+}
+
+type staticExplainer struct {
+	allowed bool
+}
+
+func (e staticExplainer) ExplainRelation(ctx context.Context, relation model.Relation, action model.Action) (*model.Explanation, error) {
+	return &model.Explanation{Kind: "permission", Allowed: e.allowed}, nil
+}
+
+func TestCheckedExplainer(t *testing.T) {
+	table := []struct {
+		spicedb  bool
+		local    bool
+		mismatch bool
+	}{
+		{spicedb: true, local: true},
+		{spicedb: false, local: false},
+		{spicedb: true, local: false, mismatch: true},
+		{spicedb: false, local: true, mismatch: true},
+	}
+	for _, tt := range table {
+		explainer := checkedExplainer{
+			permission: staticPermission{allowed: tt.spicedb},
+			explainer:  staticExplainer{allowed: tt.local},
+		}
+		explanation, err := explainer.ExplainRelation(context.Background(), model.Relation{}, model.Action{Id: "view"})
+		assert.NoError(t, err)
+		assert.Equal(t, tt.spicedb, explanation.Allowed)
+		assert.Equal(t, tt.mismatch, explanation.Mismatch)
+	}
+}
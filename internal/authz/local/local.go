@@ -0,0 +1,318 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/odpf/shield/model"
+	"github.com/odpf/shield/pkg/utils"
+
+	"github.com/odpf/salt/log"
+)
+
+// maxCheckDepth bounds the recursion while walking relations, mirrors the
+// default dispatch depth of SpiceDB
+const maxCheckDepth = 50
+
+var (
+	ErrMaxDepthExceeded = errors.New("max depth exceeded while evaluating permission")
+	ErrUnknownNamespace = errors.New("namespace not found in schema")
+)
+
+// RelationStore gives read access to the relations persisted by shield, all
+// relations are written to it before they are pushed to the authz engine
+type RelationStore interface {
+	ListObjectRelations(ctx context.Context, objectNamespaceId, objectId, relation string) ([]model.Relation, error)
+	ListObjectIds(ctx context.Context, objectNamespaceId string) ([]string, error)
+}
+
+// Local evaluates permissions in-process by walking the schema generated by
+// schema_generator against relations stored in postgres, it can be used in
+// place of SpiceDB where running one is not possible
+type Local struct {
+	Policy     *Policy
+	Permission *Permission
+}
+
+type schemaHolder struct {
+	mu          sync.RWMutex
+	definitions map[string]namespaceDefinition
+}
+
+func (h *schemaHolder) get(namespace string) (namespaceDefinition, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	def, ok := h.definitions[normalize(namespace)]
+	return def, ok
+}
+
+type Policy struct {
+	schema *schemaHolder
+}
+
+type Permission struct {
+	schema *schemaHolder
+	store  RelationStore
+}
+
+func New(store RelationStore, logger log.Logger) *Local {
+	holder := &schemaHolder{definitions: map[string]namespaceDefinition{}}
+	logger.Debug("local authz engine reads relations stored by shield")
+	return &Local{
+		Policy:     &Policy{schema: holder},
+		Permission: &Permission{schema: holder, store: store},
+	}
+}
+
+// AddPolicy parses the schema and replaces the definitions it contains,
+// definitions not present in the schema are kept as is
+func (p *Policy) AddPolicy(ctx context.Context, schema string) error {
+	definitions, err := parseSchema(schema)
+	if err != nil {
+		return err
+	}
+
+	p.schema.mu.Lock()
+	defer p.schema.mu.Unlock()
+	for name, def := range definitions {
+		p.schema.definitions[name] = def
+	}
+	return nil
+}
+
+// AddRelation is a no-op as relations are already persisted in postgres
+func (p Permission) AddRelation(ctx context.Context, relation model.Relation) error {
+	return nil
+}
+
+// DeleteRelation is a no-op as relations are already removed from postgres
+func (p Permission) DeleteRelation(ctx context.Context, relation model.Relation) error {
+	return nil
+}
+
+func (p Permission) CheckRelation(ctx context.Context, relation model.Relation, action model.Action) (bool, error) {
+	subject := object{
+		namespace: utils.DefaultStringIfEmpty(relation.SubjectNamespace.Id, relation.SubjectNamespaceId),
+		id:        relation.SubjectId,
+	}
+	resource := object{
+		namespace: utils.DefaultStringIfEmpty(relation.ObjectNamespace.Id, relation.ObjectNamespaceId),
+		id:        relation.ObjectId,
+	}
+	return p.check(ctx, resource, normalize(action.Id), subject, 0, nil)
+}
+
+// ExplainRelation evaluates the action like CheckRelation and returns the
+// tree of permissions and relations walked to reach the result
+func (p Permission) ExplainRelation(ctx context.Context, relation model.Relation, action model.Action) (*model.Explanation, error) {
+	subject := object{
+		namespace: utils.DefaultStringIfEmpty(relation.SubjectNamespace.Id, relation.SubjectNamespaceId),
+		id:        relation.SubjectId,
+	}
+	resource := object{
+		namespace: utils.DefaultStringIfEmpty(relation.ObjectNamespace.Id, relation.ObjectNamespaceId),
+		id:        relation.ObjectId,
+	}
+
+	root := &model.Explanation{}
+	allowed, err := p.check(ctx, resource, normalize(action.Id), subject, 0, root)
+	if err != nil {
+		return nil, err
+	}
+	if len(root.Children) == 0 {
+		// action is neither a permission nor a relation of the namespace
+		return &model.Explanation{Kind: "permission", Object: resource.namespace + ":" + resource.id, Name: normalize(action.Id)}, nil
+	}
+	explanation := root.Children[0]
+	explanation.Allowed = allowed
+	return explanation, nil
+}
+
+// LookupResources checks the action on every object of the namespace which
+// has a relation, unlike SpiceDB it doesn't walk relations in reverse
+func (p Permission) LookupResources(ctx context.Context, relation model.Relation, action model.Action) ([]string, error) {
+	subject := object{
+		namespace: utils.DefaultStringIfEmpty(relation.SubjectNamespace.Id, relation.SubjectNamespaceId),
+		id:        relation.SubjectId,
+	}
+	namespace := utils.DefaultStringIfEmpty(relation.ObjectNamespace.Id, relation.ObjectNamespaceId)
+
+	objectIds, err := p.store.ListObjectIds(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, id := range objectIds {
+		allowed, err := p.check(ctx, object{namespace: namespace, id: id}, normalize(action.Id), subject, 0, nil)
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+type object struct {
+	namespace string
+	id        string
+}
+
+func (o object) is(other object) bool {
+	return normalize(o.namespace) == normalize(other.namespace) && o.id == other.id
+}
+
+// check tells if subject has the permission or relation named name over
+// resource, the evaluation is recorded under parent when it isn't nil
+func (p Permission) check(ctx context.Context, resource object, name string, subject object, depth int, parent *model.Explanation) (bool, error) {
+	if depth > maxCheckDepth {
+		return false, ErrMaxDepthExceeded
+	}
+
+	def, ok := p.schema.get(resource.namespace)
+	if !ok {
+		return false, fmt.Errorf("%w: %s", ErrUnknownNamespace, resource.namespace)
+	}
+
+	if expr, ok := def.permissions[name]; ok {
+		node := explain(parent, "permission", resource, name)
+		if node != nil {
+			node.Expression = expr.String()
+		}
+		allowed, err := p.evaluate(ctx, def, resource, expr, subject, depth+1, node)
+		return record(node, allowed), err
+	}
+	if _, ok := def.relations[name]; !ok {
+		// SpiceDB reports no permission for an unknown relation on the
+		// resource, keeping it the same here
+		return false, nil
+	}
+
+	node := explain(parent, "relation", resource, name)
+	relations, err := p.store.ListObjectRelations(ctx, resource.namespace, resource.id, name)
+	if err != nil {
+		return false, err
+	}
+	for _, rel := range relations {
+		relSubject := object{namespace: rel.SubjectNamespaceId, id: rel.SubjectId}
+		if relSubject.is(subject) {
+			record(explain(node, "subject", relSubject, ""), true)
+			return record(node, true), nil
+		}
+
+		subjectRelation := def.subjectRelation(name, normalize(relSubject.namespace))
+		if subjectRelation == "" {
+			explain(node, "subject", relSubject, "")
+			continue
+		}
+		allowed, err := p.check(ctx, relSubject, subjectRelation, subject, depth+1, node)
+		if err != nil {
+			return false, err
+		}
+		if allowed {
+			return record(node, true), nil
+		}
+	}
+	return false, nil
+}
+
+func (p Permission) evaluate(ctx context.Context, def namespaceDefinition, resource object, expr *expression, subject object, depth int, parent *model.Explanation) (bool, error) {
+	switch expr.op {
+	case operationUnion:
+		node := explainExpression(parent, "union", resource, expr)
+		for _, child := range expr.children {
+			allowed, err := p.evaluate(ctx, def, resource, child, subject, depth, node)
+			if err != nil || allowed {
+				return record(node, allowed), err
+			}
+		}
+		return false, nil
+	case operationIntersection:
+		node := explainExpression(parent, "intersection", resource, expr)
+		for _, child := range expr.children {
+			allowed, err := p.evaluate(ctx, def, resource, child, subject, depth, node)
+			if err != nil || !allowed {
+				return false, err
+			}
+		}
+		return record(node, true), nil
+	case operationExclusion:
+		node := explainExpression(parent, "exclusion", resource, expr)
+		allowed, err := p.evaluate(ctx, def, resource, expr.children[0], subject, depth, node)
+		if err != nil || !allowed {
+			return false, err
+		}
+		excluded, err := p.evaluate(ctx, def, resource, expr.children[1], subject, depth, node)
+		if err != nil {
+			return false, err
+		}
+		return record(node, !excluded), nil
+	}
+
+	if expr.tupleset == "" {
+		return p.check(ctx, resource, expr.relation, subject, depth, parent)
+	}
+
+	node := explainExpression(parent, "tuple_to_userset", resource, expr)
+	tuples, err := p.store.ListObjectRelations(ctx, resource.namespace, resource.id, expr.tupleset)
+	if err != nil {
+		return false, err
+	}
+	for _, rel := range tuples {
+		parentObject := object{namespace: rel.SubjectNamespaceId, id: rel.SubjectId}
+		parentDef, ok := p.schema.get(parentObject.namespace)
+		if !ok {
+			continue
+		}
+		if _, ok := parentDef.permissions[expr.relation]; !ok {
+			if _, ok := parentDef.relations[expr.relation]; !ok {
+				continue
+			}
+		}
+		allowed, err := p.check(ctx, parentObject, expr.relation, subject, depth, node)
+		if err != nil {
+			return false, err
+		}
+		if allowed {
+			return record(node, true), nil
+		}
+	}
+	return false, nil
+}
+
+// explain adds a node under parent, nothing is recorded if parent is nil
+// which is the case for plain checks
+func explain(parent *model.Explanation, kind string, o object, name string) *model.Explanation {
+	if parent == nil {
+		return nil
+	}
+	node := &model.Explanation{Kind: kind, Object: o.namespace + ":" + o.id, Name: name}
+	parent.Children = append(parent.Children, node)
+	return node
+}
+
+func explainExpression(parent *model.Explanation, kind string, o object, expr *expression) *model.Explanation {
+	node := explain(parent, kind, o, "")
+	if node != nil {
+		node.Expression = expr.String()
+	}
+	return node
+}
+
+func record(node *model.Explanation, allowed bool) bool {
+	if node != nil {
+		node.Allowed = allowed
+	}
+	return allowed
+}
+
+// normalize converts ids the same way schema_generator does while building
+// the schema and relationships
+func normalize(id string) string {
+	return strings.ReplaceAll(id, "-", "_")
+}
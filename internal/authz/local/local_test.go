@@ -0,0 +1,215 @@
+package local
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/odpf/salt/log"
+	"github.com/odpf/shield/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSchema = `definition shield/user {}
+
+definition shield/team {
+	relation team_member: shield/user
+	relation team_admin: shield/user
+	relation shield/organization: shield/organization
+	permission view_member = team_member + team_admin + shield/organization->organization_admin
+}
+
+definition shield/organization {
+	relation organization_admin: shield/user
+}
+
+definition entropy/firehose {
+	relation shield/project: shield/project
+	relation entropy_firehose_viewer: shield/user | shield/team#team_member
+	relation entropy_firehose_banned: shield/user
+	permission entropy_firehose_view = (entropy_firehose_viewer + shield/project->project_admin) - entropy_firehose_banned
+}
+
+definition shield/project {
+	relation project_admin: shield/user
+}`
+
+type relationStore []model.Relation
+
+func (s relationStore) ListObjectRelations(ctx context.Context, objectNamespaceId, objectId, relation string) ([]model.Relation, error) {
+	var relations []model.Relation
+	for _, r := range s {
+		if r.ObjectNamespaceId == objectNamespaceId && r.ObjectId == objectId && strings.ReplaceAll(r.RoleId, "-", "_") == relation {
+			relations = append(relations, r)
+		}
+	}
+	return relations, nil
+}
+
+func (s relationStore) ListObjectIds(ctx context.Context, objectNamespaceId string) ([]string, error) {
+	var ids []string
+	seen := map[string]bool{}
+	for _, r := range s {
+		if r.ObjectNamespaceId == objectNamespaceId && !seen[r.ObjectId] {
+			seen[r.ObjectId] = true
+			ids = append(ids, r.ObjectId)
+		}
+	}
+	return ids, nil
+}
+
+func rel(objectNs, objectId, role, subjectNs, subjectId string) model.Relation {
+	return model.Relation{
+		ObjectNamespaceId:  objectNs,
+		ObjectId:           objectId,
+		RoleId:             role,
+		SubjectNamespaceId: subjectNs,
+		SubjectId:          subjectId,
+	}
+}
+
+func TestParseSchema(t *testing.T) {
+	definitions, err := parseSchema(testSchema)
+	assert.NoError(t, err)
+	assert.Len(t, definitions, 5)
+
+	team := definitions["shield/team"]
+	assert.Equal(t, "(team_member + team_admin + shield/organization->organization_admin)", team.permissions["view_member"].String())
+
+	firehose := definitions["entropy/firehose"]
+	assert.Equal(t, "((entropy_firehose_viewer + shield/project->project_admin) - entropy_firehose_banned)", firehose.permissions["entropy_firehose_view"].String())
+	assert.Equal(t, "team_member", firehose.subjectRelation("entropy_firehose_viewer", "shield/team"))
+	assert.Equal(t, "", firehose.subjectRelation("entropy_firehose_viewer", "shield/user"))
+
+	_, err = parseSchema(`definition shield/team { relation team_member }`)
+	assert.Error(t, err)
+}
+
+func TestCheckRelation(t *testing.T) {
+	store := relationStore{
+		rel("shield/team", "team-1", "team_member", "shield/user", "user-1"),
+		rel("shield/team", "team-1", "shield/organization", "shield/organization", "org-1"),
+		rel("shield/organization", "org-1", "organization_admin", "shield/user", "user-2"),
+		rel("shield/project", "project-1", "project_admin", "shield/user", "user-3"),
+		rel("entropy/firehose", "firehose-1", "shield/project", "shield/project", "project-1"),
+		rel("entropy/firehose", "firehose-1", "entropy_firehose_viewer", "shield/team", "team-1"),
+		rel("entropy/firehose", "firehose-1", "entropy_firehose_banned", "shield/user", "user-2"),
+	}
+
+	engine := New(store, log.NewNoop())
+	assert.NoError(t, engine.Policy.AddPolicy(context.Background(), testSchema))
+
+	table := []struct {
+		title    string
+		object   string
+		objectNs string
+		subject  string
+		action   string
+		want     bool
+	}{
+		{"direct relation", "team-1", "shield/team", "user-1", "view_member", true},
+		{"tuple to userset", "team-1", "shield/team", "user-2", "view_member", true},
+		{"no relation", "team-1", "shield/team", "user-3", "view_member", false},
+		{"subject relation expansion", "firehose-1", "entropy/firehose", "user-1", "entropy_firehose_view", true},
+		{"inherited from parent namespace", "firehose-1", "entropy/firehose", "user-3", "entropy_firehose_view", true},
+		{"excluded subject", "firehose-1", "entropy/firehose", "user-2", "entropy_firehose_view", false},
+		{"unknown permission", "firehose-1", "entropy/firehose", "user-1", "entropy_firehose_delete", false},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.title, func(t *testing.T) {
+			allowed, err := engine.Permission.CheckRelation(context.Background(), model.Relation{
+				ObjectNamespaceId:  tt.objectNs,
+				ObjectId:           tt.object,
+				SubjectNamespaceId: "shield/user",
+				SubjectId:          tt.subject,
+			}, model.Action{Id: tt.action})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, allowed)
+		})
+	}
+
+	_, err := engine.Permission.CheckRelation(context.Background(), model.Relation{
+		ObjectNamespaceId:  "shield/unknown",
+		ObjectId:           "id",
+		SubjectNamespaceId: "shield/user",
+		SubjectId:          "user-1",
+	}, model.Action{Id: "view"})
+	assert.ErrorIs(t, err, ErrUnknownNamespace)
+}
+
+func TestLookupResources(t *testing.T) {
+	store := relationStore{
+		rel("shield/project", "project-1", "project_admin", "shield/user", "user-1"),
+		rel("entropy/firehose", "firehose-1", "shield/project", "shield/project", "project-1"),
+		rel("entropy/firehose", "firehose-2", "entropy_firehose_viewer", "shield/user", "user-1"),
+		rel("entropy/firehose", "firehose-3", "entropy_firehose_viewer", "shield/user", "user-2"),
+		rel("entropy/firehose", "firehose-4", "shield/project", "shield/project", "project-1"),
+		rel("entropy/firehose", "firehose-4", "entropy_firehose_banned", "shield/user", "user-1"),
+	}
+
+	engine := New(store, log.NewNoop())
+	assert.NoError(t, engine.Policy.AddPolicy(context.Background(), testSchema))
+
+	ids, err := engine.Permission.LookupResources(context.Background(), model.Relation{
+		ObjectNamespaceId:  "entropy/firehose",
+		SubjectNamespaceId: "shield/user",
+		SubjectId:          "user-1",
+	}, model.Action{Id: "entropy_firehose_view"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"firehose-1", "firehose-2"}, ids)
+}
+
+func TestExplainRelation(t *testing.T) {
+	store := relationStore{
+		rel("shield/project", "project-1", "project_admin", "shield/user", "user-3"),
+		rel("entropy/firehose", "firehose-1", "shield/project", "shield/project", "project-1"),
+		rel("entropy/firehose", "firehose-1", "entropy_firehose_viewer", "shield/user", "user-1"),
+	}
+
+	engine := New(store, log.NewNoop())
+	assert.NoError(t, engine.Policy.AddPolicy(context.Background(), testSchema))
+
+	explain := func(subject string) *model.Explanation {
+		explanation, err := engine.Permission.ExplainRelation(context.Background(), model.Relation{
+			ObjectNamespaceId:  "entropy/firehose",
+			ObjectId:           "firehose-1",
+			SubjectNamespaceId: "shield/user",
+			SubjectId:          subject,
+		}, model.Action{Id: "entropy_firehose_view"})
+		assert.NoError(t, err)
+		return explanation
+	}
+
+	t.Run("granted through parent project", func(t *testing.T) {
+		explanation := explain("user-3")
+		assert.True(t, explanation.Allowed)
+		assert.Equal(t, "permission", explanation.Kind)
+		assert.Equal(t, "entropy/firehose:firehose-1", explanation.Object)
+
+		exclusion := explanation.Children[0]
+		assert.Equal(t, "exclusion", exclusion.Kind)
+		union := exclusion.Children[0]
+		assert.Equal(t, "union", union.Kind)
+		assert.True(t, union.Allowed)
+
+		viewer, project := union.Children[0], union.Children[1]
+		assert.Equal(t, "entropy_firehose_viewer", viewer.Name)
+		assert.False(t, viewer.Allowed)
+		assert.Equal(t, "tuple_to_userset", project.Kind)
+		assert.True(t, project.Allowed)
+		assert.Equal(t, "shield/project:project-1", project.Children[0].Object)
+		assert.Equal(t, "project_admin", project.Children[0].Name)
+		assert.True(t, project.Children[0].Allowed)
+	})
+
+	t.Run("denied with every path failing", func(t *testing.T) {
+		explanation := explain("user-2")
+		assert.False(t, explanation.Allowed)
+		union := explanation.Children[0].Children[0]
+		assert.Len(t, union.Children, 2)
+		for _, c := range union.Children {
+			assert.False(t, c.Allowed)
+		}
+	})
+}
@@ -0,0 +1,301 @@
+package local
+
+import (
+	"fmt"
+	"strings"
+)
+
+type operation string
+
+const (
+	operationUnion        operation = "+"
+	operationIntersection operation = "&"
+	operationExclusion    operation = "-"
+)
+
+// expression is a node of a permission rewrite, it is either a set operation
+// over children, a computed userset (relation) or a tuple to userset
+// (tupleset->relation)
+type expression struct {
+	op       operation
+	children []*expression
+
+	relation string
+	tupleset string
+}
+
+func (e *expression) String() string {
+	if e.op != "" {
+		var children []string
+		for _, c := range e.children {
+			children = append(children, c.String())
+		}
+		return "(" + strings.Join(children, fmt.Sprintf(" %s ", e.op)) + ")"
+	}
+	if e.tupleset != "" {
+		return fmt.Sprintf("%s->%s", e.tupleset, e.relation)
+	}
+	return e.relation
+}
+
+type allowedType struct {
+	namespace string
+	relation  string
+}
+
+type namespaceDefinition struct {
+	name        string
+	relations   map[string][]allowedType
+	permissions map[string]*expression
+}
+
+// subjectRelation returns the relation a subject of given namespace is expanded
+// with when stored against the relation, e.g. `team#team_member`
+func (d namespaceDefinition) subjectRelation(relation, subjectNamespace string) string {
+	for _, t := range d.relations[relation] {
+		if t.namespace == subjectNamespace && t.relation != "" && t.relation != "..." {
+			return t.relation
+		}
+	}
+	return ""
+}
+
+type token struct {
+	value string
+	pos   int
+}
+
+func tokenize(schema string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(schema); {
+		c := schema[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.HasPrefix(schema[i:], "//"):
+			for i < len(schema) && schema[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(schema[i:], "/*"):
+			end := strings.Index(schema[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment at %d", i)
+			}
+			i += end + 4
+		case strings.HasPrefix(schema[i:], "->"):
+			tokens = append(tokens, token{value: "->", pos: i})
+			i += 2
+		case strings.IndexByte("{}:|#=+&-()", c) >= 0:
+			tokens = append(tokens, token{value: string(c), pos: i})
+			i++
+		case isIdentifierChar(c):
+			start := i
+			for i < len(schema) && isIdentifierChar(schema[i]) {
+				i++
+			}
+			tokens = append(tokens, token{value: schema[start:i], pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at %d", c, i)
+		}
+	}
+	return tokens, nil
+}
+
+func isIdentifierChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' || c == '/' || c == '.'
+}
+
+type schemaParser struct {
+	tokens []token
+	pos    int
+}
+
+// parseSchema parses the schema language emitted by schema_generator.BuildSchema
+// into namespace definitions keyed by their name
+func parseSchema(schema string) (map[string]namespaceDefinition, error) {
+	tokens, err := tokenize(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &schemaParser{tokens: tokens}
+	definitions := map[string]namespaceDefinition{}
+	for !p.done() {
+		def, err := p.parseDefinition()
+		if err != nil {
+			return nil, err
+		}
+		definitions[def.name] = def
+	}
+	return definitions, nil
+}
+
+func (p *schemaParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *schemaParser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.tokens[p.pos].value
+}
+
+func (p *schemaParser) next() string {
+	val := p.peek()
+	p.pos++
+	return val
+}
+
+func (p *schemaParser) expect(value string) error {
+	if got := p.next(); got != value {
+		return p.errorf("expected `%s`, found `%s`", value, got)
+	}
+	return nil
+}
+
+func (p *schemaParser) identifier() (string, error) {
+	val := p.next()
+	if val == "" || !isIdentifierChar(val[0]) {
+		return "", p.errorf("expected identifier, found `%s`", val)
+	}
+	return val, nil
+}
+
+func (p *schemaParser) errorf(format string, args ...interface{}) error {
+	pos := -1
+	if idx := p.pos - 1; idx >= 0 && idx < len(p.tokens) {
+		pos = p.tokens[idx].pos
+	}
+	return fmt.Errorf("schema parse error at %d: %s", pos, fmt.Sprintf(format, args...))
+}
+
+func (p *schemaParser) parseDefinition() (namespaceDefinition, error) {
+	if err := p.expect("definition"); err != nil {
+		return namespaceDefinition{}, err
+	}
+	name, err := p.identifier()
+	if err != nil {
+		return namespaceDefinition{}, err
+	}
+	def := namespaceDefinition{
+		name:        name,
+		relations:   map[string][]allowedType{},
+		permissions: map[string]*expression{},
+	}
+	if err := p.expect("{"); err != nil {
+		return namespaceDefinition{}, err
+	}
+
+	for p.peek() != "}" {
+		switch p.next() {
+		case "relation":
+			relName, types, err := p.parseRelation()
+			if err != nil {
+				return namespaceDefinition{}, err
+			}
+			def.relations[relName] = types
+		case "permission":
+			permName, err := p.identifier()
+			if err != nil {
+				return namespaceDefinition{}, err
+			}
+			if err := p.expect("="); err != nil {
+				return namespaceDefinition{}, err
+			}
+			expr, err := p.parseExpression()
+			if err != nil {
+				return namespaceDefinition{}, err
+			}
+			def.permissions[permName] = expr
+		case "":
+			return namespaceDefinition{}, p.errorf("unterminated definition %s", name)
+		default:
+			return namespaceDefinition{}, p.errorf("expected relation or permission in definition %s", name)
+		}
+	}
+	p.next()
+	return def, nil
+}
+
+func (p *schemaParser) parseRelation() (string, []allowedType, error) {
+	name, err := p.identifier()
+	if err != nil {
+		return "", nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return "", nil, err
+	}
+
+	var types []allowedType
+	for {
+		ns, err := p.identifier()
+		if err != nil {
+			return "", nil, err
+		}
+		t := allowedType{namespace: ns}
+		if p.peek() == "#" {
+			p.next()
+			rel := p.next()
+			if rel == "" {
+				return "", nil, p.errorf("expected subject relation for %s", ns)
+			}
+			t.relation = rel
+		}
+		types = append(types, t)
+
+		if p.peek() != "|" {
+			break
+		}
+		p.next()
+	}
+	return name, types, nil
+}
+
+func (p *schemaParser) parseExpression() (*expression, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := operation(p.peek())
+		if op != operationUnion && op != operationIntersection && op != operationExclusion {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		if left.op == op && op != operationExclusion {
+			left.children = append(left.children, right)
+			continue
+		}
+		left = &expression{op: op, children: []*expression{left, right}}
+	}
+}
+
+func (p *schemaParser) parseTerm() (*expression, error) {
+	if p.peek() == "(" {
+		p.next()
+		expr, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		return expr, p.expect(")")
+	}
+
+	name, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != "->" {
+		return &expression{relation: name}, nil
+	}
+	p.next()
+	rel, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+	return &expression{tupleset: name, relation: rel}, nil
+}
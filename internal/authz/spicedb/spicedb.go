@@ -2,10 +2,14 @@ package spicedb
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/odpf/shield/internal/schema_generator"
+	"github.com/odpf/shield/metrics"
 	"github.com/odpf/shield/model"
+	"github.com/odpf/shield/tracing"
 
 	"github.com/odpf/salt/log"
 
@@ -44,7 +48,12 @@ func (p *Policy) AddPolicy(ctx context.Context, schema string) error {
 
 func New(config config.SpiceDBConfig, logger log.Logger) (*SpiceDB, error) {
 	endpoint := fmt.Sprintf("%s:%s", config.Host, config.Port)
-	client, err := authzed.NewClient(endpoint, grpc.WithInsecure(), grpcutil.WithInsecureBearerToken(config.PreSharedKey))
+	client, err := authzed.NewClient(endpoint,
+		grpc.WithInsecure(),
+		grpcutil.WithInsecureBearerToken(config.PreSharedKey),
+		grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor(), metrics.SpiceDBUnaryInterceptor()),
+		grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor(), metrics.SpiceDBStreamInterceptor()),
+	)
 	if err != nil {
 		return &SpiceDB{}, err
 	}
@@ -108,6 +117,37 @@ func (p Permission) CheckRelation(ctx context.Context, relation model.Relation,
 	return response.Permissionship == pb.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION, nil
 }
 
+func (p Permission) LookupResources(ctx context.Context, relation model.Relation, action model.Action) ([]string, error) {
+	relationship, err := schema_generator.TransformCheckRelation(relation)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &pb.LookupResourcesRequest{
+		ResourceObjectType: relationship.Resource.ObjectType,
+		Permission:         action.Id,
+		Subject:            relationship.Subject,
+	}
+
+	stream, err := p.client.LookupResources(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, response.ResourceObjectId)
+	}
+	return ids, nil
+}
+
 func (p Permission) DeleteRelation(ctx context.Context, relation model.Relation) error {
 	relationship, err := schema_generator.TransformRelation(relation)
 	if err != nil {
@@ -120,7 +120,7 @@ func getResourceRole(r string, ns model.Namespace) model.Role {
 		Id:        roleId,
 		Name:      roleId,
 		Namespace: roleNs,
-		Types:     []string{definition.UserType, definition.TeamMemberType},
+		Types:     []string{definition.UserType, definition.TeamMemberType, definition.ServiceAccountType},
 	}
 	return role
 }
@@ -247,6 +247,7 @@ func (s Service) bootstrapNamespaces(ctx context.Context) {
 		definition.ProjectNamespace,
 		definition.TeamNamespace,
 		definition.UserNamespace,
+		definition.ServiceAccountNamespace,
 	}
 
 	s.createNamespaces(ctx, namespaces)
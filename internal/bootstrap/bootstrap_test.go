@@ -14,7 +14,7 @@ func TestGetResourceRole(t *testing.T) {
 			Id:        "team_admin",
 			Name:      "team_admin",
 			Namespace: model.Namespace{Id: "team"},
-			Types:     []string{"user", "team#team_member"},
+			Types:     []string{"user", "team#team_member", "service_account"},
 		}
 		assert.EqualValues(t, expected, output)
 	})
@@ -25,7 +25,7 @@ func TestGetResourceRole(t *testing.T) {
 			Id:        "organization_admin",
 			Name:      "organization_admin",
 			Namespace: model.Namespace{Id: "organization"},
-			Types:     []string{"user", "team#team_member"},
+			Types:     []string{"user", "team#team_member", "service_account"},
 		}
 		assert.EqualValues(t, expected, output)
 	})
@@ -21,3 +21,8 @@ var UserNamespace = model.Namespace{
 	Id:   "user",
 	Name: "User",
 }
+
+var ServiceAccountNamespace = model.Namespace{
+	Id:   "service_account",
+	Name: "Service Account",
+}
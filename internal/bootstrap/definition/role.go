@@ -7,22 +7,23 @@ import (
 )
 
 var (
-	UserType       = UserNamespace.Id
-	TeamMemberType = fmt.Sprintf("%s#%s", TeamNamespace.Id, TeamMemberRole.Id)
+	UserType           = UserNamespace.Id
+	ServiceAccountType = ServiceAccountNamespace.Id
+	TeamMemberType     = fmt.Sprintf("%s#%s", TeamNamespace.Id, TeamMemberRole.Id)
 )
 
 var OrganizationAdminRole = model.Role{
 	Name:        "Organization Admin",
 	Id:          "organization_admin",
 	NamespaceId: OrgNamespace.Id,
-	Types:       []string{UserType, TeamMemberType},
+	Types:       []string{UserType, TeamMemberType, ServiceAccountType},
 }
 
 var ProjectAdminRole = model.Role{
 	Name:        "Project Admin",
 	Id:          "project_admin",
 	NamespaceId: ProjectNamespace.Id,
-	Types:       []string{UserType, TeamMemberType},
+	Types:       []string{UserType, TeamMemberType, ServiceAccountType},
 }
 
 var TeamAdminRole = model.Role{
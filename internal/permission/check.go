@@ -2,25 +2,206 @@ package permission
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 
+	"github.com/odpf/shield/internal/bootstrap/definition"
+	"github.com/odpf/shield/metrics"
 	"github.com/odpf/shield/model"
+	"github.com/odpf/shield/tracing"
 	"github.com/odpf/shield/utils"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// checkConcurrency bounds the permission checks of a batch made in parallel
+const checkConcurrency = 10
+
 type CheckService struct {
 	PermissionsService Permissions
 }
 
+// ResourceAction is a single check of a batch
+type ResourceAction struct {
+	Resource model.Resource
+	Action   model.Action
+}
+
 func NewCheckService(permissionService Permissions) CheckService {
 	return CheckService{PermissionsService: permissionService}
 }
 
 func (c CheckService) CheckAuthz(ctx context.Context, resource model.Resource, action model.Action) (bool, error) {
-	user, err := c.PermissionsService.FetchCurrentUser(ctx)
+	ctx, span := tracing.Tracer().Start(ctx, "permission.CheckAuthz")
+	check, err := c.subjectCheck(ctx)
 	if err != nil {
+		tracing.EndSpan(span, err)
 		return false, err
 	}
 
 	resource.Id = utils.CreateResourceId(resource)
-	return c.PermissionsService.CheckPermission(ctx, user, resource, action)
+	allowed, err := check(ctx, resource, action)
+	observeCheck(resource, action, allowed, err)
+	endCheckSpan(span, resource, action, allowed, err)
+	return allowed, err
+}
+
+// CheckAuthzBatch checks all resource and action pairs for the current
+// subject, which is resolved once for the batch. Results are in the order of
+// checks, the batch fails on the first error.
+func (c CheckService) CheckAuthzBatch(ctx context.Context, checks []ResourceAction) ([]bool, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "permission.CheckAuthzBatch", trace.WithAttributes(attribute.Int("authz.checks", len(checks))))
+	results, err := c.checkAuthzBatch(ctx, checks)
+	tracing.EndSpan(span, err)
+	return results, err
+}
+
+func (c CheckService) checkAuthzBatch(ctx context.Context, checks []ResourceAction) ([]bool, error) {
+	results := make([]bool, len(checks))
+	if len(checks) == 0 {
+		return results, nil
+	}
+
+	check, err := c.subjectCheck(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	indexes := make(chan int)
+
+	workers := checkConcurrency
+	if len(checks) < workers {
+		workers = len(checks)
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				resource := checks[i].Resource
+				resource.Id = utils.CreateResourceId(resource)
+				checkCtx, span := tracing.Tracer().Start(ctx, "permission.Check")
+				allowed, err := check(checkCtx, resource, checks[i].Action)
+				// checks cancelled after the batch failed aren't decisions
+				if !errors.Is(err, context.Canceled) {
+					observeCheck(resource, checks[i].Action, allowed, err)
+				}
+				endCheckSpan(span, resource, checks[i].Action, allowed, err)
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				results[i] = allowed
+			}
+		}()
+	}
+
+feed:
+	for i := range checks {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ExplainAuthz returns the evaluation tree of the action on resource for the
+// user, or for the current user or service account if userId is empty
+func (c CheckService) ExplainAuthz(ctx context.Context, resource model.Resource, action model.Action, userId string) (*model.Explanation, error) {
+	resource.Id = utils.CreateResourceId(resource)
+	if serviceAccount, ok := GetServiceAccountFromContext(ctx); ok && userId == "" {
+		return c.PermissionsService.ExplainServiceAccountPermission(ctx, serviceAccount, resource, action)
+	}
+
+	user := model.User{Id: userId}
+	if user.Id == "" {
+		var err error
+		if user, err = c.PermissionsService.FetchCurrentUser(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return c.PermissionsService.ExplainPermission(ctx, user, resource, action)
+}
+
+// CheckOrgAdmin tells if the current user, or the service account of the
+// API key, can manage the organization
+func (c CheckService) CheckOrgAdmin(ctx context.Context, orgId string) (bool, error) {
+	return c.checkSubject(ctx, model.Resource{Id: orgId, Namespace: definition.OrgNamespace}, definition.ManageOrganizationAction)
+}
+
+// CheckProjectAdmin tells if the current user, or the service account of
+// the API key, can manage the project
+func (c CheckService) CheckProjectAdmin(ctx context.Context, projectId string) (bool, error) {
+	return c.checkSubject(ctx, model.Resource{Id: projectId, Namespace: definition.ProjectNamespace}, definition.ManageProjectAction)
+}
+
+// CheckResourceAdmin tells if the current user, or the service account of
+// the API key, has every action on the resource, the all_actions action is
+// onboarded with each resource namespace
+func (c CheckService) CheckResourceAdmin(ctx context.Context, resource model.Resource) (bool, error) {
+	return c.checkSubject(ctx, resource, model.Action{Id: fmt.Sprintf("%s_all_actions", resource.NamespaceId)})
+}
+
+func (c CheckService) checkSubject(ctx context.Context, resource model.Resource, action model.Action) (bool, error) {
+	check, err := c.subjectCheck(ctx)
+	if err != nil {
+		return false, err
+	}
+	return check(ctx, resource, action)
+}
+
+func observeCheck(resource model.Resource, action model.Action, allowed bool, err error) {
+	metrics.ObserveAuthzDecision(resource.NamespaceId, action.Id, allowed, err)
+}
+
+func endCheckSpan(span trace.Span, resource model.Resource, action model.Action, allowed bool, err error) {
+	span.SetAttributes(
+		attribute.String("authz.namespace", resource.NamespaceId),
+		attribute.String("authz.resource", resource.Id),
+		attribute.String("authz.action", action.Id),
+		attribute.Bool("authz.allowed", allowed),
+	)
+	tracing.EndSpan(span, err)
+}
+
+// subjectCheck resolves who the request is made by, the service account of
+// an API key or the current user, and returns a check for that subject
+func (c CheckService) subjectCheck(ctx context.Context) (func(context.Context, model.Resource, model.Action) (bool, error), error) {
+	if serviceAccount, ok := GetServiceAccountFromContext(ctx); ok {
+		return func(ctx context.Context, resource model.Resource, action model.Action) (bool, error) {
+			return c.PermissionsService.CheckServiceAccountPermission(ctx, serviceAccount, resource, action)
+		}, nil
+	}
+
+	user, err := c.PermissionsService.FetchCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, resource model.Resource, action model.Action) (bool, error) {
+		return c.PermissionsService.CheckPermission(ctx, user, resource, action)
+	}, nil
 }
@@ -0,0 +1,133 @@
+package permission
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/odpf/shield/model"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type mockPermissions struct {
+	Permissions
+	userFetches  int32
+	checkErr     error
+	allowedNames map[string]bool
+}
+
+func (m *mockPermissions) FetchCurrentUser(ctx context.Context) (model.User, error) {
+	atomic.AddInt32(&m.userFetches, 1)
+	return model.User{Id: "user-1"}, nil
+}
+
+func (m *mockPermissions) CheckPermission(ctx context.Context, user model.User, resource model.Resource, action model.Action) (bool, error) {
+	if m.checkErr != nil && resource.Name == "broken" {
+		return false, m.checkErr
+	}
+	name := resource.Name
+	if name == "" {
+		name = resource.Id
+	}
+	return m.allowedNames[name+"/"+action.Id], nil
+}
+
+func (m *mockPermissions) CheckServiceAccountPermission(ctx context.Context, serviceAccount model.ServiceAccount, resource model.Resource, action model.Action) (bool, error) {
+	return m.allowedNames[serviceAccount.Id+":"+resource.Id+"/"+action.Id], nil
+}
+
+func TestCheckAuthzBatch(t *testing.T) {
+	mock := &mockPermissions{allowedNames: map[string]bool{}}
+	var checks []ResourceAction
+	for i := 0; i < 100; i++ {
+		name := string(rune('a'+i%26)) + string(rune('a'+i/26))
+		if i%3 == 0 {
+			mock.allowedNames[name+"/view"] = true
+		}
+		checks = append(checks, ResourceAction{
+			Resource: model.Resource{Name: name, NamespaceId: "entropy/firehose"},
+			Action:   model.Action{Id: "view"},
+		})
+	}
+
+	results, err := NewCheckService(mock).CheckAuthzBatch(context.Background(), checks)
+	assert.NoError(t, err)
+	assert.Len(t, results, len(checks))
+	for i, allowed := range results {
+		assert.Equal(t, i%3 == 0, allowed, checks[i].Resource.Name)
+	}
+	assert.Equal(t, int32(1), mock.userFetches)
+
+	t.Run("fails on error of any check", func(t *testing.T) {
+		mock.checkErr = errors.New("spicedb unavailable")
+		checks := append(checks, ResourceAction{Resource: model.Resource{Name: "broken"}, Action: model.Action{Id: "view"}})
+		_, err := NewCheckService(mock).CheckAuthzBatch(context.Background(), checks)
+		assert.ErrorIs(t, err, mock.checkErr)
+	})
+}
+
+func TestCheckAuthzBatchTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	mock := &mockPermissions{allowedNames: map[string]bool{"b1/view": true}}
+	checks := []ResourceAction{
+		{Resource: model.Resource{Name: "b1", NamespaceId: "library/book"}, Action: model.Action{Id: "view"}},
+		{Resource: model.Resource{Name: "b2", NamespaceId: "library/book"}, Action: model.Action{Id: "view"}},
+	}
+	_, err := NewCheckService(mock).CheckAuthzBatch(context.Background(), checks)
+	assert.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 3)
+	batch := spans[len(spans)-1]
+	assert.Equal(t, "permission.CheckAuthzBatch", batch.Name)
+	allowed := map[string]bool{}
+	for _, span := range spans[:2] {
+		assert.Equal(t, "permission.Check", span.Name)
+		assert.Equal(t, batch.SpanContext.SpanID(), span.Parent.SpanID())
+		attrs := map[attribute.Key]attribute.Value{}
+		for _, attr := range span.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		allowed[attrs["authz.resource"].AsString()] = attrs["authz.allowed"].AsBool()
+	}
+	assert.Equal(t, map[string]bool{"r/library/book/b1": true, "r/library/book/b2": false}, allowed)
+}
+
+func TestCheckAdmin(t *testing.T) {
+	mock := &mockPermissions{allowedNames: map[string]bool{
+		"org-1/manage_organization":       true,
+		"project-1/manage_project":        true,
+		"sa-1:org-2/manage_organization":  true,
+		"book-1/library_book_all_actions": true,
+	}}
+	checkService := NewCheckService(mock)
+	ctx := context.Background()
+
+	allowed, err := checkService.CheckOrgAdmin(ctx, "org-1")
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+	allowed, _ = checkService.CheckOrgAdmin(ctx, "org-2")
+	assert.False(t, allowed)
+	allowed, _ = checkService.CheckProjectAdmin(ctx, "project-1")
+	assert.True(t, allowed)
+	allowed, _ = checkService.CheckResourceAdmin(ctx, model.Resource{Name: "book-1", NamespaceId: "library_book"})
+	assert.True(t, allowed)
+	allowed, _ = checkService.CheckResourceAdmin(ctx, model.Resource{Name: "book-2", NamespaceId: "library_book"})
+	assert.False(t, allowed)
+
+	// the service account of an API key is checked instead of the user
+	saCtx := SetServiceAccountToContext(ctx, model.ServiceAccount{Id: "sa-1"})
+	allowed, _ = checkService.CheckOrgAdmin(saCtx, "org-2")
+	assert.True(t, allowed)
+	allowed, _ = checkService.CheckOrgAdmin(saCtx, "org-1")
+	assert.False(t, allowed)
+	allowed, _ = checkService.CheckProjectAdmin(saCtx, "project-2")
+	assert.False(t, allowed)
+}
@@ -16,6 +16,7 @@ type Store interface {
 	CreateRelation(ctx context.Context, relation model.Relation) (model.Relation, error)
 	GetRelationByFields(ctx context.Context, relation model.Relation) (model.Relation, error)
 	DeleteRelationById(ctx context.Context, id string) error
+	ListResourceRelations(ctx context.Context, namespaceId, id string) ([]model.Relation, error)
 }
 
 type Service struct {
@@ -38,8 +39,15 @@ type Permissions interface {
 	AddOwnerToResource(ctx context.Context, user model.User, resource model.Resource) error
 	AddProjectToResource(ctx context.Context, project model.Project, resource model.Resource) error
 	AddOrgToResource(ctx context.Context, org model.Organization, resource model.Resource) error
+	RemoveResourceRelations(ctx context.Context, resource model.Resource) error
+	RemoveServiceAccountRelations(ctx context.Context, serviceAccount model.ServiceAccount) error
 	FetchCurrentUser(ctx context.Context) (model.User, error)
 	CheckPermission(ctx context.Context, user model.User, resource model.Resource, action model.Action) (bool, error)
+	CheckServiceAccountPermission(ctx context.Context, serviceAccount model.ServiceAccount, resource model.Resource, action model.Action) (bool, error)
+	LookupResources(ctx context.Context, user model.User, namespaceId string, action model.Action) ([]string, error)
+	ExplainPermission(ctx context.Context, user model.User, resource model.Resource, action model.Action) (*model.Explanation, error)
+	LookupServiceAccountResources(ctx context.Context, serviceAccount model.ServiceAccount, namespaceId string, action model.Action) ([]string, error)
+	ExplainServiceAccountPermission(ctx context.Context, serviceAccount model.ServiceAccount, resource model.Resource, action model.Action) (*model.Explanation, error)
 }
 
 func (s Service) addRelation(ctx context.Context, rel model.Relation) error {
@@ -225,6 +233,37 @@ func (s Service) AddOrgToResource(ctx context.Context, org model.Organization, r
 	return s.addRelation(ctx, rel)
 }
 
+// RemoveResourceRelations deletes every relation the resource is the object
+// or subject of from the authz engine and the store
+func (s Service) RemoveResourceRelations(ctx context.Context, resource model.Resource) error {
+	nsId := utils.DefaultStringIfEmpty(resource.NamespaceId, resource.Namespace.Id)
+	return s.removeRelations(ctx, nsId, resource.Id)
+}
+
+// RemoveServiceAccountRelations deletes every relation the service account
+// is the subject of, e.g. roles granted to it, from both the authz engine
+// and the store
+func (s Service) RemoveServiceAccountRelations(ctx context.Context, serviceAccount model.ServiceAccount) error {
+	return s.removeRelations(ctx, definition.ServiceAccountNamespace.Id, serviceAccount.Id)
+}
+
+func (s Service) removeRelations(ctx context.Context, namespaceId, id string) error {
+	relations, err := s.Store.ListResourceRelations(ctx, namespaceId, id)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range relations {
+		if err := s.Authz.Permission.DeleteRelation(ctx, rel); err != nil {
+			return err
+		}
+		if err := s.Store.DeleteRelationById(ctx, rel.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s Service) AddTeamToResource(ctx context.Context, team model.Group, resource model.Resource) error {
 	resourceNS := model.Namespace{
 		Id: resource.NamespaceId,
@@ -259,6 +298,35 @@ func (s Service) CheckPermission(ctx context.Context, user model.User, resource
 	return s.Authz.Permission.CheckRelation(ctx, rel, action)
 }
 
+// ExplainPermission returns how the permission of user on resource is
+// evaluated, see CheckPermission
+func (s Service) ExplainPermission(ctx context.Context, user model.User, resource model.Resource, action model.Action) (*model.Explanation, error) {
+	resourceNS := model.Namespace{
+		Id: utils.DefaultStringIfEmpty(resource.NamespaceId, resource.Namespace.Id),
+	}
+
+	rel := model.Relation{
+		ObjectNamespace:  resourceNS,
+		ObjectId:         resource.Id,
+		SubjectId:        user.Id,
+		SubjectNamespace: definition.UserNamespace,
+	}
+
+	return s.Authz.Explainer.ExplainRelation(ctx, rel, action)
+}
+
+// LookupResources returns ids of resources in the namespace on which the
+// user has the action
+func (s Service) LookupResources(ctx context.Context, user model.User, namespaceId string, action model.Action) ([]string, error) {
+	rel := model.Relation{
+		ObjectNamespace:  model.Namespace{Id: namespaceId},
+		SubjectId:        user.Id,
+		SubjectNamespace: definition.UserNamespace,
+	}
+
+	return s.Authz.Permission.LookupResources(ctx, rel, action)
+}
+
 func (s Service) AddOwnerToResource(ctx context.Context, user model.User, resource model.Resource) error {
 	nsId := utils.DefaultStringIfEmpty(resource.NamespaceId, resource.Namespace.Id)
 
@@ -0,0 +1,63 @@
+package permission
+
+import (
+	"context"
+
+	"github.com/odpf/shield/internal/bootstrap/definition"
+	"github.com/odpf/shield/model"
+	"github.com/odpf/shield/pkg/utils"
+)
+
+const serviceAccountContext = "service-account-context"
+
+// SetServiceAccountToContext marks the request as made by a service account
+// authenticated with an API key, permissions are then checked for it
+// instead of the user from identity header
+func SetServiceAccountToContext(ctx context.Context, serviceAccount model.ServiceAccount) context.Context {
+	return context.WithValue(ctx, serviceAccountContext, serviceAccount)
+}
+
+func GetServiceAccountFromContext(ctx context.Context) (model.ServiceAccount, bool) {
+	val, ok := ctx.Value(serviceAccountContext).(model.ServiceAccount)
+	return val, ok
+}
+
+func (s Service) CheckServiceAccountPermission(ctx context.Context, serviceAccount model.ServiceAccount, resource model.Resource, action model.Action) (bool, error) {
+	resourceNS := model.Namespace{
+		Id: utils.DefaultStringIfEmpty(resource.NamespaceId, resource.Namespace.Id),
+	}
+
+	rel := model.Relation{
+		ObjectNamespace:  resourceNS,
+		ObjectId:         resource.Id,
+		SubjectId:        serviceAccount.Id,
+		SubjectNamespace: definition.ServiceAccountNamespace,
+	}
+
+	return s.Authz.Permission.CheckRelation(ctx, rel, action)
+}
+
+func (s Service) LookupServiceAccountResources(ctx context.Context, serviceAccount model.ServiceAccount, namespaceId string, action model.Action) ([]string, error) {
+	rel := model.Relation{
+		ObjectNamespace:  model.Namespace{Id: namespaceId},
+		SubjectId:        serviceAccount.Id,
+		SubjectNamespace: definition.ServiceAccountNamespace,
+	}
+
+	return s.Authz.Permission.LookupResources(ctx, rel, action)
+}
+
+func (s Service) ExplainServiceAccountPermission(ctx context.Context, serviceAccount model.ServiceAccount, resource model.Resource, action model.Action) (*model.Explanation, error) {
+	resourceNS := model.Namespace{
+		Id: utils.DefaultStringIfEmpty(resource.NamespaceId, resource.Namespace.Id),
+	}
+
+	rel := model.Relation{
+		ObjectNamespace:  resourceNS,
+		ObjectId:         resource.Id,
+		SubjectId:        serviceAccount.Id,
+		SubjectNamespace: definition.ServiceAccountNamespace,
+	}
+
+	return s.Authz.Explainer.ExplainRelation(ctx, rel, action)
+}
@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/odpf/salt/log"
+)
+
+// localSweepInterval is how often the local store drops buckets which are
+// full again, a full bucket is the same as a missing one
+const localSweepInterval = time.Minute
+
+// Limit is a token bucket holding up to Burst tokens and refilled with Rate
+// tokens every Period, a request takes a token
+type Limit struct {
+	Rate   int
+	Period time.Duration
+	Burst  int
+}
+
+// refill returns tokens of a bucket after elapsed time
+func (l Limit) refill(tokens float64, elapsed time.Duration) float64 {
+	if elapsed > 0 {
+		tokens += elapsed.Seconds() * l.PerSecond()
+	}
+	return math.Min(tokens, float64(l.Burst))
+}
+
+// PerSecond is the refill rate of the bucket
+func (l Limit) PerSecond() float64 {
+	return float64(l.Rate) / l.Period.Seconds()
+}
+
+// fullAfter is how long until a bucket holding tokens is full again
+func (l Limit) fullAfter(tokens float64) time.Duration {
+	return time.Duration((float64(l.Burst) - tokens) / l.PerSecond() * float64(time.Second))
+}
+
+// Result returns the outcome of taking a token out of a bucket left with
+// tokens, stores share it so they give the same hints
+func (l Limit) Result(tokens float64, allowed bool) Result {
+	result := Result{
+		Allowed:    allowed,
+		Limit:      l.Burst,
+		Remaining:  int(math.Max(math.Floor(tokens), 0)),
+		ResetAfter: l.fullAfter(tokens),
+	}
+	if !allowed {
+		result.RetryAfter = time.Duration((1 - tokens) / l.PerSecond() * float64(time.Second))
+	}
+	return result
+}
+
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+
+	// RetryAfter is how long until a token is available if not allowed
+	RetryAfter time.Duration
+
+	// ResetAfter is how long until the bucket is full again
+	ResetAfter time.Duration
+}
+
+type Store interface {
+	// TakeRateLimitToken takes a token out of the bucket of key, the bucket
+	// is created full on first use
+	TakeRateLimitToken(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// Sweeper is a store which keeps buckets until they are removed
+type Sweeper interface {
+	DeleteFullRateLimitBuckets(ctx context.Context) error
+}
+
+// Sweep removes buckets of a shared store every interval until ctx is done
+func Sweep(ctx context.Context, logger log.Logger, store Sweeper, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := store.DeleteFullRateLimitBuckets(ctx); err != nil {
+				logger.Warn("failed to delete full rate limit buckets", "err", err)
+			}
+		}
+	}
+}
+
+type bucket struct {
+	limit   Limit
+	tokens  float64
+	updated time.Time
+	fullAt  time.Time
+}
+
+// LocalStore keeps buckets in memory, limits are per shield instance
+type LocalStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewLocalStore() *LocalStore {
+	return &LocalStore{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+func (s *LocalStore) TakeRateLimitToken(ctx context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	b, ok := s.buckets[key]
+	if !ok || b.limit != limit {
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), updated: now}
+		s.buckets[key] = b
+	}
+
+	b.tokens = limit.refill(b.tokens, now.Sub(b.updated))
+	b.updated = now
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	b.fullAt = now.Add(limit.fullAfter(b.tokens))
+
+	if now.Sub(s.lastSweep) >= localSweepInterval {
+		s.sweep(now)
+	}
+	return limit.Result(b.tokens, allowed), nil
+}
+
+func (s *LocalStore) sweep(now time.Time) {
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if !now.Before(b.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStore(t *testing.T) {
+	now := time.Date(2022, 3, 22, 12, 0, 0, 0, time.UTC)
+	store := NewLocalStore()
+	store.now = func() time.Time { return now }
+	limit := Limit{Rate: 2, Period: time.Second, Burst: 3}
+	ctx := context.Background()
+
+	for i := 2; i >= 0; i-- {
+		result, err := store.TakeRateLimitToken(ctx, "user:a", limit)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, i, result.Remaining)
+	}
+
+	result, _ := store.TakeRateLimitToken(ctx, "user:a", limit)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 500*time.Millisecond, result.RetryAfter)
+	assert.Equal(t, 1500*time.Millisecond, result.ResetAfter)
+
+	// buckets are independent per key
+	result, _ = store.TakeRateLimitToken(ctx, "user:b", limit)
+	assert.True(t, result.Allowed)
+
+	now = now.Add(500 * time.Millisecond)
+	result, _ = store.TakeRateLimitToken(ctx, "user:a", limit)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	// full buckets are dropped
+	now = now.Add(localSweepInterval)
+	store.TakeRateLimitToken(ctx, "user:c", limit)
+	assert.Len(t, store.buckets, 1)
+}
@@ -0,0 +1,66 @@
+package resource
+
+import (
+	"sync"
+	"time"
+)
+
+// LookupCache keeps resource ids looked up for a subject, namespace and
+// action for a short while. Ids are never shared between subjects, and a
+// lookup for the first page of a listing always replaces them.
+type LookupCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[lookupKey]lookupEntry
+}
+
+type lookupKey struct {
+	subject     string
+	namespaceId string
+	actionId    string
+}
+
+type lookupEntry struct {
+	ids       []string
+	expiresAt time.Time
+}
+
+func NewLookupCache(ttl time.Duration) *LookupCache {
+	return &LookupCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[lookupKey]lookupEntry{},
+	}
+}
+
+func (c *LookupCache) get(key lookupKey) ([]string, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.ids, true
+}
+
+// set keeps ids of key and drops expired entries, ids must not be changed
+// afterwards
+func (c *LookupCache) set(key lookupKey, ids []string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for k, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = lookupEntry{ids: ids, expiresAt: now.Add(c.ttl)}
+}
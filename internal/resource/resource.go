@@ -2,7 +2,9 @@ package resource
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"sort"
 
 	"github.com/odpf/shield/internal/permission"
 	"github.com/odpf/shield/model"
@@ -12,18 +14,44 @@ import (
 type Service struct {
 	Store       Store
 	Permissions permission.Permissions
+
+	// Lookups keeps ids looked up for the first page of authorized
+	// resources so following pages are cut from them, every page is looked
+	// up if nil
+	Lookups *LookupCache
 }
 
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
 var (
 	ResourceDoesntExist = errors.New("resource doesn't exist")
 	InvalidUUID         = errors.New("invalid syntax of uuid")
+	InvalidPageToken    = errors.New("invalid page token")
 )
 
+// AuthorizedFilter selects resources of a namespace on which the user, or
+// the current user or service account if UserId is empty, has the action.
+// Only resources of the organization are listed if OrganizationId is set.
+type AuthorizedFilter struct {
+	NamespaceId    string
+	ActionId       string
+	UserId         string
+	OrganizationId string
+	PageSize       int
+	PageToken      string
+}
+
 type Store interface {
 	GetResource(ctx context.Context, id string) (model.Resource, error)
 	CreateResource(ctx context.Context, resource model.Resource) (model.Resource, error)
 	ListResources(ctx context.Context) ([]model.Resource, error)
+	ListResourcesByIds(ctx context.Context, ids []string) ([]model.Resource, error)
+	ListResourceIdsByOrg(ctx context.Context, orgId string, ids []string, limit int) ([]string, error)
 	UpdateResource(ctx context.Context, id string, resource model.Resource) (model.Resource, error)
+	DeleteResource(ctx context.Context, id string) error
 }
 
 func (s Service) Get(ctx context.Context, id string) (model.Resource, error) {
@@ -33,16 +61,20 @@ func (s Service) Get(ctx context.Context, id string) (model.Resource, error) {
 func (s Service) Create(ctx context.Context, resource model.Resource) (model.Resource, error) {
 	id := utils.CreateResourceId(resource)
 
-	user, err := s.Permissions.FetchCurrentUser(ctx)
+	userId := resource.UserId
 
-	if err != nil {
-		return model.Resource{}, err
-	}
+	// resources created by a service account are owned through their
+	// project and organization only
+	if _, ok := permission.GetServiceAccountFromContext(ctx); !ok {
+		user, err := s.Permissions.FetchCurrentUser(ctx)
 
-	userId := resource.UserId
+		if err != nil {
+			return model.Resource{}, err
+		}
 
-	if userId == "" {
-		userId = user.Id
+		if userId == "" {
+			userId = user.Id
+		}
 	}
 
 	newResource, err := s.Store.CreateResource(ctx, model.Resource{
@@ -92,6 +124,135 @@ func (s Service) List(ctx context.Context) ([]model.Resource, error) {
 	return s.Store.ListResources(ctx)
 }
 
+// ListAuthorized returns a page of resources allowed by the authz engine
+// ordered by id, along with the token of the next page if there is one
+func (s Service) ListAuthorized(ctx context.Context, filter AuthorizedFilter) ([]model.Resource, string, error) {
+	after, err := decodePageToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	ids, err := s.lookupAuthorized(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	start := sort.SearchStrings(ids, after)
+	if start < len(ids) && ids[start] == after {
+		start++
+	}
+	ids = ids[start:]
+
+	// one more than the page tells if there is a next page
+	if filter.OrganizationId != "" && len(ids) > 0 {
+		if ids, err = s.Store.ListResourceIdsByOrg(ctx, filter.OrganizationId, ids, pageSize+1); err != nil {
+			return nil, "", err
+		}
+	}
+
+	var nextPageToken string
+	if len(ids) > pageSize {
+		ids = ids[:pageSize]
+		nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(ids[pageSize-1]))
+	}
+	if len(ids) == 0 {
+		return []model.Resource{}, "", nil
+	}
+
+	resources, err := s.Store.ListResourcesByIds(ctx, ids)
+	if err != nil {
+		return nil, "", err
+	}
+	return resources, nextPageToken, nil
+}
+
+// lookupAuthorized returns sorted ids of resources the filter allows. The
+// first page is always looked up, following pages reuse its ids if cached.
+func (s Service) lookupAuthorized(ctx context.Context, filter AuthorizedFilter) ([]string, error) {
+	action := model.Action{Id: filter.ActionId}
+	serviceAccount, isServiceAccount := permission.GetServiceAccountFromContext(ctx)
+	isServiceAccount = isServiceAccount && filter.UserId == ""
+
+	user := model.User{Id: filter.UserId}
+	if !isServiceAccount && user.Id == "" {
+		var err error
+		if user, err = s.Permissions.FetchCurrentUser(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	key := lookupKey{subject: "user:" + user.Id, namespaceId: filter.NamespaceId, actionId: filter.ActionId}
+	if isServiceAccount {
+		key.subject = "serviceaccount:" + serviceAccount.Id
+	}
+	if filter.PageToken != "" {
+		if ids, ok := s.Lookups.get(key); ok {
+			return ids, nil
+		}
+	}
+
+	var ids []string
+	var err error
+	if isServiceAccount {
+		ids, err = s.Permissions.LookupServiceAccountResources(ctx, serviceAccount, filter.NamespaceId, action)
+	} else {
+		ids, err = s.Permissions.LookupResources(ctx, user, filter.NamespaceId, action)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ids = uniqueSorted(ids)
+	s.Lookups.set(key, ids)
+	return ids, nil
+}
+
+// uniqueSorted sorts ids and drops duplicates, SpiceDB may return a resource
+// once for every path through which the permission is granted
+func uniqueSorted(ids []string) []string {
+	sort.Strings(ids)
+	unique := ids[:0]
+	for _, id := range ids {
+		if len(unique) == 0 || id != unique[len(unique)-1] {
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// decodePageToken returns the last resource id of the previous page
+func decodePageToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	after, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", InvalidPageToken
+	}
+	return string(after), nil
+}
+
 func (s Service) Update(ctx context.Context, id string, resource model.Resource) (model.Resource, error) {
 	return s.Store.UpdateResource(ctx, id, resource)
 }
+
+// Delete removes the resource along with relations it is the object or
+// subject of. Relations are removed first so a failed delete can be retried.
+func (s Service) Delete(ctx context.Context, id string) error {
+	resource, err := s.Store.GetResource(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Permissions.RemoveResourceRelations(ctx, resource); err != nil {
+		return err
+	}
+	return s.Store.DeleteResource(ctx, id)
+}
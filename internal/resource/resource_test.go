@@ -0,0 +1,160 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/model"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPermissions struct {
+	permission.Permissions
+	ids     []string
+	calls   *[]string
+	lookups *int
+}
+
+func (m mockPermissions) LookupResources(ctx context.Context, user model.User, namespaceId string, action model.Action) ([]string, error) {
+	if m.lookups != nil {
+		*m.lookups++
+	}
+	return append([]string{}, m.ids...), nil
+}
+
+func (m mockPermissions) RemoveResourceRelations(ctx context.Context, resource model.Resource) error {
+	*m.calls = append(*m.calls, "relations "+resource.Id)
+	return nil
+}
+
+type mockStore struct {
+	Store
+	calls *[]string
+}
+
+func (s mockStore) GetResource(ctx context.Context, id string) (model.Resource, error) {
+	if id != "r/ns/a" {
+		return model.Resource{}, ResourceDoesntExist
+	}
+	return model.Resource{Id: id, NamespaceId: "ns"}, nil
+}
+
+func (s mockStore) DeleteResource(ctx context.Context, id string) error {
+	*s.calls = append(*s.calls, "resource "+id)
+	return nil
+}
+
+func mockOrgId(id string) string {
+	if id == "r/ns/b" {
+		return "org-2"
+	}
+	return "org-1"
+}
+
+func (s mockStore) ListResourcesByIds(ctx context.Context, ids []string) ([]model.Resource, error) {
+	var resources []model.Resource
+	for _, id := range ids {
+		resources = append(resources, model.Resource{Id: id, OrganizationId: mockOrgId(id)})
+	}
+	return resources, nil
+}
+
+func (s mockStore) ListResourceIdsByOrg(ctx context.Context, orgId string, ids []string, limit int) ([]string, error) {
+	orgIds := []string{}
+	for _, id := range ids {
+		if mockOrgId(id) == orgId && len(orgIds) < limit {
+			orgIds = append(orgIds, id)
+		}
+	}
+	return orgIds, nil
+}
+
+// listPages returns ids of all pages of resources listed with the filter
+func listPages(t *testing.T, service Service, filter AuthorizedFilter) [][]string {
+	var pages [][]string
+	for {
+		resources, next, err := service.ListAuthorized(context.Background(), filter)
+		assert.NoError(t, err)
+
+		var page []string
+		for _, r := range resources {
+			page = append(page, r.Id)
+		}
+		pages = append(pages, page)
+
+		if next == "" {
+			return pages
+		}
+		filter.PageToken = next
+	}
+}
+
+func TestListAuthorized(t *testing.T) {
+	service := Service{
+		Store:       mockStore{},
+		Permissions: mockPermissions{ids: []string{"r/ns/e", "r/ns/b", "r/ns/a", "r/ns/d", "r/ns/b", "r/ns/c"}},
+	}
+	filter := AuthorizedFilter{NamespaceId: "ns", ActionId: "view", UserId: "user-1", PageSize: 2}
+	assert.Equal(t, [][]string{{"r/ns/a", "r/ns/b"}, {"r/ns/c", "r/ns/d"}, {"r/ns/e"}}, listPages(t, service, filter))
+
+	// pages are full with resources of the organization
+	filter.OrganizationId = "org-1"
+	assert.Equal(t, [][]string{{"r/ns/a", "r/ns/c"}, {"r/ns/d", "r/ns/e"}}, listPages(t, service, filter))
+
+	filter.OrganizationId = "org-2"
+	assert.Equal(t, [][]string{{"r/ns/b"}}, listPages(t, service, filter))
+
+	_, _, err := service.ListAuthorized(context.Background(), AuthorizedFilter{UserId: "user-1", PageToken: "not base64!"})
+	assert.ErrorIs(t, err, InvalidPageToken)
+}
+
+func TestListAuthorizedReusesLookup(t *testing.T) {
+	var lookups int
+	lookupCache := NewLookupCache(time.Minute)
+	now := time.Now()
+	lookupCache.now = func() time.Time { return now }
+	service := Service{
+		Store:       mockStore{},
+		Permissions: mockPermissions{ids: []string{"r/ns/a", "r/ns/b", "r/ns/c", "r/ns/d", "r/ns/e"}, lookups: &lookups},
+		Lookups:     lookupCache,
+	}
+	filter := AuthorizedFilter{NamespaceId: "ns", ActionId: "view", UserId: "user-1", PageSize: 2}
+
+	assert.Len(t, listPages(t, service, filter), 3)
+	assert.Equal(t, 1, lookups)
+
+	// the first page is always looked up
+	assert.Len(t, listPages(t, service, filter), 3)
+	assert.Equal(t, 2, lookups)
+
+	// other subjects don't share lookups
+	filter.UserId = "user-2"
+	assert.Len(t, listPages(t, service, filter), 3)
+	assert.Equal(t, 3, lookups)
+
+	// expired lookups are done again
+	_, next, err := service.ListAuthorized(context.Background(), filter)
+	assert.NoError(t, err)
+	now = now.Add(time.Minute)
+	filter.PageToken = next
+	_, _, err = service.ListAuthorized(context.Background(), filter)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, lookups)
+}
+
+func TestDelete(t *testing.T) {
+	var calls []string
+	service := Service{
+		Store:       mockStore{calls: &calls},
+		Permissions: mockPermissions{calls: &calls},
+	}
+
+	assert.NoError(t, service.Delete(context.Background(), "r/ns/a"))
+	assert.Equal(t, []string{"relations r/ns/a", "resource r/ns/a"}, calls)
+
+	calls = nil
+	assert.ErrorIs(t, service.Delete(context.Background(), "r/ns/b"), ResourceDoesntExist)
+	assert.Empty(t, calls)
+}
@@ -36,7 +36,8 @@ func BuildSchema(def []definition) []string {
 
 func GetDefaultSchema() []string {
 	userSchema := `definition user {}`
-	schemas := []string{userSchema}
+	serviceAccountSchema := `definition service_account {}`
+	schemas := []string{userSchema, serviceAccountSchema}
 	return schemas
 }
 
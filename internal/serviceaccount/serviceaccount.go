@@ -0,0 +1,216 @@
+package serviceaccount
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/odpf/shield/model"
+)
+
+const (
+	// APIKeyHeader is the header, or grpc metadata, carrying the API key
+	APIKeyHeader = "X-Shield-Api-Key"
+
+	// keyPrefix makes API keys recognisable in logs and secret scanners
+	keyPrefix    = "shield_"
+	secretLength = 32
+)
+
+type Service struct {
+	Store Store
+
+	// Relations removes relations of deleted service accounts, relations
+	// are kept if nil
+	Relations Relations
+}
+
+var (
+	ServiceAccountDoesntExist = errors.New("service account doesn't exist")
+	APIKeyDoesntExist         = errors.New("api key doesn't exist")
+	InvalidUUID               = errors.New("invalid syntax of uuid")
+	InvalidAPIKey             = errors.New("invalid api key")
+	APIKeyExpired             = errors.New("api key is expired or revoked")
+	OrgRequired               = errors.New("organization is required for service account")
+)
+
+type Store interface {
+	GetServiceAccount(ctx context.Context, id string) (model.ServiceAccount, error)
+	CreateServiceAccount(ctx context.Context, serviceAccount model.ServiceAccount) (model.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context, orgId string) ([]model.ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, id string) error
+	GetAPIKey(ctx context.Context, id string) (model.APIKey, error)
+	CreateAPIKey(ctx context.Context, key model.APIKey) (model.APIKey, error)
+	ListAPIKeys(ctx context.Context, serviceAccountId string) ([]model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) (model.APIKey, error)
+	ExpireAPIKey(ctx context.Context, id string, expiresAt time.Time) (model.APIKey, error)
+}
+
+// Relations removes relations a service account is the subject of, from
+// both the authz engine and the store
+type Relations interface {
+	RemoveServiceAccountRelations(ctx context.Context, serviceAccount model.ServiceAccount) error
+}
+
+func (s Service) Get(ctx context.Context, id string) (model.ServiceAccount, error) {
+	return s.Store.GetServiceAccount(ctx, id)
+}
+
+func (s Service) Create(ctx context.Context, serviceAccount model.ServiceAccount) (model.ServiceAccount, error) {
+	if serviceAccount.OrganizationId == "" {
+		return model.ServiceAccount{}, OrgRequired
+	}
+	return s.Store.CreateServiceAccount(ctx, model.ServiceAccount{
+		Name:           serviceAccount.Name,
+		OrganizationId: serviceAccount.OrganizationId,
+		ProjectId:      serviceAccount.ProjectId,
+		Metadata:       serviceAccount.Metadata,
+	})
+}
+
+// List returns service accounts of the organization, or all of them if orgId is empty
+func (s Service) List(ctx context.Context, orgId string) ([]model.ServiceAccount, error) {
+	return s.Store.ListServiceAccounts(ctx, orgId)
+}
+
+// Delete removes the service account along with relations it is the subject
+// of and revokes its keys. Relations are removed first so a failed delete can
+// be retried.
+func (s Service) Delete(ctx context.Context, id string) error {
+	serviceAccount, err := s.Store.GetServiceAccount(ctx, id)
+	if err != nil {
+		return err
+	}
+	if s.Relations != nil {
+		if err := s.Relations.RemoveServiceAccountRelations(ctx, serviceAccount); err != nil {
+			return err
+		}
+	}
+	return s.Store.DeleteServiceAccount(ctx, id)
+}
+
+// CreateKey issues a new API key for the service account, returned key is
+// the only place where its secret is available
+func (s Service) CreateKey(ctx context.Context, serviceAccountId string, name string, expiresAt time.Time) (model.APIKey, error) {
+	if _, err := s.Store.GetServiceAccount(ctx, serviceAccountId); err != nil {
+		return model.APIKey{}, err
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return model.APIKey{}, err
+	}
+
+	key, err := s.Store.CreateAPIKey(ctx, model.APIKey{
+		Name:             name,
+		ServiceAccountId: serviceAccountId,
+		SecretHash:       hashSecret(secret),
+		ExpiresAt:        expiresAt,
+	})
+	if err != nil {
+		return model.APIKey{}, err
+	}
+
+	key.Secret = keyPrefix + key.Id + "." + secret
+	return key, nil
+}
+
+func (s Service) ListKeys(ctx context.Context, serviceAccountId string) ([]model.APIKey, error) {
+	return s.Store.ListAPIKeys(ctx, serviceAccountId)
+}
+
+func (s Service) RevokeKey(ctx context.Context, id string) (model.APIKey, error) {
+	return s.Store.RevokeAPIKey(ctx, id)
+}
+
+// RotateKey issues a new key with the same name and expiry duration, the old
+// key keeps working for the grace period so clients can switch over
+func (s Service) RotateKey(ctx context.Context, id string, gracePeriod time.Duration) (model.APIKey, error) {
+	oldKey, err := s.Store.GetAPIKey(ctx, id)
+	if err != nil {
+		return model.APIKey{}, err
+	}
+	if !oldKey.RevokedAt.IsZero() {
+		return model.APIKey{}, APIKeyExpired
+	}
+
+	var expiresAt time.Time
+	if !oldKey.ExpiresAt.IsZero() {
+		expiresAt = time.Now().Add(oldKey.ExpiresAt.Sub(oldKey.CreatedAt))
+	}
+	newKey, err := s.CreateKey(ctx, oldKey.ServiceAccountId, oldKey.Name, expiresAt)
+	if err != nil {
+		return model.APIKey{}, err
+	}
+
+	graceEnd := time.Now().Add(gracePeriod)
+	if oldKey.ExpiresAt.IsZero() || graceEnd.Before(oldKey.ExpiresAt) {
+		if gracePeriod <= 0 {
+			_, err = s.Store.RevokeAPIKey(ctx, oldKey.Id)
+		} else {
+			_, err = s.Store.ExpireAPIKey(ctx, oldKey.Id, graceEnd)
+		}
+		if err != nil {
+			return model.APIKey{}, err
+		}
+	}
+	return newKey, nil
+}
+
+// Authenticate returns the service account owning the API key if the key
+// is neither expired nor revoked
+func (s Service) Authenticate(ctx context.Context, rawKey string) (model.ServiceAccount, error) {
+	id, secret, err := parseKey(rawKey)
+	if err != nil {
+		return model.ServiceAccount{}, err
+	}
+
+	key, err := s.Store.GetAPIKey(ctx, id)
+	if err != nil {
+		if errors.Is(err, APIKeyDoesntExist) || errors.Is(err, InvalidUUID) {
+			return model.ServiceAccount{}, InvalidAPIKey
+		}
+		return model.ServiceAccount{}, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(key.SecretHash)) != 1 {
+		return model.ServiceAccount{}, InvalidAPIKey
+	}
+	if !key.RevokedAt.IsZero() || (!key.ExpiresAt.IsZero() && time.Now().After(key.ExpiresAt)) {
+		return model.ServiceAccount{}, APIKeyExpired
+	}
+
+	return s.Store.GetServiceAccount(ctx, key.ServiceAccountId)
+}
+
+func parseKey(rawKey string) (string, string, error) {
+	if !strings.HasPrefix(rawKey, keyPrefix) {
+		return "", "", InvalidAPIKey
+	}
+	parts := strings.SplitN(strings.TrimPrefix(rawKey, keyPrefix), ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", InvalidAPIKey
+	}
+	return parts[0], parts[1], nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSecret hashes the random secret of a key, a fast hash is enough as
+// secrets have 256 bits of entropy unlike user chosen passwords
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,170 @@
+package serviceaccount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/odpf/shield/model"
+	"github.com/stretchr/testify/assert"
+)
+
+type memStore struct {
+	Store
+	serviceAccounts map[string]model.ServiceAccount
+	keys            map[string]model.APIKey
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		serviceAccounts: map[string]model.ServiceAccount{
+			"sa-1": {Id: "sa-1", Name: "batch-job", OrganizationId: "org-1"},
+		},
+		keys: map[string]model.APIKey{},
+	}
+}
+
+func (s *memStore) GetServiceAccount(ctx context.Context, id string) (model.ServiceAccount, error) {
+	sa, ok := s.serviceAccounts[id]
+	if !ok {
+		return model.ServiceAccount{}, ServiceAccountDoesntExist
+	}
+	return sa, nil
+}
+
+func (s *memStore) GetAPIKey(ctx context.Context, id string) (model.APIKey, error) {
+	key, ok := s.keys[id]
+	if !ok {
+		return model.APIKey{}, APIKeyDoesntExist
+	}
+	return key, nil
+}
+
+func (s *memStore) CreateAPIKey(ctx context.Context, key model.APIKey) (model.APIKey, error) {
+	key.Id = fmt.Sprintf("key-%d", len(s.keys)+1)
+	key.CreatedAt = time.Now()
+	s.keys[key.Id] = key
+	return key, nil
+}
+
+func (s *memStore) RevokeAPIKey(ctx context.Context, id string) (model.APIKey, error) {
+	key := s.keys[id]
+	key.RevokedAt = time.Now()
+	s.keys[id] = key
+	return key, nil
+}
+
+func (s *memStore) ExpireAPIKey(ctx context.Context, id string, expiresAt time.Time) (model.APIKey, error) {
+	key := s.keys[id]
+	key.ExpiresAt = expiresAt
+	s.keys[id] = key
+	return key, nil
+}
+
+func (s *memStore) DeleteServiceAccount(ctx context.Context, id string) error {
+	delete(s.serviceAccounts, id)
+	return nil
+}
+
+type mockRelations struct {
+	removed []string
+	err     error
+}
+
+func (m *mockRelations) RemoveServiceAccountRelations(ctx context.Context, serviceAccount model.ServiceAccount) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.removed = append(m.removed, serviceAccount.Id)
+	return nil
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	relations := &mockRelations{err: errors.New("spicedb unavailable")}
+	service := Service{Store: store, Relations: relations}
+
+	// kept until its relations are removed so the delete can be retried
+	assert.Error(t, service.Delete(ctx, "sa-1"))
+	assert.Contains(t, store.serviceAccounts, "sa-1")
+
+	relations.err = nil
+	assert.NoError(t, service.Delete(ctx, "sa-1"))
+	assert.Equal(t, []string{"sa-1"}, relations.removed)
+	assert.NotContains(t, store.serviceAccounts, "sa-1")
+
+	assert.ErrorIs(t, service.Delete(ctx, "sa-1"), ServiceAccountDoesntExist)
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	service := Service{Store: store}
+
+	key, err := service.CreateKey(ctx, "sa-1", "ci", time.Time{})
+	assert.NoError(t, err)
+	assert.Contains(t, key.Secret, "shield_key-1.")
+	assert.NotContains(t, store.keys[key.Id].SecretHash, key.Secret)
+
+	sa, err := service.Authenticate(ctx, key.Secret)
+	assert.NoError(t, err)
+	assert.Equal(t, "sa-1", sa.Id)
+
+	_, err = service.Authenticate(ctx, key.Secret+"x")
+	assert.ErrorIs(t, err, InvalidAPIKey)
+	_, err = service.Authenticate(ctx, "shield_key-9.secret")
+	assert.ErrorIs(t, err, InvalidAPIKey)
+	_, err = service.Authenticate(ctx, "not-a-key")
+	assert.ErrorIs(t, err, InvalidAPIKey)
+
+	_, err = service.CreateKey(ctx, "sa-2", "ci", time.Time{})
+	assert.ErrorIs(t, err, ServiceAccountDoesntExist)
+
+	expired, err := service.CreateKey(ctx, "sa-1", "expired", time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	_, err = service.Authenticate(ctx, expired.Secret)
+	assert.ErrorIs(t, err, APIKeyExpired)
+
+	_, err = service.RevokeKey(ctx, key.Id)
+	assert.NoError(t, err)
+	_, err = service.Authenticate(ctx, key.Secret)
+	assert.ErrorIs(t, err, APIKeyExpired)
+}
+
+func TestRotateKey(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	service := Service{Store: store}
+
+	oldKey, err := service.CreateKey(ctx, "sa-1", "ci", time.Now().Add(24*time.Hour))
+	assert.NoError(t, err)
+
+	t.Run("old key works during grace period", func(t *testing.T) {
+		newKey, err := service.RotateKey(ctx, oldKey.Id, time.Hour)
+		assert.NoError(t, err)
+		assert.NotEqual(t, oldKey.Id, newKey.Id)
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), newKey.ExpiresAt, time.Minute)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), store.keys[oldKey.Id].ExpiresAt, time.Minute)
+
+		_, err = service.Authenticate(ctx, oldKey.Secret)
+		assert.NoError(t, err)
+		_, err = service.Authenticate(ctx, newKey.Secret)
+		assert.NoError(t, err)
+	})
+
+	t.Run("old key is revoked without grace period", func(t *testing.T) {
+		newKey, err := service.RotateKey(ctx, oldKey.Id, 0)
+		assert.NoError(t, err)
+
+		_, err = service.Authenticate(ctx, oldKey.Secret)
+		assert.ErrorIs(t, err, APIKeyExpired)
+		_, err = service.Authenticate(ctx, newKey.Secret)
+		assert.NoError(t, err)
+
+		_, err = service.RotateKey(ctx, oldKey.Id, 0)
+		assert.ErrorIs(t, err, APIKeyExpired)
+	})
+}
@@ -10,10 +10,7 @@ import (
 )
 
 func InitLogger(appConfig *config.Shield) *log.Zap {
-	cfg := zap.NewProductionConfig()
-	cfg.Level = zap.NewAtomicLevelAt(atomicLevel(appConfig.Log.Level))
-	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
-	cfg.DisableCaller = true
+	cfg := zapConfig(appConfig)
 	consoleEncoder := zapcore.NewConsoleEncoder(cfg.EncoderConfig)
 
 	opt := log.ZapWithConfig(cfg, zap.WrapCore(func(c zapcore.Core) zapcore.Core {
@@ -24,6 +21,31 @@ func InitLogger(appConfig *config.Shield) *log.Zap {
 	return logger
 }
 
+// InitAccessLogger returns a logger writing to stdout like the app logger and
+// also to the file as json lines, every record is written to the file
+// whatever the log level is
+func InitAccessLogger(appConfig *config.Shield, file zapcore.WriteSyncer) *log.Zap {
+	cfg := zapConfig(appConfig)
+	consoleEncoder := zapcore.NewConsoleEncoder(cfg.EncoderConfig)
+	fileEncoder := zapcore.NewJSONEncoder(cfg.EncoderConfig)
+
+	opt := log.ZapWithConfig(cfg, zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(
+			zapcore.NewCore(consoleEncoder, zapcore.AddSync(os.Stdout), cfg.Level),
+			zapcore.NewCore(fileEncoder, file, zap.DebugLevel),
+		)
+	}))
+	return log.NewZap(opt)
+}
+
+func zapConfig(appConfig *config.Shield) zap.Config {
+	cfg := zap.NewProductionConfig()
+	cfg.Level = zap.NewAtomicLevelAt(atomicLevel(appConfig.Log.Level))
+	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	cfg.DisableCaller = true
+	return cfg
+}
+
 func atomicLevel(level string) zapcore.Level {
 	switch level {
 	case "info":
@@ -0,0 +1,72 @@
+package logger
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatingFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "access.log")
+
+	file, err := NewRotatingFile(path, 1, 2)
+	assert.NoError(t, err)
+	file.maxSize = 10
+
+	for _, line := range []string{"aaaa\n", "bbbb\n", "cccc\n", "dddd\n", "eeee\n"} {
+		_, err := file.Write([]byte(line))
+		assert.NoError(t, err)
+	}
+	// a line larger than the limit isn't split
+	_, err = file.Write([]byte(strings.Repeat("f", 12) + "\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	read := func(name string) string {
+		content, err := ioutil.ReadFile(filepath.Join(dir, name))
+		assert.NoError(t, err)
+		return string(content)
+	}
+	assert.Equal(t, "ffffffffffff\n", read("access.log"))
+	assert.Equal(t, "eeee\n", read("access.log.1"))
+	assert.Equal(t, "cccc\ndddd\n", read("access.log.2"))
+	_, err = ioutil.ReadFile(filepath.Join(dir, "access.log.3"))
+	assert.Error(t, err)
+}
+
+func TestRotatingFileKeepsWritingWhenRotationFails(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "access.log")
+
+	file, err := NewRotatingFile(path, 1, 1)
+	assert.NoError(t, err)
+	file.maxSize = 10
+
+	// a directory in place of the backup fails the rename
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "access.log.1", "taken"), 0755))
+
+	_, err = file.Write([]byte("aaaa\n"))
+	assert.NoError(t, err)
+	_, err = file.Write([]byte("bbbbbbbb\n"))
+	assert.Error(t, err)
+	_, err = file.Write([]byte("cccc\n"))
+	assert.Error(t, err)
+
+	// rotation is tried again once the backup can be written
+	assert.NoError(t, os.RemoveAll(filepath.Join(dir, "access.log.1")))
+	_, err = file.Write([]byte("dddd\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "dddd\n", string(content))
+	content, err = ioutil.ReadFile(filepath.Join(dir, "access.log.1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "aaaa\nbbbbbbbb\ncccc\n", string(content))
+}
@@ -0,0 +1,189 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/odpf/shield/middleware"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	metricsNamespace = "shield"
+
+	// decisionError is counted for checks which failed, along with allow and
+	// deny decisions
+	decisionError = "error"
+)
+
+var (
+	proxyRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "proxy",
+		Name:      "requests_total",
+		Help:      "Requests served by the proxy per rule, backend and status code.",
+	}, []string{"service", "rule", "backend", "code"})
+
+	proxyDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "proxy",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests served by the proxy per rule and backend.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "rule", "backend"})
+
+	authzDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "authz",
+		Name:      "decisions_total",
+		Help:      "Permission checks per namespace and action, decision is allow, deny or error.",
+	}, []string{"namespace", "action", "decision"})
+
+	spiceDBDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "spicedb",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of calls to SpiceDB per method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	spiceDBErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "spicedb",
+		Name:      "request_errors_total",
+		Help:      "Failed calls to SpiceDB per method and grpc status code.",
+	}, []string{"method", "code"})
+
+	apiRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "requests_total",
+		Help:      "Requests served by the admin grpc api and its gateway per method and grpc status code.",
+	}, []string{"method", "code"})
+
+	apiDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests served by the admin grpc api and its gateway per method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+// Handler serves metrics of the default registry
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Proxy counts and times requests of a proxy service by the rule they
+// matched, the rule is read from the access log record of the request
+func Proxy(service string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		record := middleware.EnsureAccessLog(req)
+		writer := middleware.NewResponseWriter(rw)
+
+		next.ServeHTTP(writer, req)
+
+		proxyRequests.WithLabelValues(service, record.Rule, record.Backend, strconv.Itoa(writer.Status())).Inc()
+		proxyDuration.WithLabelValues(service, record.Rule, record.Backend).Observe(time.Since(record.Start).Seconds())
+	})
+}
+
+// ObserveAuthzDecision counts a permission check of action on a resource of
+// namespace
+func ObserveAuthzDecision(namespace, action string, allowed bool, err error) {
+	decision := middleware.DecisionDeny
+	switch {
+	case err != nil:
+		decision = decisionError
+	case allowed:
+		decision = middleware.DecisionAllow
+	}
+	authzDecisions.WithLabelValues(namespace, action, decision).Inc()
+}
+
+// APIUnaryInterceptor counts and times requests of the admin grpc api,
+// gateway requests are served through it as well
+func APIUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		method := path.Base(info.FullMethod)
+		apiRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+		apiDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		return res, err
+	}
+}
+
+// RegisterDB exports stats of the connection pool of db
+func RegisterDB(db *sql.DB, name string) error {
+	return prometheus.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// SpiceDBUnaryInterceptor times calls made by a SpiceDB client and counts
+// the ones which failed
+func SpiceDBUnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		observeSpiceDB(method, start, err)
+		return err
+	}
+}
+
+// SpiceDBStreamInterceptor times streams of a SpiceDB client until they are
+// read to the end or fail
+func SpiceDBStreamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			observeSpiceDB(method, start, err)
+			return nil, err
+		}
+		return &observedStream{ClientStream: stream, method: method, start: start}, nil
+	}
+}
+
+func observeSpiceDB(fullMethod string, start time.Time, err error) {
+	method := path.Base(fullMethod)
+	spiceDBDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		spiceDBErrors.WithLabelValues(method, status.Code(err).String()).Inc()
+	}
+}
+
+// observedStream times a server stream until it's read to the end or fails
+type observedStream struct {
+	grpc.ClientStream
+	method string
+	start  time.Time
+	once   sync.Once
+}
+
+func (s *observedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(func() {
+			if errors.Is(err, io.EOF) {
+				observeSpiceDB(s.method, s.start, nil)
+				return
+			}
+			observeSpiceDB(s.method, s.start, err)
+		})
+	}
+	return err
+}
@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestProxy(t *testing.T) {
+	handler := Proxy("library", http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		middleware.ExtractAccessLog(req).MatchRule(&structs.Rule{
+			Frontend: structs.Frontend{Name: "list books", Method: http.MethodGet, URL: "/api/books"},
+			Backend:  structs.Backend{Namespace: "library"},
+		})
+		rw.WriteHeader(http.StatusNotFound)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/books", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/books", nil))
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(proxyRequests.WithLabelValues("library", "list books", "library", "404")))
+	assert.Equal(t, 1, testutil.CollectAndCount(proxyDuration))
+}
+
+func TestObserveAuthzDecision(t *testing.T) {
+	ObserveAuthzDecision("library_book", "read", true, nil)
+	ObserveAuthzDecision("library_book", "read", false, nil)
+	ObserveAuthzDecision("library_book", "read", false, nil)
+	ObserveAuthzDecision("library_book", "read", false, errors.New("spicedb unavailable"))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(authzDecisions.WithLabelValues("library_book", "read", "allow")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(authzDecisions.WithLabelValues("library_book", "read", "deny")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(authzDecisions.WithLabelValues("library_book", "read", "error")))
+}
+
+func TestAPIUnaryInterceptor(t *testing.T) {
+	interceptor := APIUnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/odpf.shield.v1beta1.ShieldService/GetProject"}
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "project", nil
+	})
+	assert.NoError(t, err)
+	_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "project not found")
+	})
+	assert.Error(t, err)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(apiRequests.WithLabelValues("GetProject", "OK")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(apiRequests.WithLabelValues("GetProject", "NotFound")))
+	assert.Equal(t, 1, testutil.CollectAndCount(apiDuration))
+}
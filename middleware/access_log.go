@@ -0,0 +1,146 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/odpf/shield/structs"
+)
+
+const (
+	DecisionAllow = "allow"
+	DecisionDeny  = "deny"
+)
+
+// AccessLog is the access log record of a proxied request, it is filled in
+// by middlewares, the proxy and hooks as the request passes through them
+// and read by access logs and metrics.
+// Its methods do nothing on a nil record so callers don't have to check if
+// access logs are enabled.
+type AccessLog struct {
+	Start time.Time
+
+	// Rule is the name of the matched frontend, Backend the name of its
+	// backend
+	Rule    string
+	Backend string
+
+	// User is the identity resolved by authn middlewares or authz
+	User string
+
+	// Resources are ids of resources authz checked, Decision is allow or
+	// deny and Reason why a request was denied
+	Resources []string
+	Decision  string
+	Reason    string
+
+	// Body is the buffered request body, set if the rule buffers it
+	Body []byte
+
+	UpstreamStatus int
+	UpstreamStart  time.Time
+	UpstreamEnd    time.Time
+	HooksEnd       time.Time
+}
+
+func EnrichAccessLog(r *http.Request, record *AccessLog) {
+	*r = *r.WithContext(context.WithValue(r.Context(), ctxAccessLogKey, record))
+}
+
+// EnsureAccessLog returns the access log record of the request, a new one
+// is added if there is none yet. The record is shared by access logs and
+// metrics of the request.
+func EnsureAccessLog(r *http.Request) *AccessLog {
+	if record := ExtractAccessLog(r); record != nil {
+		return record
+	}
+	record := &AccessLog{Start: time.Now()}
+	EnrichAccessLog(r, record)
+	return record
+}
+
+// ExtractAccessLog returns the access log record of the request, nil if
+// access logs are disabled
+func ExtractAccessLog(r *http.Request) *AccessLog {
+	record, _ := r.Context().Value(ctxAccessLogKey).(*AccessLog)
+	return record
+}
+
+func (l *AccessLog) MatchRule(rule *structs.Rule) {
+	if l == nil {
+		return
+	}
+	l.Rule = RuleName(rule)
+	l.Backend = rule.Backend.Namespace
+}
+
+// RuleName is the name of the frontend of rule, or its method and url if it
+// has none
+func RuleName(rule *structs.Rule) string {
+	if rule.Frontend.Name != "" {
+		return rule.Frontend.Name
+	}
+	return rule.Frontend.Method + " " + rule.Frontend.URL
+}
+
+func (l *AccessLog) SetUser(user string) {
+	if l == nil || user == "" {
+		return
+	}
+	l.User = user
+}
+
+func (l *AccessLog) SetBody(body []byte) {
+	if l == nil {
+		return
+	}
+	l.Body = body
+}
+
+// Allow records that authz allowed the request on the resources
+func (l *AccessLog) Allow(resources []string) {
+	if l == nil {
+		return
+	}
+	l.Decision = DecisionAllow
+	l.Resources = resources
+	l.Reason = ""
+}
+
+// Deny records why authz denied the request, resources are the ones
+// checked if it got that far
+func (l *AccessLog) Deny(reason string, resources []string) {
+	if l == nil {
+		return
+	}
+	l.Decision = DecisionDeny
+	l.Resources = resources
+	l.Reason = reason
+}
+
+// UpstreamStarted marks the end of middlewares, retries of the request are
+// part of the upstream phase
+func (l *AccessLog) UpstreamStarted() {
+	if l == nil {
+		return
+	}
+	l.UpstreamStart = time.Now()
+}
+
+// UpstreamDone records the backend response before hooks run, status is 0 if
+// the backend couldn't be reached
+func (l *AccessLog) UpstreamDone(status int) {
+	if l == nil {
+		return
+	}
+	l.UpstreamEnd = time.Now()
+	l.UpstreamStatus = status
+}
+
+func (l *AccessLog) HooksDone() {
+	if l == nil {
+		return
+	}
+	l.HooksEnd = time.Now()
+}
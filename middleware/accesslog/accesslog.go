@@ -0,0 +1,226 @@
+package accesslog
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/odpf/shield/config"
+	"github.com/odpf/shield/internal/serviceaccount"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+
+	"github.com/odpf/salt/log"
+)
+
+const redacted = "[REDACTED]"
+
+// defaultRedactedHeaders carry credentials and are always masked
+var defaultRedactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", serviceaccount.APIKeyHeader}
+
+// AccessLog logs a record of every request once it is served, the record is
+// filled in by middlewares, the proxy and hooks while serving it
+type AccessLog struct {
+	log                 log.Logger
+	service             string
+	identityProxyHeader string
+	config              config.AccessLogConfig
+
+	redactedHeaders map[string]bool
+	redactedKeys    map[string]bool
+
+	next http.Handler
+}
+
+func New(log log.Logger, service, identityProxyHeader string, conf config.AccessLogConfig, next http.Handler) *AccessLog {
+	redactedHeaders := map[string]bool{}
+	for _, header := range append(defaultRedactedHeaders, conf.Redact.Headers...) {
+		redactedHeaders[http.CanonicalHeaderKey(header)] = true
+	}
+	redactedKeys := map[string]bool{}
+	for _, key := range conf.Redact.BodyKeys {
+		redactedKeys[strings.ToLower(key)] = true
+	}
+	return &AccessLog{
+		log:                 log,
+		service:             service,
+		identityProxyHeader: identityProxyHeader,
+		config:              conf,
+		redactedHeaders:     redactedHeaders,
+		redactedKeys:        redactedKeys,
+		next:                next,
+	}
+}
+
+func (a AccessLog) Info() *structs.MiddlewareInfo {
+	return &structs.MiddlewareInfo{
+		Name:        "_accesslog",
+		Description: "log a record of every proxied request",
+	}
+}
+
+func (a *AccessLog) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	record := middleware.EnsureAccessLog(req)
+
+	// headers are logged as the client sent them, before middlewares change
+	// them
+	var headers map[string]string
+	if a.config.Headers {
+		headers = a.redactHeaders(req.Header)
+	}
+	method, path := req.Method, req.URL.Path
+	isGRPC := strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc")
+
+	var reqBody *countingBody
+	if req.Body != nil && req.Body != http.NoBody {
+		reqBody = &countingBody{ReadCloser: req.Body}
+		req.Body = reqBody
+	}
+	writer := middleware.NewResponseWriter(rw)
+
+	a.next.ServeHTTP(writer, req)
+	end := time.Now()
+
+	fields := []interface{}{
+		"service", a.service,
+		"rule", record.Rule,
+		"backend", record.Backend,
+		"method", method,
+		"path", path,
+	}
+	if isGRPC {
+		// path of grpc requests is the method
+		fields = append(fields, "grpc_method", path)
+	}
+
+	user := record.User
+	if user == "" {
+		user = req.Header.Get(a.identityProxyHeader)
+	}
+	fields = append(fields, "user", user)
+
+	if record.Decision != "" {
+		fields = append(fields, "authz", record.Decision)
+	}
+	if record.Reason != "" {
+		fields = append(fields, "reason", record.Reason)
+	}
+	if len(record.Resources) > 0 {
+		fields = append(fields, "resources", record.Resources)
+	}
+
+	var bytesIn int64
+	if reqBody != nil {
+		bytesIn = reqBody.bytes
+	}
+	fields = append(fields,
+		"status", writer.Status(),
+		"upstream_status", record.UpstreamStatus,
+		"bytes_in", bytesIn,
+		"bytes_out", writer.Bytes(),
+	)
+	if isGRPC {
+		if grpcStatus := grpcStatus(writer.Header()); grpcStatus != "" {
+			fields = append(fields, "grpc_status", grpcStatus)
+		}
+	}
+
+	fields = append(fields, "latency", end.Sub(record.Start))
+	fields = append(fields, phaseLatencies(record, end)...)
+
+	if headers != nil {
+		fields = append(fields, "headers", headers)
+	}
+	if a.config.Body && !isGRPC {
+		if body, ok := a.redactBody(record.Body); ok {
+			fields = append(fields, "body", body)
+		}
+	}
+	a.log.Info("access", fields...)
+}
+
+// phaseLatencies splits the latency of the request between middlewares,
+// the backend and hooks, the rest is spent streaming the response
+func phaseLatencies(record *middleware.AccessLog, end time.Time) []interface{} {
+	if record.UpstreamStart.IsZero() {
+		// the request didn't get past middlewares
+		return []interface{}{"middlewares_latency", end.Sub(record.Start)}
+	}
+	fields := []interface{}{"middlewares_latency", record.UpstreamStart.Sub(record.Start)}
+	if !record.UpstreamEnd.IsZero() {
+		fields = append(fields, "upstream_latency", record.UpstreamEnd.Sub(record.UpstreamStart))
+	}
+	if !record.HooksEnd.IsZero() {
+		fields = append(fields, "hooks_latency", record.HooksEnd.Sub(record.UpstreamEnd))
+	}
+	return fields
+}
+
+// grpcStatus is sent in trailers, or in headers of trailers-only responses
+func grpcStatus(header http.Header) string {
+	if status := header.Get("Grpc-Status"); status != "" {
+		return status
+	}
+	return header.Get(http.TrailerPrefix + "Grpc-Status")
+}
+
+func (a *AccessLog) redactHeaders(header http.Header) map[string]string {
+	headers := map[string]string{}
+	for key, values := range header {
+		if a.redactedHeaders[http.CanonicalHeaderKey(key)] {
+			headers[key] = redacted
+			continue
+		}
+		headers[key] = strings.Join(values, ", ")
+	}
+	return headers
+}
+
+// redactBody returns the json body with values of redacted keys masked,
+// bodies which aren't json or are too large aren't logged
+func (a *AccessLog) redactBody(body []byte) (string, bool) {
+	if len(body) == 0 || (a.config.MaxBodySize > 0 && len(body) > a.config.MaxBodySize) {
+		return "", false
+	}
+	var payload interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", false
+	}
+	masked, err := json.Marshal(a.redactValue(payload))
+	if err != nil {
+		return "", false
+	}
+	return string(masked), true
+}
+
+func (a *AccessLog) redactValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, v := range value {
+			if a.redactedKeys[strings.ToLower(key)] {
+				value[key] = redacted
+				continue
+			}
+			value[key] = a.redactValue(v)
+		}
+	case []interface{}:
+		for i, v := range value {
+			value[i] = a.redactValue(v)
+		}
+	}
+	return value
+}
+
+// countingBody counts bytes of the request body read by the proxy
+type countingBody struct {
+	io.ReadCloser
+	bytes int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.bytes += int64(n)
+	return n, err
+}
@@ -0,0 +1,105 @@
+package accesslog
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/odpf/salt/log"
+	"github.com/odpf/shield/config"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordLogger keeps fields of the last info record
+type recordLogger struct {
+	log.Logger
+	msg    string
+	fields map[string]interface{}
+}
+
+func (l *recordLogger) Info(msg string, args ...interface{}) {
+	l.msg = msg
+	l.fields = map[string]interface{}{}
+	for i := 0; i+1 < len(args); i += 2 {
+		l.fields[args[i].(string)] = args[i+1]
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	backend := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		middleware.ExtractAccessLog(req).MatchRule(&structs.Rule{
+			Frontend: structs.Frontend{Name: "create book", Method: http.MethodPost, URL: "/api/books"},
+			Backend:  structs.Backend{Namespace: "library"},
+		})
+		assert.NoError(t, middleware.EnrichRequestBody(req, 0))
+		req.Header.Set("X-Shield-Email", "user@example.com")
+		middleware.ExtractAccessLog(req).Allow([]string{"r/library_book/b1"})
+
+		record := middleware.ExtractAccessLog(req)
+		record.UpstreamStarted()
+		io.Copy(ioutil.Discard, req.Body)
+		record.UpstreamDone(http.StatusCreated)
+		record.HooksDone()
+
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(`{"id":"b1"}`))
+	})
+
+	logger := &recordLogger{}
+	conf := config.AccessLogConfig{
+		Enabled: true,
+		Headers: true,
+		Body:    true,
+		Redact:  config.AccessLogRedact{Headers: []string{"x-secret"}, BodyKeys: []string{"Password"}},
+	}
+	body := `{"name":"b1","owner":{"password":"hunter2"}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/books", strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("X-Secret", "s3cret")
+	req.Header.Set("X-Env", "dev")
+
+	New(logger, "library", "X-Shield-Email", conf, backend).ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "access", logger.msg)
+	assert.Equal(t, "create book", logger.fields["rule"])
+	assert.Equal(t, "library", logger.fields["backend"])
+	assert.Equal(t, "user@example.com", logger.fields["user"])
+	assert.Equal(t, middleware.DecisionAllow, logger.fields["authz"])
+	assert.Equal(t, []string{"r/library_book/b1"}, logger.fields["resources"])
+	assert.Equal(t, http.StatusCreated, logger.fields["status"])
+	assert.Equal(t, http.StatusCreated, logger.fields["upstream_status"])
+	assert.Equal(t, int64(len(body)), logger.fields["bytes_in"])
+	assert.Equal(t, int64(11), logger.fields["bytes_out"])
+	assert.Contains(t, logger.fields, "upstream_latency")
+	assert.Contains(t, logger.fields, "hooks_latency")
+	assert.Equal(t, map[string]string{
+		"Authorization": redacted,
+		"X-Secret":      redacted,
+		"X-Env":         "dev",
+	}, logger.fields["headers"])
+	assert.Equal(t, `{"name":"b1","owner":{"password":"[REDACTED]"}}`, logger.fields["body"])
+}
+
+func TestAccessLogDenied(t *testing.T) {
+	denied := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		middleware.ExtractAccessLog(req).Deny("no action allowed on resource b1", []string{"r/library_book/b1"})
+		rw.WriteHeader(http.StatusUnauthorized)
+	})
+
+	logger := &recordLogger{}
+	req := httptest.NewRequest(http.MethodDelete, "/api/books/b1", nil)
+	New(logger, "library", "X-Shield-Email", config.AccessLogConfig{Enabled: true}, denied).ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, middleware.DecisionDeny, logger.fields["authz"])
+	assert.Equal(t, "no action allowed on resource b1", logger.fields["reason"])
+	assert.Equal(t, http.StatusUnauthorized, logger.fields["status"])
+	assert.Equal(t, 0, logger.fields["upstream_status"])
+	assert.Contains(t, logger.fields, "middlewares_latency")
+	assert.NotContains(t, logger.fields, "upstream_latency")
+	assert.NotContains(t, logger.fields, "headers")
+}
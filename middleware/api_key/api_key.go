@@ -0,0 +1,109 @@
+package api_key
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/internal/serviceaccount"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/model"
+	"github.com/odpf/shield/structs"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/salt/log"
+)
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, rawKey string) (model.ServiceAccount, error)
+}
+
+// APIKey authenticates machine to machine requests with the API key of a
+// service account, authz middleware and hooks then check permissions of
+// the service account instead of the user from identity header
+type APIKey struct {
+	log                 log.Logger
+	identityProxyHeader string
+	next                http.Handler
+	authenticator       Authenticator
+}
+
+type Config struct {
+	// Header carrying the API key, defaults to X-Shield-Api-Key
+	Header string `yaml:"header" mapstructure:"header"`
+}
+
+func New(logger log.Logger, identityProxyHeader string, authenticator Authenticator, next http.Handler) *APIKey {
+	return &APIKey{
+		log:                 logger,
+		identityProxyHeader: identityProxyHeader,
+		next:                next,
+		authenticator:       authenticator,
+	}
+}
+
+func (w APIKey) Info() *structs.MiddlewareInfo {
+	return &structs.MiddlewareInfo{
+		Name:        "api_key",
+		Description: "service account authentication using api keys",
+	}
+}
+
+func (w *APIKey) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	wareSpec, ok := middleware.ExtractMiddleware(req, w.Info().Name)
+	if !ok {
+		w.next.ServeHTTP(rw, req)
+		return
+	}
+
+	conf, ok := wareSpec.Compiled.(*Config)
+	if !ok {
+		var err error
+		if conf, err = parseConfig(wareSpec.Config); err != nil {
+			w.log.Error("middleware: invalid config", "config", wareSpec.Config, "err", err)
+			w.notAllowed(rw)
+			return
+		}
+	}
+
+	rawKey := req.Header.Get(conf.Header)
+	if rawKey == "" {
+		w.notAllowed(rw)
+		return
+	}
+
+	serviceAccount, err := w.authenticator.Authenticate(req.Context(), rawKey)
+	if err != nil {
+		w.log.Info("middleware: api key rejected", "err", err)
+		w.notAllowed(rw)
+		return
+	}
+
+	// the key must not reach backend and caller can't act as a user
+	req.Header.Del(conf.Header)
+	req.Header.Del(w.identityProxyHeader)
+	*req = *req.WithContext(permission.SetServiceAccountToContext(req.Context(), serviceAccount))
+	middleware.ExtractAccessLog(req).SetUser("serviceaccount:" + serviceAccount.Id)
+
+	w.next.ServeHTTP(rw, req)
+}
+
+func (w APIKey) notAllowed(rw http.ResponseWriter) {
+	rw.WriteHeader(http.StatusUnauthorized)
+}
+
+// CompileConfig decodes api key config while loading rules
+func CompileConfig(config map[string]interface{}) (interface{}, error) {
+	return parseConfig(config)
+}
+
+func parseConfig(rawConfig map[string]interface{}) (*Config, error) {
+	conf := &Config{}
+	if err := mapstructure.Decode(rawConfig, conf); err != nil {
+		return nil, err
+	}
+	if conf.Header == "" {
+		conf.Header = serviceaccount.APIKeyHeader
+	}
+	return conf, nil
+}
@@ -2,6 +2,7 @@ package authz
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -12,14 +13,18 @@ import (
 	"github.com/odpf/shield/model"
 	"github.com/odpf/shield/pkg/body_extractor"
 	"github.com/odpf/shield/structs"
+	"github.com/odpf/shield/tracing"
 	"github.com/odpf/shield/utils"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/odpf/salt/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type AuthzCheckService interface {
 	CheckAuthz(ctx context.Context, resource model.Resource, action model.Action) (bool, error)
+	CheckAuthzBatch(ctx context.Context, checks []permission.ResourceAction) ([]bool, error)
 }
 
 type Authz struct {
@@ -33,12 +38,51 @@ type Authz struct {
 type Config struct {
 	Actions    []string                        `yaml:"actions" mapstructure:"actions"`
 	Attributes map[string]middleware.Attribute `yaml:"attributes" mapstructure:"attributes"` // auth field -> Attribute
+
+	// Expression is a CEL expression used instead of actions, e.g.
+	// can("read", resource) || can("admin", project)
+	Expression string `yaml:"expression" mapstructure:"expression"`
+
+	expression *expression
 }
 
 func New(log log.Logger, identityProxyHeader string, deps handler.Deps, next http.Handler, authzCheckService AuthzCheckService) *Authz {
 	return &Authz{log: log, identityProxyHeader: identityProxyHeader, Deps: deps, next: next, AuthzCheckService: authzCheckService}
 }
 
+// CompileConfig decodes and validates authz middleware config while loading rules
+func CompileConfig(config map[string]interface{}) (interface{}, error) {
+	return parseConfig(config)
+}
+
+func parseConfig(rawConfig map[string]interface{}) (*Config, error) {
+	config := &Config{}
+	if err := mapstructure.Decode(rawConfig, config); err != nil {
+		return nil, err
+	}
+	switch {
+	case len(config.Actions) == 0 && config.Expression == "":
+		return nil, errors.New("actions or expression is required")
+	case len(config.Actions) > 0 && config.Expression != "":
+		return nil, errors.New("only one of actions and expression can be set")
+	case config.Expression != "":
+		expr, err := compileExpression(config.Expression)
+		if err != nil {
+			return nil, err
+		}
+		config.expression = expr
+	}
+	for res, attr := range config.Attributes {
+		if err := attr.Validate(); err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", res, err)
+		}
+		if attr.Type == middleware.AttributeTypePathParam {
+			return nil, fmt.Errorf("attribute %s: path params are extracted by default", res)
+		}
+	}
+	return config, nil
+}
+
 func (c Authz) Info() *structs.MiddlewareInfo {
 	return &structs.MiddlewareInfo{
 		Name:        "authz",
@@ -59,17 +103,23 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	// TODO: should cache it
-	config := Config{}
-	if err := mapstructure.Decode(wareSpec.Config, &config); err != nil {
-		c.log.Error("middleware: failed to decode authz config", "config", wareSpec.Config)
-		c.notAllowed(rw)
-		return
+	// the span covers the decision, not the middlewares after it
+	_, span := tracing.Tracer().Start(req.Context(), "authz", trace.WithAttributes(attribute.String("authz.namespace", rule.Backend.Namespace)))
+	defer endSpan(span, req)
+
+	config, ok := wareSpec.Compiled.(*Config)
+	if !ok {
+		var err error
+		if config, err = parseConfig(wareSpec.Config); err != nil {
+			c.log.Error("middleware: failed to decode authz config", "config", wareSpec.Config, "err", err)
+			c.notAllowed(rw, req, "invalid authz config", nil)
+			return
+		}
 	}
 
 	if rule.Backend.Namespace == "" {
 		c.log.Error("namespace is not defined for this rule")
-		c.notAllowed(rw)
+		c.notAllowed(rw, req, "namespace not defined in rule", nil)
 		return
 	}
 
@@ -79,6 +129,7 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
 	permissionAttributes["user"] = req.Header.Get(c.identityProxyHeader)
 	req = req.WithContext(permission.SetEmailToContext(req.Context(), req.Header.Get(c.identityProxyHeader)))
+	checkCtx := trace.ContextWithSpan(req.Context(), span)
 
 	for res, attr := range config.Attributes {
 		_ = res
@@ -88,12 +139,12 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 			// check if grpc request
 			if !strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
 				c.log.Error("middleware: not a grpc request", "attr", attr)
-				c.notAllowed(rw)
+				c.notAllowed(rw, req, "not a grpc request", nil)
 				return
 			}
 
 			// TODO: we can optimise this by parsing all field at once
-			payloadField, err := body_extractor.GRPCPayloadHandler{}.Extract(&req.Body, attr.Index)
+			payloadField, err := middleware.GRPCPayload(req, attr)
 			if err != nil {
 				c.log.Error("middleware: failed to parse grpc payload", "err", err)
 				return
@@ -105,13 +156,14 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		case middleware.AttributeTypeJSONPayload:
 			if attr.Key == "" {
 				c.log.Error("middleware: payload key field empty")
-				c.notAllowed(rw)
+				c.notAllowed(rw, req, "payload key field empty", nil)
 				return
 			}
-			payloadField, err := body_extractor.JSONPayloadHandler{}.Extract(&req.Body, attr.Key)
+			body := middleware.RequestPayload(req)
+			payloadField, err := body_extractor.JSONPayloadHandler{}.Extract(&body, attr.Key)
 			if err != nil {
 				c.log.Error("middleware: failed to parse grpc payload", "err", err)
-				c.notAllowed(rw)
+				c.notAllowed(rw, req, "failed to parse json payload: "+err.Error(), nil)
 				return
 			}
 
@@ -121,13 +173,13 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		case middleware.AttributeTypeHeader:
 			if attr.Key == "" {
 				c.log.Error("middleware: header key field empty")
-				c.notAllowed(rw)
+				c.notAllowed(rw, req, "header key field empty", nil)
 				return
 			}
 			headerAttr := req.Header.Get(attr.Key)
 			if headerAttr == "" {
 				c.log.Error(fmt.Sprintf("middleware: header %s is empty", attr.Key))
-				c.notAllowed(rw)
+				c.notAllowed(rw, req, fmt.Sprintf("header %s is empty", attr.Key), nil)
 				return
 			}
 
@@ -137,13 +189,13 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		case middleware.AttributeTypeQuery:
 			if attr.Key == "" {
 				c.log.Error("middleware: query key field empty")
-				c.notAllowed(rw)
+				c.notAllowed(rw, req, "query key field empty", nil)
 				return
 			}
 			queryAttr := req.URL.Query().Get(attr.Key)
 			if queryAttr == "" {
 				c.log.Error(fmt.Sprintf("middleware: query %s is empty", attr.Key))
-				c.notAllowed(rw)
+				c.notAllowed(rw, req, fmt.Sprintf("query %s is empty", attr.Key), nil)
 				return
 			}
 
@@ -153,16 +205,39 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		case middleware.AttributeTypeConstant:
 			if attr.Value == "" {
 				c.log.Error("middleware: constant value empty")
-				c.notAllowed(rw)
+				c.notAllowed(rw, req, "constant value empty", nil)
 				return
 			}
 
 			permissionAttributes[res] = attr.Value
 			c.log.Info("middleware: extracted", "constant_key", res, "attr", permissionAttributes[res])
 
+		case middleware.AttributeTypeJWTClaim:
+			claim, ok := middleware.ExtractClaim(req, attr.Key)
+			if !ok {
+				c.log.Error(fmt.Sprintf("middleware: claim %s not found in token", attr.Key))
+				c.notAllowed(rw, req, fmt.Sprintf("claim %s not found in token", attr.Key), nil)
+				return
+			}
+
+			permissionAttributes[res] = claimValues(claim)
+			c.log.Info("middleware: extracted", "field", permissionAttributes[res], "attr", attr)
+
+		case middleware.AttributeTypeGraphQL:
+			argument, err := middleware.GraphQLArgument(req, attr.Key)
+			if err != nil {
+				c.log.Error("middleware: failed to parse graphql argument", "err", err)
+				c.notAllowed(rw, req, "failed to parse graphql argument: "+err.Error(), nil)
+				return
+			}
+
+			// arguments can be ids of any scalar type
+			permissionAttributes[res] = claimValues(argument)
+			c.log.Info("middleware: extracted", "field", permissionAttributes[res], "attr", attr)
+
 		default:
 			c.log.Error("middleware: unknown attribute type", "attr", attr)
-			c.notAllowed(rw)
+			c.notAllowed(rw, req, fmt.Sprintf("unknown attribute type %q", attr.Type), nil)
 			return
 		}
 	}
@@ -170,7 +245,7 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	paramMap, mapExists := middleware.ExtractPathParams(req)
 	if !mapExists {
 		c.log.Error("middleware: path param map doesn't exist")
-		c.notAllowed(rw)
+		c.notAllowed(rw, req, "path params not found", nil)
 		return
 	}
 
@@ -178,23 +253,52 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		permissionAttributes[key] = value
 	}
 
+	if config.expression != nil {
+		allowed, resourceIds, err := c.evalExpression(checkCtx, req, config.expression, permissionAttributes)
+		if err != nil {
+			c.log.Error("error while evaluating authz expression", "err", err)
+			c.notAllowed(rw, req, "failed to evaluate expression: "+err.Error(), resourceIds)
+			return
+		}
+		c.log.Info("authz expression evaluated", "user", permissionAttributes["user"], "result", allowed)
+		if !allowed {
+			c.notAllowed(rw, req, "expression evaluated to false", resourceIds)
+			return
+		}
+		middleware.ExtractAccessLog(req).Allow(resourceIds)
+		enrichIdentity(req, c.identityProxyHeader, permissionAttributes)
+		endSpan(span, req)
+		c.next.ServeHTTP(rw, req)
+		return
+	}
+
 	resources, err := createResources(permissionAttributes)
 	if err != nil {
 		c.log.Error("error while creating resource obj", "err", err)
-		c.notAllowed(rw)
+		c.notAllowed(rw, req, "failed to create resources: "+err.Error(), nil)
 		return
 	}
+
+	// every resource needs at least one of the actions, all pairs are
+	// checked at once so the user is resolved a single time
+	var checks []permission.ResourceAction
 	for _, resource := range resources {
-		isAuthorized := false
 		for _, actionId := range config.Actions {
-			isAuthorized, err = c.AuthzCheckService.CheckAuthz(req.Context(), resource, model.Action{Id: actionId})
-			if err != nil {
-				c.log.Error("error while creating resource obj", "err", err)
-				c.notAllowed(rw)
-				return
-			}
+			checks = append(checks, permission.ResourceAction{Resource: resource, Action: model.Action{Id: actionId}})
+		}
+	}
+	results, err := c.AuthzCheckService.CheckAuthzBatch(checkCtx, checks)
+	if err != nil {
+		c.log.Error("error while checking permissions", "err", err)
+		c.notAllowed(rw, req, "failed to check permissions: "+err.Error(), checkedResourceIds(checks))
+		return
+	}
 
-			if isAuthorized {
+	for i, resource := range resources {
+		isAuthorized := false
+		for _, allowed := range results[i*len(config.Actions) : (i+1)*len(config.Actions)] {
+			if allowed {
+				isAuthorized = true
 				break
 			}
 		}
@@ -202,17 +306,101 @@ func (c *Authz) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		c.log.Info("authz check successful", "user", permissionAttributes["user"], "resource", resource.Name, "result", isAuthorized)
 		if !isAuthorized {
 			c.log.Info("user not allowed to make request", "user", permissionAttributes["user"], "resource", resource.Name, "result", isAuthorized)
-			c.notAllowed(rw)
+			c.notAllowed(rw, req, fmt.Sprintf("no action allowed on resource %s", resource.Name), checkedResourceIds(checks))
 			return
 		}
 	}
 
+	middleware.ExtractAccessLog(req).Allow(checkedResourceIds(checks))
+	enrichIdentity(req, c.identityProxyHeader, permissionAttributes)
+	endSpan(span, req)
 	c.next.ServeHTTP(rw, req)
 }
 
-func (w Authz) notAllowed(rw http.ResponseWriter) {
+// evalExpression makes all permission checks of the expression in a batch
+// and evaluates it with their results, ids of the checked resources are
+// returned along with it
+func (c *Authz) evalExpression(ctx context.Context, req *http.Request, expr *expression, attributes map[string]interface{}) (bool, []string, error) {
+	var checks []permission.ResourceAction
+	counts := make([]int, len(expr.checks))
+	for i, check := range expr.checks {
+		resources, err := expressionResources(check.subject, attributes)
+		if err != nil {
+			return false, nil, fmt.Errorf("can(%q, %s): %w", check.action, check.subject, err)
+		}
+		for _, resource := range resources {
+			checks = append(checks, permission.ResourceAction{Resource: resource, Action: model.Action{Id: check.action}})
+		}
+		counts[i] = len(resources)
+	}
+	resourceIds := checkedResourceIds(checks)
+	results, err := c.AuthzCheckService.CheckAuthzBatch(ctx, checks)
+	if err != nil {
+		return false, resourceIds, err
+	}
+
+	// a check passes if the action is allowed on all of its resources
+	checkResults := map[string]bool{}
+	offset := 0
+	for i, check := range expr.checks {
+		allowed := counts[i] > 0
+		for _, result := range results[offset : offset+counts[i]] {
+			allowed = allowed && result
+		}
+		checkResults[check.key()] = allowed
+		offset += counts[i]
+	}
+	allowed, err := expr.eval(attributes, req, checkResults)
+	return allowed, resourceIds, err
+}
+
+// enrichIdentity keeps the authorized caller for the next middlewares
+func enrichIdentity(req *http.Request, identityProxyHeader string, attributes map[string]interface{}) {
+	identity := middleware.Identity{
+		Email: req.Header.Get(identityProxyHeader),
+	}
+	if projects, err := getAttributesValues(attributes["project"]); err == nil && len(projects) > 0 {
+		identity.ProjectId = projects[0]
+	}
+	if orgs, err := getAttributesValues(attributes["organization"]); err == nil && len(orgs) > 0 {
+		identity.OrganizationId = orgs[0]
+	}
+	middleware.EnrichIdentity(req, identity)
+	middleware.ExtractAccessLog(req).SetUser(identity.Email)
+}
+
+// endSpan ends the authz span with the decision kept in the access log, the
+// span is ended before the next middleware on allowed requests
+func endSpan(span trace.Span, req *http.Request) {
+	if record := middleware.ExtractAccessLog(req); record != nil && record.Decision != "" {
+		span.SetAttributes(attribute.String("authz.decision", record.Decision))
+		if record.Reason != "" {
+			span.SetAttributes(attribute.String("authz.reason", record.Reason))
+		}
+	}
+	span.End()
+}
+
+// notAllowed denies the request, reason and the checked resources are kept
+// in the access log
+func (w Authz) notAllowed(rw http.ResponseWriter, req *http.Request, reason string, resourceIds []string) {
+	middleware.ExtractAccessLog(req).Deny(reason, resourceIds)
 	rw.WriteHeader(http.StatusUnauthorized)
-	return
+}
+
+// checkedResourceIds returns ids of the resources of checks without
+// duplicates, in the order they are checked
+func checkedResourceIds(checks []permission.ResourceAction) []string {
+	var ids []string
+	seen := map[string]bool{}
+	for _, check := range checks {
+		id := utils.CreateResourceId(check.Resource)
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
 }
 
 func createResources(permissionAttributes map[string]interface{}) ([]model.Resource, error) {
@@ -251,6 +439,21 @@ func createResources(permissionAttributes map[string]interface{}) ([]model.Resou
 	return resources, nil
 }
 
+// claimValues converts a decoded json claim to attribute values
+func claimValues(claim interface{}) []string {
+	var values []string
+	switch claim := claim.(type) {
+	case []interface{}:
+		for _, v := range claim {
+			values = append(values, fmt.Sprint(v))
+		}
+	case nil:
+	default:
+		values = append(values, fmt.Sprint(claim))
+	}
+	return values
+}
+
 func getAttributesValues(attributes interface{}) ([]string, error) {
 	var values []string
 	switch attributes.(type) {
@@ -0,0 +1,155 @@
+package authz
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/odpf/shield/internal/bootstrap/definition"
+	"github.com/odpf/shield/model"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/parser"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// checksVar holds results of permission checks made before evaluating an
+// expression, can(action, resource) is expanded to a lookup in it
+const checksVar = "_checks"
+
+// expressionSubjects are the resources can() checks, resource is the
+// resource of the rule and the others are shield namespaces
+var expressionSubjects = map[string]string{
+	"resource":     "",
+	"project":      definition.ProjectNamespace.Id,
+	"organization": definition.OrgNamespace.Id,
+	"team":         definition.TeamNamespace.Id,
+}
+
+// expression is a CEL expression of the config compiled while loading rules,
+// permission checks it makes are known upfront so they are batched
+type expression struct {
+	program cel.Program
+	checks  []expressionCheck
+}
+
+type expressionCheck struct {
+	action  string
+	subject string
+}
+
+func (c expressionCheck) key() string {
+	return c.action + " " + c.subject
+}
+
+func compileExpression(source string) (*expression, error) {
+	expr := &expression{}
+	seen := map[string]bool{}
+	can := parser.NewGlobalMacro("can", 2, func(eh parser.ExprHelper, _ *exprpb.Expr, args []*exprpb.Expr) (*exprpb.Expr, *common.Error) {
+		action, ok := args[0].GetConstExpr().GetConstantKind().(*exprpb.Constant_StringValue)
+		if !ok {
+			return nil, &common.Error{
+				Message:  "action of can() must be a string literal",
+				Location: eh.OffsetLocation(args[0].GetId()),
+			}
+		}
+		subject := args[1].GetIdentExpr().GetName()
+		if _, ok := expressionSubjects[subject]; !ok {
+			return nil, &common.Error{
+				Message:  "resource of can() must be one of resource, project, organization or team",
+				Location: eh.OffsetLocation(args[1].GetId()),
+			}
+		}
+
+		check := expressionCheck{action: action.StringValue, subject: subject}
+		if !seen[check.key()] {
+			seen[check.key()] = true
+			expr.checks = append(expr.checks, check)
+		}
+		return eh.GlobalCall(operators.Index, eh.Ident(checksVar), eh.LiteralString(check.key())), nil
+	})
+
+	env, err := cel.NewEnv(
+		cel.Macros(can),
+		cel.Declarations(
+			decls.NewVar("attributes", decls.NewMapType(decls.String, decls.Dyn)),
+			decls.NewVar("request", decls.NewMapType(decls.String, decls.Dyn)),
+			decls.NewVar(checksVar, decls.NewMapType(decls.String, decls.Bool)),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(source)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("invalid expression: %w", iss.Err())
+	}
+	if !proto.Equal(ast.ResultType(), decls.Bool) && !proto.Equal(ast.ResultType(), decls.Dyn) {
+		return nil, fmt.Errorf("expression must evaluate to a bool")
+	}
+	if expr.program, err = env.Program(ast); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+// eval evaluates the expression with results of its checks keyed by
+// expressionCheck.key
+func (e *expression) eval(attributes map[string]interface{}, req *http.Request, checks map[string]bool) (bool, error) {
+	out, _, err := e.program.Eval(map[string]interface{}{
+		"attributes": attributes,
+		"request":    expressionRequest(req),
+		checksVar:    checks,
+	})
+	if err != nil {
+		return false, err
+	}
+	allowed, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %v instead of a bool", out.Value())
+	}
+	return allowed, nil
+}
+
+// expressionRequest is the request metadata expressions can read, header
+// names are lower case
+func expressionRequest(req *http.Request) map[string]interface{} {
+	header := map[string]interface{}{}
+	for name := range req.Header {
+		header[strings.ToLower(name)] = req.Header.Get(name)
+	}
+	query := map[string]interface{}{}
+	for name, values := range req.URL.Query() {
+		query[name] = values[0]
+	}
+	return map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+		"header": header,
+		"query":  query,
+	}
+}
+
+// expressionResources returns the resources can(action, subject) checks,
+// the action has to be allowed on all of them
+func expressionResources(subject string, attributes map[string]interface{}) ([]model.Resource, error) {
+	if subject == "resource" {
+		return createResources(attributes)
+	}
+	ids, err := getAttributesValues(attributes[subject])
+	if err != nil {
+		return nil, err
+	}
+	var resources []model.Resource
+	for _, id := range ids {
+		resources = append(resources, model.Resource{
+			Name:        id,
+			NamespaceId: expressionSubjects[subject],
+		})
+	}
+	return resources, nil
+}
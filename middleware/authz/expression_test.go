@@ -0,0 +1,104 @@
+package authz
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// allowedChecks allows actions on resources named in allowed
+type allowedChecks struct {
+	allowed map[string]bool
+}
+
+func (a *allowedChecks) CheckAuthz(ctx context.Context, resource model.Resource, action model.Action) (bool, error) {
+	return a.allowed[action.Id+" "+resource.NamespaceId+" "+resource.Name], nil
+}
+
+func (a *allowedChecks) CheckAuthzBatch(ctx context.Context, checks []permission.ResourceAction) ([]bool, error) {
+	results := make([]bool, len(checks))
+	for i, check := range checks {
+		results[i], _ = a.CheckAuthz(ctx, check.Resource, check.Action)
+	}
+	return results, nil
+}
+
+func TestCompileExpression(t *testing.T) {
+	table := []struct {
+		expression string
+		checks     int
+		err        bool
+	}{
+		{expression: `can("read", resource) || can("admin", project)`, checks: 2},
+		{expression: `can("read", resource) && request.header["x-env"] != "prod" || can("read", resource)`, checks: 1},
+		{expression: `attributes.user.endsWith("@odpf.io")`},
+		{expression: `can(action, resource)`, err: true},
+		{expression: `can("read", attributes.project)`, err: true},
+		{expression: `project == "books"`, err: true},
+		{expression: `size(attributes)`, err: true},
+		{expression: `can("read", resource) &&`, err: true},
+	}
+	for _, tt := range table {
+		expr, err := compileExpression(tt.expression)
+		assert.Equal(t, tt.err, err != nil, tt.expression)
+		if !tt.err {
+			assert.Len(t, expr.checks, tt.checks, tt.expression)
+		}
+	}
+}
+
+func TestEvalExpression(t *testing.T) {
+	attributes := map[string]interface{}{
+		"user":          "jane@odpf.io",
+		"namespace":     "entropy",
+		"resource":      []string{"firehose-1", "firehose-2"},
+		"resource_type": "firehose",
+		"project":       "books",
+	}
+	checks := &allowedChecks{allowed: map[string]bool{
+		"read entropy_firehose firehose-1":  true,
+		"read entropy_firehose firehose-2":  true,
+		"write entropy_firehose firehose-1": true,
+		"admin project books":               true,
+	}}
+	ware := &Authz{AuthzCheckService: checks}
+
+	guarded := `can("read", resource) && !("x-env" in request.header && request.header["x-env"] == "prod")`
+	table := []struct {
+		expression string
+		env        string
+		want       bool
+		err        bool
+	}{
+		{expression: `can("read", resource)`, want: true},
+		{expression: `can("write", resource)`, want: false},
+		{expression: `can("write", resource) || can("admin", project)`, want: true},
+		{expression: `can("read", resource) && request.header["x-env"] != "prod"`, env: "prod", want: false},
+		{expression: `can("read", resource) && request.header["x-env"] != "prod"`, env: "dev", want: true},
+		{expression: `can("read", organization)`, want: false},
+		{expression: guarded, env: "prod", want: false},
+		{expression: guarded, env: "dev", want: true},
+		{expression: guarded, want: true},
+		// a missing header is an error and isn't allowed unless guarded
+		{expression: `can("read", resource) && request.header["x-env"] != "prod"`, want: false, err: true},
+		{expression: `can("write", resource) && request.header["x-env"] != "prod"`, want: false},
+		{expression: `request.header["x-env"] != "prod" || can("admin", project)`, want: true},
+	}
+	for _, tt := range table {
+		expr, err := compileExpression(tt.expression)
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/firehoses", nil)
+		if tt.env != "" {
+			req.Header.Set("X-Env", tt.env)
+		}
+		allowed, _, err := ware.evalExpression(req.Context(), req, expr, attributes)
+		assert.Equal(t, tt.err, err != nil, tt.expression)
+		assert.Equal(t, tt.want, allowed, tt.expression)
+	}
+}
@@ -2,6 +2,7 @@ package basic_auth
 
 import (
 	"bytes"
+	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
@@ -78,12 +79,14 @@ func (w *BasicAuth) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	// note: might effect performance, consider caching
-	conf := Config{}
-	if err := mapstructure.Decode(wareSpec.Config, &conf); err != nil {
-		w.log.Error("middleware: invalid config", "config", wareSpec.Config)
-		w.notAllowed(rw)
-		return
+	conf, ok := wareSpec.Compiled.(*compiledConfig)
+	if !ok {
+		var err error
+		if conf, err = parseConfig(wareSpec.Config); err != nil {
+			w.log.Error("middleware: invalid config", "config", wareSpec.Config, "err", err)
+			w.notAllowed(rw)
+			return
+		}
 	}
 	authenticator := goauth.NewBasicAuthenticator("shield", func(user, realm string) string {
 		for _, credential := range conf.Users {
@@ -97,6 +100,7 @@ func (w *BasicAuth) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	var authedUser string
 	if authedUser = authenticator.CheckAuth(req); authedUser != "" {
 		req.Header.Set("X-User", authedUser)
+		middleware.ExtractAccessLog(req).SetUser(authedUser)
 	} else {
 		w.notAllowed(rw)
 		return
@@ -118,19 +122,14 @@ func (w BasicAuth) notAllowed(rw http.ResponseWriter) {
 	return
 }
 
-func (w BasicAuth) authorizeRequest(conf Config, user string, req *http.Request) bool {
-	var userCapabilities []string
-	for _, u := range conf.Users {
-		if u.User == user {
-			userCapabilities = u.Capabilities
-		}
-	}
+func (w BasicAuth) authorizeRequest(conf *compiledConfig, user string, req *http.Request) bool {
+	userCapabilities := conf.capabilities[user]
 	if len(userCapabilities) == 0 {
 		return false
 	}
 	// check if its superuser
 	for _, cap := range userCapabilities {
-		if cap == "*" {
+		if cap.value == "*" {
 			return true
 		}
 	}
@@ -148,7 +147,7 @@ func (w BasicAuth) authorizeRequest(conf Config, user string, req *http.Request)
 			}
 
 			// TODO: we can optimise this by parsing all field at once
-			payloadField, err := body_extractor.GRPCPayloadHandler{}.Extract(&req.Body, attr.Index)
+			payloadField, err := middleware.GRPCPayload(req, attr)
 			if err != nil {
 				w.log.Error("middleware: failed to parse grpc payload", "err", err)
 				return false
@@ -161,7 +160,8 @@ func (w BasicAuth) authorizeRequest(conf Config, user string, req *http.Request)
 				w.log.Error("middleware: payload key field empty")
 				return false
 			}
-			payloadField, err := body_extractor.JSONPayloadHandler{}.Extract(&req.Body, attr.Key)
+			body := middleware.RequestPayload(req)
+			payloadField, err := body_extractor.JSONPayloadHandler{}.Extract(&body, attr.Key)
 			if err != nil {
 				w.log.Error("middleware: failed to parse grpc payload", "err", err)
 				return false
@@ -169,6 +169,15 @@ func (w BasicAuth) authorizeRequest(conf Config, user string, req *http.Request)
 
 			templateMap[res] = payloadField
 			w.log.Debug("middleware: extracted", "field", payloadField, "attr", attr)
+		case middleware.AttributeTypeGraphQL:
+			argument, err := middleware.GraphQLArgument(req, attr.Key)
+			if err != nil {
+				w.log.Error("middleware: failed to parse graphql argument", "err", err)
+				return false
+			}
+
+			templateMap[res] = argument
+			w.log.Debug("middleware: extracted", "field", argument, "attr", attr)
 		default:
 			w.log.Error("middleware: unknown attribute type", "attr", attr)
 			return false
@@ -176,13 +185,13 @@ func (w BasicAuth) authorizeRequest(conf Config, user string, req *http.Request)
 	}
 
 	var isAllowed = false
-	compiledAction, err := CompileString(conf.Scope.Action, templateMap)
+	compiledAction, err := executeTemplate(conf.action, templateMap)
 	if err != nil {
 		w.log.Error("middleware: action parsing failed", "err", err)
 		return false
 	}
 	for _, userCap := range userCapabilities {
-		if w.matchAction(userCap, compiledAction) {
+		if userCap.match(compiledAction) {
 			isAllowed = true
 			break
 		}
@@ -190,30 +199,87 @@ func (w BasicAuth) authorizeRequest(conf Config, user string, req *http.Request)
 	return isAllowed
 }
 
-func (w BasicAuth) matchAction(cap, action string) bool {
+// compiledConfig holds the action template and capability expressions
+// parsed once while loading rules
+type compiledConfig struct {
+	Config
+	action       *template.Template
+	capabilities map[string][]capability
+}
+
+type capability struct {
+	value string
+	rx    *regexp.Regexp
+}
+
+func (c capability) match(action string) bool {
 	// do regex compare if required
-	if strings.HasPrefix(cap, RegexPrefix) {
-		cap = strings.TrimPrefix(cap, RegexPrefix)
-		rxAction, err := regexp.Compile(cap)
+	if c.rx != nil && c.rx.MatchString(action) {
+		return true
+	}
+	return c.value == action
+}
+
+// CompileConfig decodes basic auth config and compiles its action template
+// and capability expressions while loading rules
+func CompileConfig(config map[string]interface{}) (interface{}, error) {
+	return parseConfig(config)
+}
+
+func parseConfig(rawConfig map[string]interface{}) (*compiledConfig, error) {
+	conf := &compiledConfig{capabilities: map[string][]capability{}}
+	if err := mapstructure.Decode(rawConfig, &conf.Config); err != nil {
+		return nil, err
+	}
+
+	for _, u := range conf.Users {
+		if u.User == "" || u.Password == "" {
+			return nil, fmt.Errorf("user and password are required for basic auth users")
+		}
+		var caps []capability
+		for _, c := range u.Capabilities {
+			cap := capability{value: c}
+			if strings.HasPrefix(c, RegexPrefix) {
+				rx, err := regexp.Compile(strings.TrimPrefix(c, RegexPrefix))
+				if err != nil {
+					return nil, fmt.Errorf("capability %s of user %s: %w", c, u.User, err)
+				}
+				cap.rx = rx
+			}
+			caps = append(caps, cap)
+		}
+		conf.capabilities[u.User] = caps
+	}
+
+	if conf.Scope.Action != "" {
+		tmpl, err := template.New("shield_engine").Parse(conf.Scope.Action)
 		if err != nil {
-			w.log.Warn("failed to compile regex", "exp", cap, "err", err)
-			return false
+			return nil, fmt.Errorf("scope action: %w", err)
 		}
-		if rxAction.MatchString(action) {
-			return true
+		conf.action = tmpl
+	}
+	for res, attr := range conf.Scope.Attributes {
+		if err := attr.Validate(); err != nil {
+			return nil, fmt.Errorf("scope attribute %s: %w", res, err)
+		}
+		if attr.Type != middleware.AttributeTypeGRPCPayload && attr.Type != middleware.AttributeTypeJSONPayload {
+			return nil, fmt.Errorf("scope attribute %s: unsupported attribute type %s", res, attr.Type)
 		}
 	}
-	return cap == action
+	return conf, nil
 }
 
 func CompileString(input string, context map[string]interface{}) (string, error) {
-	// note: template can be cached
 	tmpl, err := template.New("shield_engine").Parse(input)
 	if err != nil {
 		return "", err
 	}
+	return executeTemplate(tmpl, context)
+}
+
+func executeTemplate(tmpl *template.Template, context map[string]interface{}) (string, error) {
 	var buf bytes.Buffer
-	if err = tmpl.Execute(&buf, context); err != nil {
+	if err := tmpl.Execute(&buf, context); err != nil {
 		return "", err
 	}
 	return strings.TrimSpace(buf.String()), nil
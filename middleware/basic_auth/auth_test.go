@@ -0,0 +1,62 @@
+package basic_auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConfig(t *testing.T) {
+	t.Run("compiles capabilities and scope action", func(t *testing.T) {
+		conf, err := parseConfig(map[string]interface{}{
+			"users": []map[string]interface{}{
+				{"user": "user", "password": "hash", "capabilities": []string{"ping", "r#^project-.*$"}},
+			},
+			"scope": map[string]interface{}{
+				"action": "project-{{ .project }}",
+				"attributes": map[string]interface{}{
+					"project": map[string]interface{}{"type": "json_payload", "key": "project"},
+				},
+			},
+		})
+		assert.NoError(t, err)
+
+		action, err := executeTemplate(conf.action, map[string]interface{}{"project": "alpha"})
+		assert.NoError(t, err)
+		assert.Equal(t, "project-alpha", action)
+
+		caps := conf.capabilities["user"]
+		assert.Len(t, caps, 2)
+		assert.True(t, caps[0].match("ping"))
+		assert.False(t, caps[0].match("pong"))
+		assert.True(t, caps[1].match(action))
+	})
+
+	t.Run("rejects invalid capability regex", func(t *testing.T) {
+		_, err := parseConfig(map[string]interface{}{
+			"users": []map[string]interface{}{
+				{"user": "user", "password": "hash", "capabilities": []string{"r#[a-"}},
+			},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects invalid scope action template", func(t *testing.T) {
+		_, err := parseConfig(map[string]interface{}{
+			"scope": map[string]interface{}{"action": "{{ .project "},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects unsupported scope attribute", func(t *testing.T) {
+		_, err := parseConfig(map[string]interface{}{
+			"scope": map[string]interface{}{
+				"action": "ping",
+				"attributes": map[string]interface{}{
+					"project": map[string]interface{}{"type": "header", "key": "X-Project"},
+				},
+			},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,247 @@
+package headers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/salt/log"
+)
+
+// Headers rewrites request headers before they are sent to backend
+// Values are go templates and can refer to the identity resolved by
+// authz middleware, e.g. "{{ .UserId }}", "{{ .OrganizationId }}"
+type Headers struct {
+	log                 log.Logger
+	identityProxyHeader string
+	next                http.Handler
+	Deps                handler.Deps
+}
+
+// Config is applied in order of remove, set and append
+type Config struct {
+	Set    map[string]string `yaml:"set" mapstructure:"set"`
+	Append map[string]string `yaml:"append" mapstructure:"append"`
+	Remove []string          `yaml:"remove" mapstructure:"remove"`
+}
+
+func New(log log.Logger, identityProxyHeader string, deps handler.Deps, next http.Handler) *Headers {
+	return &Headers{log: log, identityProxyHeader: identityProxyHeader, Deps: deps, next: next}
+}
+
+func (h Headers) Info() *structs.MiddlewareInfo {
+	return &structs.MiddlewareInfo{
+		Name:        "headers",
+		Description: "set, append and remove request headers sent to backend",
+	}
+}
+
+func (h *Headers) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	wareSpec, ok := middleware.ExtractMiddleware(req, h.Info().Name)
+	if !ok {
+		h.next.ServeHTTP(rw, req)
+		return
+	}
+
+	rewriter, ok := wareSpec.Compiled.(*Rewriter)
+	if !ok {
+		var err error
+		if rewriter, err = ParseConfig(wareSpec.Config); err != nil {
+			h.log.Error("middleware: failed to decode headers config", "config", wareSpec.Config, "err", err)
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	data := NewTemplateData(req, h.identityProxyHeader, h.Deps)
+	if err := rewriter.Rewrite(req.Header, data); err != nil {
+		h.log.Error("middleware: failed to rewrite headers", "err", err)
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.next.ServeHTTP(rw, req)
+}
+
+// Rewriter applies a headers config with its values compiled as templates
+type Rewriter struct {
+	remove []string
+	set    []headerValue
+	append []headerValue
+}
+
+type headerValue struct {
+	key   string
+	value string
+	tmpl  *template.Template
+}
+
+func (v headerValue) render(data *TemplateData) (string, error) {
+	if v.tmpl == nil {
+		return v.value, nil
+	}
+	var buf bytes.Buffer
+	if err := v.tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("header %s: %w", v.key, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// CompileConfig decodes headers config and compiles its templates while
+// loading rules, used for both headers middleware and hook
+func CompileConfig(config map[string]interface{}) (interface{}, error) {
+	return ParseConfig(config)
+}
+
+func ParseConfig(rawConfig map[string]interface{}) (*Rewriter, error) {
+	config := Config{}
+	if err := mapstructure.Decode(rawConfig, &config); err != nil {
+		return nil, err
+	}
+	return Compile(config)
+}
+
+func Compile(config Config) (*Rewriter, error) {
+	set, err := compileValues(config.Set)
+	if err != nil {
+		return nil, err
+	}
+	appended, err := compileValues(config.Append)
+	if err != nil {
+		return nil, err
+	}
+	return &Rewriter{
+		remove: config.Remove,
+		set:    set,
+		append: appended,
+	}, nil
+}
+
+func compileValues(values map[string]string) ([]headerValue, error) {
+	var compiled []headerValue
+	for key, value := range values {
+		hv := headerValue{key: key, value: value}
+		if strings.Contains(value, "{{") {
+			tmpl, err := template.New(key).Parse(value)
+			if err != nil {
+				return nil, fmt.Errorf("header %s: %w", key, err)
+			}
+			hv.tmpl = tmpl
+		}
+		compiled = append(compiled, hv)
+	}
+	sort.Slice(compiled, func(i, j int) bool {
+		return compiled[i].key < compiled[j].key
+	})
+	return compiled, nil
+}
+
+// Rewrite applies config over headers in order of remove, set and append.
+// A set value rendered empty removes the header, so clients can't pass their
+// own value when there is no identity to render, appended empty values are
+// skipped.
+func (r *Rewriter) Rewrite(header http.Header, data *TemplateData) error {
+	for _, key := range r.remove {
+		header.Del(key)
+	}
+	for _, hv := range r.set {
+		rendered, err := hv.render(data)
+		if err != nil {
+			return err
+		}
+		if rendered == "" {
+			header.Del(hv.key)
+			continue
+		}
+		header.Set(hv.key, rendered)
+	}
+	for _, hv := range r.append {
+		rendered, err := hv.render(data)
+		if err != nil {
+			return err
+		}
+		if rendered == "" {
+			continue
+		}
+		header.Add(hv.key, rendered)
+	}
+	return nil
+}
+
+// TemplateData is exposed to header templates, user and organization are
+// only looked up when a template refers to them
+type TemplateData struct {
+	ctx      context.Context
+	req      *http.Request
+	identity middleware.Identity
+	deps     handler.Deps
+
+	userId string
+}
+
+func NewTemplateData(req *http.Request, identityProxyHeader string, deps handler.Deps) *TemplateData {
+	identity, ok := middleware.ExtractIdentity(req)
+	if !ok || identity.Email == "" {
+		identity.Email = req.Header.Get(identityProxyHeader)
+	}
+	return &TemplateData{
+		ctx:      req.Context(),
+		req:      req,
+		identity: identity,
+		deps:     deps,
+	}
+}
+
+func (d *TemplateData) Email() string {
+	return d.identity.Email
+}
+
+func (d *TemplateData) UserId() (string, error) {
+	if d.userId != "" || d.identity.Email == "" {
+		return d.userId, nil
+	}
+	user, err := d.deps.V1beta1.UserService.GetCurrentUser(d.ctx, d.identity.Email)
+	if err != nil {
+		return "", err
+	}
+	d.userId = user.Id
+	return d.userId, nil
+}
+
+func (d *TemplateData) ProjectId() string {
+	return d.identity.ProjectId
+}
+
+func (d *TemplateData) OrganizationId() (string, error) {
+	if d.identity.OrganizationId != "" || d.identity.ProjectId == "" {
+		return d.identity.OrganizationId, nil
+	}
+	project, err := d.deps.V1beta1.ProjectService.Get(d.ctx, d.identity.ProjectId)
+	if err != nil {
+		return "", err
+	}
+	d.identity.OrganizationId = project.Organization.Id
+	return d.identity.OrganizationId, nil
+}
+
+func (d *TemplateData) Header(key string) string {
+	return d.req.Header.Get(key)
+}
+
+func (d *TemplateData) Query(key string) string {
+	return d.req.URL.Query().Get(key)
+}
+
+func (d *TemplateData) PathParam(key string) string {
+	params, _ := middleware.ExtractPathParams(d.req)
+	return params[key]
+}
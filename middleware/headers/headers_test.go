@@ -0,0 +1,104 @@
+package headers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/api/handler/v1beta1"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/model"
+	"github.com/stretchr/testify/assert"
+)
+
+type userService struct {
+	v1beta1.UserService
+	calls int
+}
+
+func (s *userService) GetCurrentUser(ctx context.Context, email string) (model.User, error) {
+	s.calls++
+	return model.User{Id: "user-id", Email: email}, nil
+}
+
+type projectService struct {
+	v1beta1.ProjectService
+}
+
+func (s projectService) Get(ctx context.Context, id string) (model.Project, error) {
+	return model.Project{Id: id, Organization: model.Organization{Id: "org-id"}}, nil
+}
+
+func TestRewrite(t *testing.T) {
+	users := &userService{}
+	deps := handler.Deps{V1beta1: v1beta1.Dep{UserService: users, ProjectService: projectService{}}}
+
+	req := httptest.NewRequest(http.MethodGet, "/api?env=prod", nil)
+	req.Header.Set("X-Shield-Email", "user@odpf.io")
+	req.Header.Set("X-Internal", "secret")
+	req.Header.Set("X-Tags", "a")
+	middleware.EnrichIdentity(req, middleware.Identity{ProjectId: "project-id"})
+
+	rewriter, err := Compile(Config{
+		Remove: []string{"X-Internal"},
+		Set: map[string]string{
+			"X-Shield-User-Id": "{{ .UserId }}",
+			"X-Shield-Org-Id":  "{{ .OrganizationId }}",
+			"X-Shield-Project": "{{ .ProjectId }}",
+			"X-Env":            `{{ .Query "env" }}`,
+			"X-Empty":          `{{ .Header "X-Missing" }}`,
+			"X-Static":         "shield",
+		},
+		Append: map[string]string{
+			"X-Tags": "{{ .UserId }}",
+		},
+	})
+	assert.NoError(t, err)
+
+	data := NewTemplateData(req, "X-Shield-Email", deps)
+	assert.NoError(t, rewriter.Rewrite(req.Header, data))
+
+	assert.Equal(t, "", req.Header.Get("X-Internal"))
+	assert.Equal(t, "user-id", req.Header.Get("X-Shield-User-Id"))
+	assert.Equal(t, "org-id", req.Header.Get("X-Shield-Org-Id"))
+	assert.Equal(t, "project-id", req.Header.Get("X-Shield-Project"))
+	assert.Equal(t, "prod", req.Header.Get("X-Env"))
+	assert.Equal(t, "shield", req.Header.Get("X-Static"))
+	assert.Equal(t, []string{"a", "user-id"}, req.Header.Values("X-Tags"))
+	_, exists := req.Header["X-Empty"]
+	assert.False(t, exists)
+	assert.Equal(t, 1, users.calls)
+
+	rewriter, err = Compile(Config{Set: map[string]string{"X-Bad": "{{ .Unknown }}"}})
+	assert.NoError(t, err)
+	assert.Error(t, rewriter.Rewrite(req.Header, data))
+
+	_, err = Compile(Config{Set: map[string]string{"X-Bad": "{{ .UserId "}})
+	assert.Error(t, err)
+}
+
+func TestRewriteRemovesSpoofedHeaders(t *testing.T) {
+	deps := handler.Deps{V1beta1: v1beta1.Dep{UserService: &userService{}, ProjectService: projectService{}}}
+
+	req := httptest.NewRequest(http.MethodGet, "/api", nil)
+	req.Header.Set("X-Shield-User-Id", "spoofed-user-id")
+	req.Header.Set("X-Shield-Org-Id", "spoofed-org-id")
+
+	rewriter, err := Compile(Config{
+		Set: map[string]string{
+			"X-Shield-User-Id": "{{ .UserId }}",
+			"X-Shield-Org-Id":  "{{ .OrganizationId }}",
+		},
+	})
+	assert.NoError(t, err)
+
+	data := NewTemplateData(req, "X-Shield-Email", deps)
+	assert.NoError(t, rewriter.Rewrite(req.Header, data))
+
+	_, exists := req.Header["X-Shield-User-Id"]
+	assert.False(t, exists)
+	_, exists = req.Header["X-Shield-Org-Id"]
+	assert.False(t, exists)
+}
@@ -0,0 +1,205 @@
+package jwt_auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"gopkg.in/square/go-jose.v2"
+)
+
+const (
+	// minRefreshInterval limits refetching a key set when a token is signed
+	// with an unknown key id, e.g. after keys are rotated
+	minRefreshInterval = 30 * time.Second
+)
+
+var ErrKeyNotFound = errors.New("signing key not found in key set")
+
+type keySet struct {
+	keys      []jose.JSONWebKey
+	fetchedAt time.Time
+}
+
+// find returns keys usable for verifying a token signed with alg, all keys
+// of the set are tried if token doesn't carry a key id
+func (s *keySet) find(kid, alg string) []jose.JSONWebKey {
+	var keys []jose.JSONWebKey
+	for _, k := range s.keys {
+		if kid != "" && k.KeyID != kid {
+			continue
+		}
+		if k.Algorithm != "" && k.Algorithm != alg {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// keySetCache fetches JWKS documents from a url or a file and keeps them
+// for the configured duration, sets are shared by all rules using the same url
+type keySetCache struct {
+	client *http.Client
+
+	// fetching deduplicates concurrent fetches of a source, the lock is not
+	// held while fetching
+	fetching singleflight.Group
+
+	mu   sync.Mutex
+	sets map[string]*keySet
+}
+
+func newKeySetCache(client *http.Client) *keySetCache {
+	return &keySetCache{
+		client: client,
+		sets:   map[string]*keySet{},
+	}
+}
+
+// keys returns keys matching kid and alg from the set at source
+func (c *keySetCache) keys(source string, ttl time.Duration, kid, alg string) ([]jose.JSONWebKey, error) {
+	c.mu.Lock()
+	set, ok := c.sets[source]
+	c.mu.Unlock()
+
+	if !ok || time.Since(set.fetchedAt) > ttl {
+		fetched, err := c.fetch(source)
+		if err != nil {
+			if !ok {
+				return nil, err
+			}
+			// keep verifying with the last known keys if source is unavailable
+			set = c.keep(source, set)
+		} else {
+			set = fetched
+		}
+	}
+
+	keys := set.find(kid, alg)
+	if len(keys) == 0 && time.Since(set.fetchedAt) > minRefreshInterval {
+		fetched, err := c.fetch(source)
+		if err != nil {
+			return nil, err
+		}
+		keys = fetched.find(kid, alg)
+	}
+	if len(keys) == 0 {
+		return nil, ErrKeyNotFound
+	}
+	return keys, nil
+}
+
+// fetch reads the set at source once for all requests waiting on it. The
+// read isn't bound to the context of any one of them, the client timeout
+// bounds it instead.
+func (c *keySetCache) fetch(source string) (*keySet, error) {
+	fetched, err, _ := c.fetching.Do(source, func() (interface{}, error) {
+		content, err := c.read(context.Background(), source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key set %s: %w", source, err)
+		}
+		keys, err := parseKeySet(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key set %s: %w", source, err)
+		}
+
+		set := &keySet{keys: keys, fetchedAt: time.Now()}
+		c.mu.Lock()
+		c.sets[source] = set
+		c.mu.Unlock()
+		return set, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fetched.(*keySet), nil
+}
+
+// keep uses the keys of stale for another cache duration, unless the set was
+// fetched meanwhile
+func (c *keySetCache) keep(source string, stale *keySet) *keySet {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if current := c.sets[source]; current != stale {
+		return current
+	}
+	kept := &keySet{keys: stale.keys, fetchedAt: time.Now()}
+	c.sets[source] = kept
+	return kept
+}
+
+func (c *keySetCache) read(ctx context.Context, source string) ([]byte, error) {
+	parsedURL, err := url.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	switch parsedURL.Scheme {
+	case "http", "https":
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return nil, err
+		}
+		res, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("unexpected status %d", res.StatusCode)
+		}
+		return ioutil.ReadAll(res.Body)
+	case "file":
+		return ioutil.ReadFile(parsedURL.Path)
+	case "":
+		return ioutil.ReadFile(source)
+	}
+	return nil, fmt.Errorf("unsupported scheme %s", parsedURL.Scheme)
+}
+
+// parseKeySet decodes signing keys of a JWKS document, keys of unsupported
+// types or meant for encryption are skipped
+func parseKeySet(content []byte) ([]jose.JSONWebKey, error) {
+	var doc struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+
+	var keys []jose.JSONWebKey
+	for _, raw := range doc.Keys {
+		var meta struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+		}
+		if err := json.Unmarshal(raw, &meta); err != nil {
+			return nil, err
+		}
+		if (meta.Kty != "RSA" && meta.Kty != "EC") || (meta.Use != "" && meta.Use != "sig") {
+			continue
+		}
+
+		var key jose.JSONWebKey
+		if err := key.UnmarshalJSON(raw); err != nil {
+			return nil, fmt.Errorf("key %s: %w", meta.Kid, err)
+		}
+		if !key.Valid() {
+			return nil, fmt.Errorf("key %s: invalid key", meta.Kid)
+		}
+		// verify with the public part if a private key is published
+		keys = append(keys, key.Public())
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signing keys found")
+	}
+	return keys, nil
+}
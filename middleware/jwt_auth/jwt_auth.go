@@ -0,0 +1,192 @@
+package jwt_auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/salt/log"
+)
+
+const (
+	defaultEmailClaim    = "email"
+	defaultCacheDuration = 10 * time.Minute
+	fetchTimeout         = 10 * time.Second
+)
+
+// JWTAuth authenticates requests with a bearer token issued by an OIDC
+// provider or any issuer publishing a JWKS. Email claim of a verified token
+// is set as the identity header, overwriting any value sent by the client,
+// so authz middleware and hooks act on behalf of the token subject.
+// Middleware will look for Authorization header for the token
+// value should be "Bearer <token>"
+type JWTAuth struct {
+	log                 log.Logger
+	identityProxyHeader string
+	next                http.Handler
+	keySets             *keySetCache
+	now                 func() time.Time
+}
+
+type Config struct {
+	// JWKS is the location of the key set used to verify token signatures,
+	// supports http(s):// urls and file:// paths
+	JWKS string `yaml:"jwks" mapstructure:"jwks"`
+
+	// CacheDuration for which a fetched key set is used before it's fetched
+	// again, defaults to 10m
+	CacheDuration time.Duration `yaml:"cache_duration" mapstructure:"cache_duration"`
+
+	// Issuer is matched against the iss claim if set
+	Issuer string `yaml:"issuer" mapstructure:"issuer"`
+
+	// Audience requires the aud claim to contain any one of these if set
+	Audience []string `yaml:"audience" mapstructure:"audience"`
+
+	// EmailClaim is the claim used as the shield user email, defaults to email
+	EmailClaim string `yaml:"email_claim" mapstructure:"email_claim"`
+
+	// Leeway allowed for clock skew while checking exp and nbf claims
+	Leeway time.Duration `yaml:"leeway" mapstructure:"leeway"`
+}
+
+func New(logger log.Logger, identityProxyHeader string, next http.Handler) *JWTAuth {
+	return &JWTAuth{
+		log:                 logger,
+		identityProxyHeader: identityProxyHeader,
+		next:                next,
+		keySets:             newKeySetCache(&http.Client{Timeout: fetchTimeout}),
+		now:                 time.Now,
+	}
+}
+
+// NewFactory builds the middleware for every rule using it, fetched key sets
+// are cached once for all of them
+func NewFactory(logger log.Logger, identityProxyHeader string) func(next http.Handler) http.Handler {
+	shared := New(logger, identityProxyHeader, nil)
+	return func(next http.Handler) http.Handler {
+		ware := *shared
+		ware.next = next
+		return &ware
+	}
+}
+
+func (w JWTAuth) Info() *structs.MiddlewareInfo {
+	return &structs.MiddlewareInfo{
+		Name:        "jwt_auth",
+		Description: "bearer token authentication verified with a json web key set",
+	}
+}
+
+func (w *JWTAuth) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	wareSpec, ok := middleware.ExtractMiddleware(req, w.Info().Name)
+	if !ok {
+		w.next.ServeHTTP(rw, req)
+		return
+	}
+
+	conf, ok := wareSpec.Compiled.(*Config)
+	if !ok {
+		var err error
+		if conf, err = parseConfig(wareSpec.Config); err != nil {
+			w.log.Error("middleware: invalid config", "config", wareSpec.Config, "err", err)
+			w.notAllowed(rw)
+			return
+		}
+	}
+
+	claims, err := w.authenticate(req, conf)
+	if err != nil {
+		w.log.Info("middleware: bearer token rejected", "err", err)
+		w.notAllowed(rw)
+		return
+	}
+
+	email, _ := claims[conf.EmailClaim].(string)
+	if email == "" {
+		w.log.Info("middleware: email claim not found in token", "claim", conf.EmailClaim)
+		w.notAllowed(rw)
+		return
+	}
+
+	req.Header.Set(w.identityProxyHeader, email)
+	middleware.ExtractAccessLog(req).SetUser(email)
+	middleware.EnrichClaims(req, claims)
+	w.next.ServeHTTP(rw, req)
+}
+
+// authenticate returns claims of the bearer token if it is signed by a key
+// of the configured key set and is valid at this time
+func (w *JWTAuth) authenticate(req *http.Request, conf *Config) (map[string]interface{}, error) {
+	authHeader := req.Header.Get("Authorization")
+	if len(authHeader) < 7 || !strings.EqualFold(authHeader[:7], "bearer ") {
+		return nil, errors.New("bearer token not found")
+	}
+
+	tok, err := parseToken(strings.TrimSpace(authHeader[7:]))
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := w.keySets.keys(conf.JWKS, conf.CacheDuration, tok.kid, tok.alg)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if err = tok.verify(key); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tok.validate(conf.Issuer, conf.Audience, conf.Leeway, w.now()); err != nil {
+		return nil, err
+	}
+	return tok.claims, nil
+}
+
+func (w JWTAuth) notAllowed(rw http.ResponseWriter) {
+	rw.Header().Set("WWW-Authenticate", `Bearer realm="shield"`)
+	rw.WriteHeader(http.StatusUnauthorized)
+}
+
+// CompileConfig decodes and validates jwt auth config while loading rules
+func CompileConfig(config map[string]interface{}) (interface{}, error) {
+	return parseConfig(config)
+}
+
+func parseConfig(rawConfig map[string]interface{}) (*Config, error) {
+	conf := &Config{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+		Result:     conf,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(rawConfig); err != nil {
+		return nil, err
+	}
+
+	if conf.JWKS == "" {
+		return nil, errors.New("jwks is required")
+	}
+	if conf.CacheDuration < 0 || conf.Leeway < 0 {
+		return nil, fmt.Errorf("cache_duration and leeway can't be negative")
+	}
+	if conf.CacheDuration == 0 {
+		conf.CacheDuration = defaultCacheDuration
+	}
+	if conf.EmailClaim == "" {
+		conf.EmailClaim = defaultEmailClaim
+	}
+	return conf, nil
+}
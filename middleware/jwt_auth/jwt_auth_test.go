@@ -0,0 +1,233 @@
+package jwt_auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/odpf/salt/log"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSigner struct {
+	kid string
+	alg string
+	key crypto.Signer
+}
+
+func (s testSigner) jwk() map[string]string {
+	switch pub := s.key.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{
+			"kty": "RSA", "kid": s.kid, "alg": s.alg, "use": "sig",
+			"n": b64(pub.N.Bytes()), "e": b64(big.NewInt(int64(pub.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		return map[string]string{
+			"kty": "EC", "kid": s.kid, "crv": "P-256",
+			"x": b64(pub.X.FillBytes(make([]byte, 32))), "y": b64(pub.Y.FillBytes(make([]byte, 32))),
+		}
+	}
+	return nil
+}
+
+func (s testSigner) sign(t *testing.T, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": s.alg, "kid": s.kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	input := b64(header) + "." + b64(payload)
+	digest := sha256.Sum256([]byte(input))
+
+	var sig []byte
+	switch key := s.key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		sig, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		assert.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		assert.NoError(t, err)
+		sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return input + "." + b64(sig)
+}
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func writeKeySet(t *testing.T, signers ...testSigner) string {
+	var keys []map[string]string
+	for _, s := range signers {
+		keys = append(keys, s.jwk())
+	}
+	content, _ := json.Marshal(map[string]interface{}{"keys": keys})
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NoError(t, ioutil.WriteFile(path, content, 0600))
+	return "file://" + path
+}
+
+func TestJWTAuth(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	rsaSigner := testSigner{kid: "rsa", alg: "RS256", key: rsaKey}
+	ecSigner := testSigner{kid: "ec", alg: "ES256", key: ecKey}
+	jwks := writeKeySet(t, rsaSigner, ecSigner)
+
+	now := time.Now()
+	validClaims := func(overrides map[string]interface{}) map[string]interface{} {
+		claims := map[string]interface{}{
+			"iss":   "https://issuer.odpf.io",
+			"aud":   []string{"shield", "other"},
+			"exp":   now.Add(time.Hour).Unix(),
+			"email": "user@odpf.io",
+			"org":   map[string]interface{}{"id": "org-1"},
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(claims, k)
+				continue
+			}
+			claims[k] = v
+		}
+		return claims
+	}
+
+	table := []struct {
+		name   string
+		token  string
+		status int
+	}{
+		{"valid rsa token", rsaSigner.sign(t, validClaims(nil)), http.StatusOK},
+		{"valid ec token", ecSigner.sign(t, validClaims(nil)), http.StatusOK},
+		{"expired", rsaSigner.sign(t, validClaims(map[string]interface{}{"exp": now.Add(-time.Hour).Unix()})), http.StatusUnauthorized},
+		{"expired within leeway", rsaSigner.sign(t, validClaims(map[string]interface{}{"exp": now.Add(-10 * time.Second).Unix()})), http.StatusOK},
+		{"without expiry", rsaSigner.sign(t, validClaims(map[string]interface{}{"exp": nil})), http.StatusUnauthorized},
+		{"not valid yet", rsaSigner.sign(t, validClaims(map[string]interface{}{"nbf": now.Add(time.Hour).Unix()})), http.StatusUnauthorized},
+		{"wrong issuer", rsaSigner.sign(t, validClaims(map[string]interface{}{"iss": "https://evil.io"})), http.StatusUnauthorized},
+		{"wrong audience", rsaSigner.sign(t, validClaims(map[string]interface{}{"aud": "other"})), http.StatusUnauthorized},
+		{"without email", rsaSigner.sign(t, validClaims(map[string]interface{}{"email": nil})), http.StatusUnauthorized},
+		{"signed by unknown key", testSigner{kid: "rsa", alg: "RS256", key: otherKey}.sign(t, validClaims(nil)), http.StatusUnauthorized},
+		{"unknown key id", testSigner{kid: "unknown", alg: "RS256", key: rsaKey}.sign(t, validClaims(nil)), http.StatusUnauthorized},
+		{"alg none", b64([]byte(`{"alg":"none","kid":"rsa"}`)) + "." + b64([]byte(`{"email":"user@odpf.io"}`)) + ".", http.StatusUnauthorized},
+		{"malformed", "not-a-token", http.StatusUnauthorized},
+		{"missing", "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			var forwarded *http.Request
+			ware := New(log.NewNoop(), "X-Shield-Email", http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				forwarded = req
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api", nil)
+			req.Header.Set("X-Shield-Email", "spoofed@odpf.io")
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			middleware.EnrichRule(req, &structs.Rule{Middlewares: structs.MiddlewareSpecs{{
+				Name: "jwt_auth",
+				Config: map[string]interface{}{
+					"jwks":     jwks,
+					"issuer":   "https://issuer.odpf.io",
+					"audience": []string{"shield"},
+					"leeway":   "30s",
+				},
+			}}})
+
+			rw := httptest.NewRecorder()
+			ware.ServeHTTP(rw, req)
+			assert.Equal(t, tt.status, rw.Code)
+			if tt.status != http.StatusOK {
+				assert.Nil(t, forwarded)
+				return
+			}
+
+			assert.Equal(t, "user@odpf.io", forwarded.Header.Get("X-Shield-Email"))
+			orgId, ok := middleware.ExtractClaim(forwarded, "org.id")
+			assert.True(t, ok)
+			assert.Equal(t, "org-1", orgId)
+		})
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	conf, err := parseConfig(map[string]interface{}{"jwks": "https://issuer.odpf.io/jwks", "cache_duration": "1h"})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, conf.CacheDuration)
+	assert.Equal(t, "email", conf.EmailClaim)
+
+	_, err = parseConfig(map[string]interface{}{"issuer": "https://issuer.odpf.io"})
+	assert.Error(t, err)
+}
+
+func TestKeySetCacheFetchesOnce(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	signer := testSigner{kid: "rsa", alg: "RS256", key: rsaKey}
+	content, _ := json.Marshal(map[string]interface{}{"keys": []map[string]string{signer.jwk()}})
+
+	var fetches int32
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		<-release
+		rw.Write(content)
+	}))
+	defer slow.Close()
+
+	cache := newKeySetCache(slow.Client())
+	cached := writeKeySet(t, signer)
+	_, err = cache.keys(cached, time.Hour, "rsa", "RS256")
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			keys, err := cache.keys(slow.URL, time.Hour, "rsa", "RS256")
+			assert.NoError(t, err)
+			assert.Len(t, keys, 1)
+		}()
+	}
+
+	// other key sets are served while a fetch is in flight
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&fetches) == 1 }, time.Second, time.Millisecond)
+	done := make(chan struct{})
+	go func() {
+		_, err := cache.keys(cached, time.Hour, "rsa", "RS256")
+		assert.NoError(t, err)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("cached key set blocked by a fetch of another source")
+	}
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+}
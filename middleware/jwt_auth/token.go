@@ -0,0 +1,142 @@
+package jwt_auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+)
+
+var (
+	ErrMalformedToken   = errors.New("malformed token")
+	ErrInvalidSignature = errors.New("invalid token signature")
+	ErrTokenExpired     = errors.New("token is expired")
+	ErrTokenNotValidYet = errors.New("token is not valid yet")
+	ErrInvalidIssuer    = errors.New("invalid token issuer")
+	ErrInvalidAudience  = errors.New("invalid token audience")
+)
+
+// signingAlgorithms are the algorithms tokens may be signed with, alg
+// "none" and symmetric algorithms are never accepted
+var signingAlgorithms = map[string]bool{
+	string(jose.RS256): true, string(jose.RS384): true, string(jose.RS512): true,
+	string(jose.PS256): true, string(jose.PS384): true, string(jose.PS512): true,
+	string(jose.ES256): true, string(jose.ES384): true, string(jose.ES512): true,
+}
+
+// token is a JWS compact serialization, claims are set once its signature
+// is verified
+type token struct {
+	jws    *jose.JSONWebSignature
+	kid    string
+	alg    string
+	claims map[string]interface{}
+}
+
+func parseToken(raw string) (*token, error) {
+	// json serialization of a JWS isn't a JWT
+	if strings.Count(raw, ".") != 2 {
+		return nil, ErrMalformedToken
+	}
+	jws, err := jose.ParseSigned(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformedToken, err)
+	}
+	if len(jws.Signatures) != 1 {
+		return nil, ErrMalformedToken
+	}
+	header := jws.Signatures[0].Protected
+	return &token{jws: jws, kid: header.KeyID, alg: header.Algorithm}, nil
+}
+
+// verify checks token signature with the key and decodes its claims
+func (t *token) verify(key jose.JSONWebKey) error {
+	if !signingAlgorithms[t.alg] {
+		return fmt.Errorf("unsupported signing algorithm %q", t.alg)
+	}
+	payload, err := t.jws.Verify(key)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&t.claims); err != nil {
+		return fmt.Errorf("%w: claims: %s", ErrMalformedToken, err)
+	}
+	return nil
+}
+
+// validate checks registered claims of the token, tokens without an
+// expiry are not accepted
+func (t *token) validate(issuer string, audience []string, leeway time.Duration, now time.Time) error {
+	exp, ok, err := t.timeClaim("exp")
+	if err != nil {
+		return err
+	}
+	if !ok || now.After(exp.Add(leeway)) {
+		return ErrTokenExpired
+	}
+	nbf, ok, err := t.timeClaim("nbf")
+	if err != nil {
+		return err
+	}
+	if ok && now.Add(leeway).Before(nbf) {
+		return ErrTokenNotValidYet
+	}
+
+	if issuer != "" {
+		if iss, _ := t.claims["iss"].(string); iss != issuer {
+			return ErrInvalidIssuer
+		}
+	}
+
+	if len(audience) > 0 {
+		var tokenAudience []string
+		switch aud := t.claims["aud"].(type) {
+		case string:
+			tokenAudience = []string{aud}
+		case []interface{}:
+			for _, a := range aud {
+				if s, ok := a.(string); ok {
+					tokenAudience = append(tokenAudience, s)
+				}
+			}
+		}
+		if !containsAny(tokenAudience, audience) {
+			return ErrInvalidAudience
+		}
+	}
+	return nil
+}
+
+func (t *token) timeClaim(name string) (time.Time, bool, error) {
+	value, ok := t.claims[name]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false, fmt.Errorf("%w: %s is not a number", ErrMalformedToken, name)
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("%w: %s is not a number", ErrMalformedToken, name)
+	}
+	return time.Unix(int64(seconds), 0), true, nil
+}
+
+func containsAny(values, expected []string) bool {
+	for _, v := range values {
+		for _, e := range expected {
+			if v == e {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -3,12 +3,17 @@ package middleware
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
-	"time"
+	"strings"
 
+	"github.com/odpf/shield/pkg/body_extractor"
+	"github.com/odpf/shield/pkg/graphql"
 	"github.com/odpf/shield/structs"
 )
 
@@ -16,25 +21,134 @@ const (
 	ctxRuleKey       = "middleware_rule"
 	ctxPathParamsKey = "path_params"
 	ctxBodyKey       = "body_ctx"
+	ctxIdentityKey   = "identity"
+	ctxClaimsKey     = "jwt_claims"
+	ctxGraphQLKey    = "graphql_operation"
+	ctxAccessLogKey  = "access_log"
 )
 
+// Identity is the caller identity resolved while authorizing the request
+type Identity struct {
+	Email          string
+	ProjectId      string
+	OrganizationId string
+}
+
 func EnrichRule(r *http.Request, rule *structs.Rule) {
 	*r = *r.WithContext(context.WithValue(r.Context(), ctxRuleKey, rule))
 }
 
-func EnrichRequestBody(r *http.Request) error {
-	reqBody, err := ioutil.ReadAll(r.Body)
+// DefaultMaxBodySize bounds buffered request bodies if the rule doesn't set
+// a limit, it is the default max message size of grpc
+const DefaultMaxBodySize = 4 << 20
+
+var ErrBodyTooLarge = errors.New("request body is too large")
+
+// EnrichRequestBody buffers the request body so payload attributes can be
+// extracted before it is proxied. Only the first message frame of grpc and
+// grpc-web requests is buffered, the rest of the stream is read by the
+// backend as the client sends it. The frame of grpc-web-text requests is
+// buffered decoded.
+func EnrichRequestBody(r *http.Request, maxSize int64) error {
+	if _, ok := ExtractRequestBody(r); ok {
+		// already buffered to match graphql rules
+		return nil
+	}
+	if maxSize <= 0 {
+		maxSize = DefaultMaxBodySize
+	}
+	if r.Body == nil || r.Body == http.NoBody {
+		*r = *r.WithContext(context.WithValue(r.Context(), ctxBodyKey, []byte{}))
+		return nil
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	if IsGRPCContentType(contentType) || IsGRPCWebContentType(contentType) {
+		frame, err := peekGRPCFrame(r.Body, maxSize)
+		if err != nil {
+			return err
+		}
+		// the peeked frame is sent ahead of the rest of the stream
+		r.Body = &peekedBody{Reader: io.MultiReader(bytes.NewReader(frame), r.Body), Closer: r.Body}
+		*r = *r.WithContext(context.WithValue(r.Context(), ctxBodyKey, frame))
+		return nil
+	}
+	if IsGRPCWebTextContentType(contentType) {
+		// frames are base64 encoded, the text read while decoding the first
+		// one is sent ahead of the rest of the stream as it was
+		var consumed bytes.Buffer
+		frame, err := peekGRPCFrame(base64.NewDecoder(base64.StdEncoding, io.TeeReader(r.Body, &consumed)), maxSize)
+		if err != nil {
+			return err
+		}
+		r.Body = &peekedBody{Reader: io.MultiReader(&consumed, r.Body), Closer: r.Body}
+		*r = *r.WithContext(context.WithValue(r.Context(), ctxBodyKey, frame))
+		return nil
+	}
+
+	reqBody, err := ioutil.ReadAll(io.LimitReader(r.Body, maxSize+1))
 	if err != nil {
 		return err
 	}
+	if int64(len(reqBody)) > maxSize {
+		return ErrBodyTooLarge
+	}
 	defer (r.Body).Close()
 
 	// repopulate body
 	(*r).Body = ioutil.NopCloser(bytes.NewBuffer(reqBody))
 	*r = *r.WithContext(context.WithValue(r.Context(), ctxBodyKey, reqBody))
+	ExtractAccessLog(r).SetBody(reqBody)
 	return nil
 }
 
+// IsGRPCContentType is true for application/grpc and application/grpc+<codec>
+// content types, grpc-web ones aren't matched
+func IsGRPCContentType(contentType string) bool {
+	return contentType == "application/grpc" || strings.HasPrefix(contentType, "application/grpc+")
+}
+
+// IsGRPCWebContentType is true for binary grpc-web content types, their
+// messages are framed like grpc ones
+func IsGRPCWebContentType(contentType string) bool {
+	return contentType == "application/grpc-web" || strings.HasPrefix(contentType, "application/grpc-web+")
+}
+
+// IsGRPCWebTextContentType is true for grpc-web-text content types, their
+// frames are base64 encoded
+func IsGRPCWebTextContentType(contentType string) bool {
+	return contentType == "application/grpc-web-text" || strings.HasPrefix(contentType, "application/grpc-web-text+")
+}
+
+// peekGRPCFrame reads the first length-prefixed message of a grpc stream
+// along with its 5 byte header, a stream without messages returns nothing
+func peekGRPCFrame(body io.Reader, maxSize int64) ([]byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(body, header); err != nil {
+		if err == io.EOF {
+			return []byte{}, nil
+		}
+		return nil, err
+	}
+	length := int64(binary.BigEndian.Uint32(header[1:]))
+	if length > maxSize {
+		return nil, ErrBodyTooLarge
+	}
+	frame := make([]byte, 5+length)
+	copy(frame, header)
+	if _, err := io.ReadFull(body, frame[5:]); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
+
+type peekedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// ExtractRequestBody returns the body buffered by EnrichRequestBody, only the
+// first message of grpc requests
 func ExtractRequestBody(r *http.Request) (io.ReadCloser, bool) {
 	body, ok := r.Context().Value(ctxBodyKey).([]byte)
 	if !ok {
@@ -43,6 +157,73 @@ func ExtractRequestBody(r *http.Request) (io.ReadCloser, bool) {
 	return ioutil.NopCloser(bytes.NewBuffer(body)), true
 }
 
+// RequestPayload returns the buffered body payload attributes are extracted
+// from, it is empty if the body of the rule isn't buffered
+func RequestPayload(r *http.Request) io.ReadCloser {
+	if body, ok := ExtractRequestBody(r); ok {
+		return body
+	}
+	return http.NoBody
+}
+
+// GRPCPayload extracts a grpc_payload attribute from the buffered request
+// message, fields are read by name if the attribute has a path
+func GRPCPayload(r *http.Request, attr Attribute) (interface{}, error) {
+	body := RequestPayload(r)
+	handler := body_extractor.GRPCPayloadHandler{Encoding: r.Header.Get("Grpc-Encoding"), Method: r.URL.Path}
+	if attr.Path == "" {
+		return handler.Extract(&body, attr.Index)
+	}
+	if rule, ok := ExtractRule(r); ok {
+		handler.Resolver, _ = rule.Backend.Descriptors.(body_extractor.MethodResolver)
+	}
+	return handler.ExtractPath(&body, attr.Path)
+}
+
+type graphqlOperation struct {
+	op  *graphql.Operation
+	err error
+}
+
+// GraphQLOperation parses the graphql operation of the request once, the
+// body is buffered up to maxSize if it isn't already
+func GraphQLOperation(r *http.Request, maxSize int64) (*graphql.Operation, error) {
+	if parsed, ok := r.Context().Value(ctxGraphQLKey).(graphqlOperation); ok {
+		return parsed.op, parsed.err
+	}
+
+	op, err := parseGraphQLOperation(r, maxSize)
+	*r = *r.WithContext(context.WithValue(r.Context(), ctxGraphQLKey, graphqlOperation{op: op, err: err}))
+	return op, err
+}
+
+func parseGraphQLOperation(r *http.Request, maxSize int64) (*graphql.Operation, error) {
+	if r.Method != http.MethodGet {
+		if err := EnrichRequestBody(r, maxSize); err != nil {
+			return nil, err
+		}
+	}
+	gqlReq, err := graphql.ParseRequest(r.Method, r.Header.Get("Content-Type"), r.URL.Query(), RequestPayload(r))
+	if err != nil {
+		return nil, err
+	}
+	return graphql.Parse(gqlReq)
+}
+
+// GraphQLArgument extracts a graphql attribute from the arguments of the
+// operation of the request
+func GraphQLArgument(r *http.Request, path string) (interface{}, error) {
+	var maxSize int64
+	if rule, ok := ExtractRule(r); ok {
+		maxSize = rule.Frontend.Body.MaxSize
+	}
+	op, err := GraphQLOperation(r, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	return op.Argument(path)
+}
+
 func ExtractRule(r *http.Request) (*structs.Rule, bool) {
 	rl, ok := r.Context().Value(ctxRuleKey).(*structs.Rule)
 	return rl, ok
@@ -68,6 +249,45 @@ func ExtractPathParams(r *http.Request) (map[string]string, bool) {
 	return params, true
 }
 
+func EnrichIdentity(r *http.Request, identity Identity) {
+	*r = *r.WithContext(context.WithValue(r.Context(), ctxIdentityKey, identity))
+}
+
+func ExtractIdentity(r *http.Request) (Identity, bool) {
+	identity, ok := r.Context().Value(ctxIdentityKey).(Identity)
+	return identity, ok
+}
+
+// EnrichClaims stores claims of a verified bearer token
+func EnrichClaims(r *http.Request, claims map[string]interface{}) {
+	*r = *r.WithContext(context.WithValue(r.Context(), ctxClaimsKey, claims))
+}
+
+func ExtractClaims(r *http.Request) (map[string]interface{}, bool) {
+	claims, ok := r.Context().Value(ctxClaimsKey).(map[string]interface{})
+	return claims, ok
+}
+
+// ExtractClaim returns a claim of the verified bearer token, nested claims
+// are looked up with a dot separated path e.g. "realm_access.roles"
+func ExtractClaim(r *http.Request, path string) (interface{}, bool) {
+	claims, ok := ExtractClaims(r)
+	if !ok {
+		return nil, false
+	}
+	var value interface{} = claims
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = obj[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
 const (
 	AttributeTypeQuery       AttributeType = "query"
 	AttributeTypeHeader      AttributeType = "header"
@@ -75,6 +295,8 @@ const (
 	AttributeTypeGRPCPayload AttributeType = "grpc_payload"
 	AttributeTypePathParam   AttributeType = "path_param"
 	AttributeTypeConstant    AttributeType = "constant"
+	AttributeTypeJWTClaim    AttributeType = "jwt_claim"
+	AttributeTypeGraphQL     AttributeType = "graphql"
 )
 
 type AttributeType string
@@ -83,14 +305,106 @@ type Attribute struct {
 	Key    string        `yaml:"key" mapstructure:"key"`
 	Type   AttributeType `yaml:"type" mapstructure:"type"`
 	Index  string        `yaml:"index" mapstructure:"index"` // proto index
-	Path   string        `yaml:"path" mapstructure:"path"`
+	Path   string        `yaml:"path" mapstructure:"path"`   // proto field names
 	Params []string      `yaml:"params" mapstructure:"params"`
 	Value  string        `yaml:"value" mapstructure:"value"`
 }
 
-func Elapsed(what string) func() {
-	start := time.Now()
-	return func() {
-		fmt.Printf("%s took %v\n", what, time.Since(start))
+// Validate checks the fields required by the attribute type are set
+func (a Attribute) Validate() error {
+	switch a.Type {
+	case AttributeTypeGRPCPayload:
+		if a.Index == "" && a.Path == "" {
+			return errors.New("index or path is required for grpc_payload attribute")
+		}
+	case AttributeTypeJSONPayload, AttributeTypeHeader, AttributeTypeQuery, AttributeTypeJWTClaim, AttributeTypeGraphQL:
+		if a.Key == "" {
+			return fmt.Errorf("key is required for %s attribute", a.Type)
+		}
+	case AttributeTypeConstant:
+		if a.Value == "" {
+			return errors.New("value is required for constant attribute")
+		}
+	case AttributeTypePathParam:
+	default:
+		return fmt.Errorf("unknown attribute type %q", a.Type)
+	}
+	return nil
+}
+
+// AttributeValue extracts an attribute from the request as a string, payload
+// attributes are read from the buffered body
+func AttributeValue(req *http.Request, attr Attribute) (string, error) {
+	switch attr.Type {
+	case AttributeTypeHeader:
+		return req.Header.Get(attr.Key), nil
+	case AttributeTypeQuery:
+		return req.URL.Query().Get(attr.Key), nil
+	case AttributeTypePathParam:
+		params, _ := ExtractPathParams(req)
+		return params[attr.Key], nil
+	case AttributeTypeConstant:
+		return attr.Value, nil
+	case AttributeTypeJWTClaim:
+		claim, ok := ExtractClaim(req, attr.Key)
+		if !ok || claim == nil {
+			return "", nil
+		}
+		return fmt.Sprint(claim), nil
+	case AttributeTypeJSONPayload:
+		body := RequestPayload(req)
+		value, err := body_extractor.JSONPayloadHandler{}.Extract(&body, attr.Key)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint(value), nil
+	case AttributeTypeGRPCPayload:
+		value, err := GRPCPayload(req, attr)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint(value), nil
+	case AttributeTypeGraphQL:
+		value, err := GraphQLArgument(req, attr.Key)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint(value), nil
+	}
+	return "", fmt.Errorf("unknown attribute type %q", attr.Type)
+}
+
+// ReadsRequestPayload tells if a middleware config has attributes read from
+// the request payload, the body of rules with such middlewares is buffered
+func ReadsRequestPayload(config map[string]interface{}) bool {
+	return HasAttribute(config, func(attr map[string]interface{}) bool {
+		switch AttributeType(fmt.Sprint(attr["type"])) {
+		case AttributeTypeJSONPayload, AttributeTypeGRPCPayload, AttributeTypeGraphQL:
+			return true
+		}
+		return false
+	})
+}
+
+// HasAttribute tells if any map nested in a config matches, attributes can
+// be nested anywhere in configs e.g. under limits of rate_limit
+func HasAttribute(config interface{}, match func(attr map[string]interface{}) bool) bool {
+	switch value := config.(type) {
+	case map[string]interface{}:
+		if match(value) {
+			return true
+		}
+		for _, nested := range value {
+			if HasAttribute(nested, match) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, nested := range value {
+			if HasAttribute(nested, match) {
+				return true
+			}
+		}
 	}
+	return false
 }
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func grpcFrame(msg string) []byte {
+	frame := make([]byte, 5+len(msg))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(msg)))
+	copy(frame[5:], msg)
+	return frame
+}
+
+func TestEnrichRequestBody(t *testing.T) {
+	t.Run("buffers json bodies", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"project":"books"}`))
+		assert.NoError(t, EnrichRequestBody(req, 64))
+
+		buffered, _ := ioutil.ReadAll(RequestPayload(req))
+		assert.Equal(t, `{"project":"books"}`, string(buffered))
+		proxied, _ := ioutil.ReadAll(req.Body)
+		assert.Equal(t, `{"project":"books"}`, string(proxied))
+	})
+
+	t.Run("rejects bodies over max size", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(strings.Repeat("a", 65)))
+		assert.True(t, errors.Is(EnrichRequestBody(req, 64), ErrBodyTooLarge))
+	})
+
+	t.Run("peeks the first message of grpc streams", func(t *testing.T) {
+		// the client keeps the stream open after the first message
+		reader, writer := io.Pipe()
+		go writer.Write(grpcFrame("first"))
+
+		req := httptest.NewRequest(http.MethodPost, "/library.v1.Books/Upload", reader)
+		req.Header.Set("Content-Type", "application/grpc")
+		assert.NoError(t, EnrichRequestBody(req, 64))
+
+		buffered, _ := ioutil.ReadAll(RequestPayload(req))
+		assert.Equal(t, grpcFrame("first"), buffered)
+
+		go func() {
+			writer.Write(grpcFrame("second"))
+			writer.Close()
+		}()
+		proxied, _ := ioutil.ReadAll(req.Body)
+		assert.Equal(t, append(grpcFrame("first"), grpcFrame("second")...), proxied)
+	})
+
+	t.Run("bounds the first grpc message", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(grpcFrame(strings.Repeat("a", 65)))))
+		req.Header.Set("Content-Type", "application/grpc")
+		assert.True(t, errors.Is(EnrichRequestBody(req, 64), ErrBodyTooLarge))
+	})
+
+	t.Run("peeks the first message of grpc-web requests", func(t *testing.T) {
+		body := append(grpcFrame("first"), grpcFrame("second")...)
+		req := httptest.NewRequest(http.MethodPost, "/library.v1.Books/Upload", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/grpc-web+proto")
+		assert.NoError(t, EnrichRequestBody(req, 64))
+
+		buffered, _ := ioutil.ReadAll(RequestPayload(req))
+		assert.Equal(t, grpcFrame("first"), buffered)
+		proxied, _ := ioutil.ReadAll(req.Body)
+		assert.Equal(t, body, proxied)
+	})
+
+	t.Run("decodes the first message of grpc-web-text requests", func(t *testing.T) {
+		// messages of a text stream may be encoded one by one
+		body := base64.StdEncoding.EncodeToString(grpcFrame("first")) + base64.StdEncoding.EncodeToString(grpcFrame("second"))
+		req := httptest.NewRequest(http.MethodPost, "/library.v1.Books/Upload", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/grpc-web-text")
+		assert.NoError(t, EnrichRequestBody(req, 64))
+
+		buffered, _ := ioutil.ReadAll(RequestPayload(req))
+		assert.Equal(t, grpcFrame("first"), buffered)
+		proxied, _ := ioutil.ReadAll(req.Body)
+		assert.Equal(t, body, string(proxied))
+	})
+
+	t.Run("payload is empty if not buffered", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("book"))
+		buffered, _ := ioutil.ReadAll(RequestPayload(req))
+		assert.Empty(t, buffered)
+	})
+}
+
+func TestReadsRequestPayload(t *testing.T) {
+	assert.True(t, ReadsRequestPayload(map[string]interface{}{
+		"attributes": map[string]interface{}{
+			"project": map[string]interface{}{"type": "json_payload", "key": "project"},
+		},
+	}))
+	assert.True(t, ReadsRequestPayload(map[string]interface{}{
+		"limits": []interface{}{
+			map[string]interface{}{"by": "attribute", "attribute": map[string]interface{}{"type": "grpc_payload", "index": "1"}},
+		},
+	}))
+	assert.False(t, ReadsRequestPayload(map[string]interface{}{
+		"attributes": map[string]interface{}{
+			"project": map[string]interface{}{"type": "header", "key": "X-Project"},
+		},
+	}))
+}
@@ -0,0 +1,335 @@
+package rate_limit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/internal/permission"
+	"github.com/odpf/shield/internal/ratelimit"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/salt/log"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	KeyUser         = "user"
+	KeyOrganization = "organization"
+	KeyProject      = "project"
+	KeyRoute        = "route"
+	KeyAttribute    = "attribute"
+
+	StoreLocal    = "local"
+	StorePostgres = "postgres"
+)
+
+// RateLimit throttles callers with token buckets keyed by who is calling or
+// an attribute of the request. It's listed after authz so the project and
+// organization resolved there can be used as keys.
+type RateLimit struct {
+	log                 log.Logger
+	identityProxyHeader string
+	next                http.Handler
+	Deps                handler.Deps
+	stores              map[string]ratelimit.Store
+}
+
+type Config struct {
+	// Store keeping buckets, local to each shield instance or postgres to
+	// share limits between instances
+	Store  string        `yaml:"store" mapstructure:"store"`
+	Limits []LimitConfig `yaml:"limits" mapstructure:"limits"`
+}
+
+type LimitConfig struct {
+	// Name of the limit, limits with the same name share buckets across
+	// frontends. Defaults to one per frontend.
+	Name string `yaml:"name" mapstructure:"name"`
+
+	// By is what buckets are keyed by, one of user, organization, project,
+	// route or attribute
+	By        string               `yaml:"by" mapstructure:"by"`
+	Attribute middleware.Attribute `yaml:"attribute" mapstructure:"attribute"`
+
+	// Requests allowed every Period, up to Burst at once
+	Requests int           `yaml:"requests" mapstructure:"requests"`
+	Period   time.Duration `yaml:"period" mapstructure:"period"`
+	Burst    int           `yaml:"burst" mapstructure:"burst"`
+}
+
+func (c LimitConfig) limit() ratelimit.Limit {
+	return ratelimit.Limit{Rate: c.Requests, Period: c.Period, Burst: c.Burst}
+}
+
+func New(logger log.Logger, identityProxyHeader string, deps handler.Deps, next http.Handler) *RateLimit {
+	stores := map[string]ratelimit.Store{
+		StoreLocal: ratelimit.NewLocalStore(),
+	}
+	if deps.RateLimitStore != nil {
+		stores[StorePostgres] = deps.RateLimitStore
+	}
+	return &RateLimit{
+		log:                 logger,
+		identityProxyHeader: identityProxyHeader,
+		next:                next,
+		Deps:                deps,
+		stores:              stores,
+	}
+}
+
+// NewFactory builds rate limits for every rule using the middleware, they
+// share stores so limits with the same name share buckets across rules and
+// buckets are kept when rules are reloaded
+func NewFactory(logger log.Logger, identityProxyHeader string, deps handler.Deps) func(next http.Handler) http.Handler {
+	shared := New(logger, identityProxyHeader, deps, nil)
+	return func(next http.Handler) http.Handler {
+		ware := *shared
+		ware.next = next
+		return &ware
+	}
+}
+
+func (w RateLimit) Info() *structs.MiddlewareInfo {
+	return &structs.MiddlewareInfo{
+		Name:        "rate_limit",
+		Description: "token bucket rate limits keyed by caller or request attribute",
+	}
+}
+
+func (w *RateLimit) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	wareSpec, ok := middleware.ExtractMiddleware(req, w.Info().Name)
+	if !ok {
+		w.next.ServeHTTP(rw, req)
+		return
+	}
+
+	conf, ok := wareSpec.Compiled.(*Config)
+	if !ok {
+		var err error
+		if conf, err = parseConfig(wareSpec.Config); err != nil {
+			w.log.Error("middleware: failed to decode rate limit config", "config", wareSpec.Config, "err", err)
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	store, ok := w.stores[conf.Store]
+	if !ok {
+		w.log.Error("middleware: rate limit store is not available", "store", conf.Store)
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	rule, _ := middleware.ExtractRule(req)
+	keys := newKeyResolver(req, w.identityProxyHeader, w.Deps)
+
+	// the most restrictive result is reported back to the caller
+	var reported *ratelimit.Result
+	for i, limitConf := range conf.Limits {
+		value, err := keys.resolve(limitConf)
+		if err != nil {
+			w.log.Warn("middleware: failed to resolve rate limit key", "by", limitConf.By, "err", err)
+			continue
+		}
+		if value == "" {
+			// nothing to key the bucket by, e.g. an anonymous request
+			continue
+		}
+
+		name := limitConf.Name
+		if name == "" && rule != nil {
+			name = fmt.Sprintf("%s %s#%d", rule.Frontend.Method, rule.Frontend.URL, i)
+		}
+		result, err := store.TakeRateLimitToken(req.Context(), name+"|"+limitConf.By+":"+value, limitConf.limit())
+		if err != nil {
+			// limits are best effort, an unavailable store doesn't fail requests
+			w.log.Warn("middleware: failed to take rate limit token", "store", conf.Store, "err", err)
+			continue
+		}
+		if !result.Allowed {
+			w.log.Info("middleware: rate limit exceeded", "limit", name, "by", limitConf.By, "key", value)
+			w.tooManyRequests(rw, req, result)
+			return
+		}
+		if reported == nil || result.Remaining < reported.Remaining {
+			reported = &result
+		}
+	}
+
+	if reported != nil {
+		setLimitHeaders(rw.Header(), *reported)
+	}
+	w.next.ServeHTTP(rw, req)
+}
+
+// tooManyRequests replies with 429 or RESOURCE_EXHAUSTED to grpc clients,
+// both with a hint of when to retry
+func (w RateLimit) tooManyRequests(rw http.ResponseWriter, req *http.Request, result ratelimit.Result) {
+	retryAfter := math.Ceil(result.RetryAfter.Seconds())
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	setLimitHeaders(rw.Header(), result)
+	rw.Header().Set("Retry-After", strconv.Itoa(int(retryAfter)))
+
+	if !strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
+		rw.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/grpc")
+	rw.Header().Set("Grpc-Status", strconv.Itoa(int(codes.ResourceExhausted)))
+	rw.Header().Set("Grpc-Message", fmt.Sprintf("rate limit exceeded, retry after %ds", int(retryAfter)))
+	// understood by grpc clients with retries enabled
+	rw.Header().Set("Grpc-Retry-Pushback-Ms", strconv.FormatInt(result.RetryAfter.Milliseconds(), 10))
+	rw.WriteHeader(http.StatusOK)
+}
+
+func setLimitHeaders(header http.Header, result ratelimit.Result) {
+	header.Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	header.Set("X-RateLimit-Reset", strconv.Itoa(int(math.Ceil(result.ResetAfter.Seconds()))))
+}
+
+// keyResolver resolves bucket keys of a request, the organization is looked
+// up from the project only once
+type keyResolver struct {
+	ctx            context.Context
+	req            *http.Request
+	deps           handler.Deps
+	email          string
+	identity       middleware.Identity
+	serviceAccount string
+}
+
+func newKeyResolver(req *http.Request, identityProxyHeader string, deps handler.Deps) *keyResolver {
+	identity, _ := middleware.ExtractIdentity(req)
+	email := identity.Email
+	if email == "" {
+		email = req.Header.Get(identityProxyHeader)
+	}
+	resolver := &keyResolver{ctx: req.Context(), req: req, deps: deps, email: email, identity: identity}
+	if serviceAccount, ok := permission.GetServiceAccountFromContext(req.Context()); ok {
+		resolver.serviceAccount = serviceAccount.Id
+		if identity.OrganizationId == "" {
+			resolver.identity.OrganizationId = serviceAccount.OrganizationId
+		}
+		if identity.ProjectId == "" {
+			resolver.identity.ProjectId = serviceAccount.ProjectId
+		}
+	}
+	return resolver
+}
+
+func (k *keyResolver) resolve(conf LimitConfig) (string, error) {
+	switch conf.By {
+	case KeyUser:
+		if k.serviceAccount != "" {
+			return "service_account:" + k.serviceAccount, nil
+		}
+		return k.email, nil
+	case KeyProject:
+		return k.identity.ProjectId, nil
+	case KeyOrganization:
+		return k.organization()
+	case KeyRoute:
+		rule, ok := middleware.ExtractRule(k.req)
+		if !ok {
+			return "", nil
+		}
+		return rule.Frontend.Method + " " + rule.Frontend.URL, nil
+	case KeyAttribute:
+		return middleware.AttributeValue(k.req, conf.Attribute)
+	}
+	return "", fmt.Errorf("unknown rate limit key %s", conf.By)
+}
+
+func (k *keyResolver) organization() (string, error) {
+	if k.identity.OrganizationId != "" || k.identity.ProjectId == "" {
+		return k.identity.OrganizationId, nil
+	}
+	project, err := k.deps.V1beta1.ProjectService.Get(k.ctx, k.identity.ProjectId)
+	if err != nil {
+		return "", err
+	}
+	k.identity.OrganizationId = project.Organization.Id
+	return k.identity.OrganizationId, nil
+}
+
+// NewCompiler decodes and validates rate limit config while loading rules,
+// a rule using a store deps don't provide is rejected instead of failing
+// every request it matches
+func NewCompiler(deps handler.Deps) structs.ConfigCompiler {
+	return func(config map[string]interface{}) (interface{}, error) {
+		conf, err := parseConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		if conf.Store == StorePostgres && deps.RateLimitStore == nil {
+			return nil, fmt.Errorf("rate limit store %s is not available", conf.Store)
+		}
+		return conf, nil
+	}
+}
+
+func parseConfig(rawConfig map[string]interface{}) (*Config, error) {
+	conf := &Config{}
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+		Result:     conf,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(rawConfig); err != nil {
+		return nil, err
+	}
+
+	switch conf.Store {
+	case "":
+		conf.Store = StoreLocal
+	case StoreLocal, StorePostgres:
+	default:
+		return nil, fmt.Errorf("unknown rate limit store %s", conf.Store)
+	}
+	if len(conf.Limits) == 0 {
+		return nil, errors.New("limits are required")
+	}
+
+	for i := range conf.Limits {
+		limit := &conf.Limits[i]
+		switch limit.By {
+		case KeyUser, KeyOrganization, KeyProject, KeyRoute:
+		case KeyAttribute:
+			if err := limit.Attribute.Validate(); err != nil {
+				return nil, fmt.Errorf("limit %d: %w", i, err)
+			}
+			if limit.Attribute.Type == middleware.AttributeTypePathParam && limit.Attribute.Key == "" {
+				return nil, fmt.Errorf("limit %d: key is required for path_param attribute", i)
+			}
+		default:
+			return nil, fmt.Errorf("limit %d: unknown key %q", i, limit.By)
+		}
+		if limit.Requests <= 0 {
+			return nil, fmt.Errorf("limit %d: requests must be positive", i)
+		}
+		if limit.Period < 0 || limit.Burst < 0 {
+			return nil, fmt.Errorf("limit %d: period and burst can't be negative", i)
+		}
+		if limit.Period == 0 {
+			limit.Period = time.Second
+		}
+		if limit.Burst == 0 {
+			limit.Burst = limit.Requests
+		}
+	}
+	return conf, nil
+}
@@ -0,0 +1,144 @@
+package rate_limit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/odpf/shield/api/handler"
+	"github.com/odpf/shield/api/handler/v1beta1"
+	"github.com/odpf/shield/internal/ratelimit"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/model"
+	"github.com/odpf/shield/structs"
+
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+type projectService struct {
+	v1beta1.ProjectService
+}
+
+func (s projectService) Get(ctx context.Context, id string) (model.Project, error) {
+	return model.Project{Id: id, Organization: model.Organization{Id: "org-" + id}}, nil
+}
+
+func serve(t *testing.T, ware http.Handler, rule *structs.Rule, prepare func(req *http.Request)) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/books", nil)
+	middleware.EnrichRule(req, rule)
+	prepare(req)
+	rw := httptest.NewRecorder()
+	ware.ServeHTTP(rw, req)
+	return rw
+}
+
+func compiledRule(t *testing.T, config map[string]interface{}) *structs.Rule {
+	compiled, err := NewCompiler(handler.Deps{})(config)
+	assert.NoError(t, err)
+	return &structs.Rule{
+		Frontend:    structs.Frontend{Method: http.MethodGet, URL: "/api/books"},
+		Middlewares: structs.MiddlewareSpecs{{Name: "rate_limit", Config: config, Compiled: compiled}},
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	next := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {})
+	deps := handler.Deps{V1beta1: v1beta1.Dep{ProjectService: projectService{}}}
+
+	t.Run("limits per user", func(t *testing.T) {
+		ware := New(log.NewNoop(), "X-Shield-Email", deps, next)
+		rule := compiledRule(t, map[string]interface{}{
+			"limits": []interface{}{map[string]interface{}{"by": "user", "requests": 2, "period": "1m"}},
+		})
+		as := func(email string) func(req *http.Request) {
+			return func(req *http.Request) { req.Header.Set("X-Shield-Email", email) }
+		}
+
+		rw := serve(t, ware, rule, as("a@odpf.io"))
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "1", rw.Header().Get("X-RateLimit-Remaining"))
+		serve(t, ware, rule, as("a@odpf.io"))
+
+		rw = serve(t, ware, rule, as("a@odpf.io"))
+		assert.Equal(t, http.StatusTooManyRequests, rw.Code)
+		assert.Equal(t, "30", rw.Header().Get("Retry-After"))
+
+		assert.Equal(t, http.StatusOK, serve(t, ware, rule, as("b@odpf.io")).Code)
+		// anonymous requests aren't limited by user
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, serve(t, ware, rule, func(req *http.Request) {}).Code)
+		}
+	})
+
+	t.Run("limits per organization of the project", func(t *testing.T) {
+		ware := New(log.NewNoop(), "X-Shield-Email", deps, next)
+		rule := compiledRule(t, map[string]interface{}{
+			"limits": []interface{}{map[string]interface{}{"by": "organization", "requests": 1, "period": "1m"}},
+		})
+		inProject := func(req *http.Request) {
+			middleware.EnrichIdentity(req, middleware.Identity{ProjectId: "p1"})
+		}
+
+		assert.Equal(t, http.StatusOK, serve(t, ware, rule, inProject).Code)
+		assert.Equal(t, http.StatusTooManyRequests, serve(t, ware, rule, inProject).Code)
+	})
+
+	t.Run("grpc callers get resource exhausted", func(t *testing.T) {
+		ware := New(log.NewNoop(), "X-Shield-Email", deps, next)
+		rule := compiledRule(t, map[string]interface{}{
+			"limits": []interface{}{map[string]interface{}{
+				"by":        "attribute",
+				"attribute": map[string]interface{}{"type": "header", "key": "X-Tenant"},
+				"requests":  1,
+			}},
+		})
+		grpcCall := func(req *http.Request) {
+			req.Header.Set("Content-Type", "application/grpc")
+			req.Header.Set("X-Tenant", "odpf")
+		}
+
+		serve(t, ware, rule, grpcCall)
+		rw := serve(t, ware, rule, grpcCall)
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, strconv.Itoa(int(codes.ResourceExhausted)), rw.Header().Get("Grpc-Status"))
+		assert.NotEmpty(t, rw.Header().Get("Grpc-Retry-Pushback-Ms"))
+	})
+}
+
+func TestParseConfig(t *testing.T) {
+	invalid := []map[string]interface{}{
+		{},
+		{"store": "redis", "limits": []interface{}{map[string]interface{}{"by": "user", "requests": 1}}},
+		{"limits": []interface{}{map[string]interface{}{"by": "ip", "requests": 1}}},
+		{"limits": []interface{}{map[string]interface{}{"by": "user"}}},
+		{"limits": []interface{}{map[string]interface{}{"by": "attribute", "attribute": map[string]interface{}{"type": "header"}, "requests": 1}}},
+	}
+	for _, config := range invalid {
+		_, err := parseConfig(config)
+		assert.Error(t, err, config)
+	}
+
+	conf, err := parseConfig(map[string]interface{}{
+		"limits": []interface{}{map[string]interface{}{"by": "route", "requests": 100}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, StoreLocal, conf.Store)
+	assert.Equal(t, 100, conf.Limits[0].Burst)
+}
+
+func TestCompilerRejectsUnavailableStore(t *testing.T) {
+	config := map[string]interface{}{
+		"store":  "postgres",
+		"limits": []interface{}{map[string]interface{}{"by": "user", "requests": 1}},
+	}
+	_, err := NewCompiler(handler.Deps{})(config)
+	assert.Error(t, err)
+
+	compiled, err := NewCompiler(handler.Deps{RateLimitStore: ratelimit.NewLocalStore()})(config)
+	assert.NoError(t, err)
+	assert.Equal(t, StorePostgres, compiled.(*Config).Store)
+}
@@ -0,0 +1,38 @@
+package rulematch
+
+import (
+	"net/http"
+
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+)
+
+// matchGraphQL tells if the request executes the graphql operation of the
+// frontend, the body is buffered with the limit of the first graphql rule
+// matched by path. Requests which aren't valid graphql match no such rule.
+func matchGraphQL(req *http.Request, frontend structs.Frontend) bool {
+	if !frontend.GraphQL.Enabled() {
+		return true
+	}
+	op, err := middleware.GraphQLOperation(req, frontend.Body.MaxSize)
+	if err != nil {
+		return false
+	}
+	if frontend.GraphQL.Operation != "" && op.Type != frontend.GraphQL.Operation {
+		return false
+	}
+	if len(frontend.GraphQL.Fields) == 0 {
+		return true
+	}
+
+	allowed := map[string]bool{}
+	for _, field := range frontend.GraphQL.Fields {
+		allowed[field] = true
+	}
+	for _, field := range op.Fields {
+		if !allowed[field] {
+			return false
+		}
+	}
+	return true
+}
@@ -1,38 +1,49 @@
 package rulematch
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/odpf/shield/middleware"
 	"github.com/odpf/shield/store"
 	"github.com/odpf/shield/structs"
-
-	"github.com/gorilla/mux"
 )
 
+// RouterRepository is a rule repository which keeps rules compiled in a Router
+type RouterRepository interface {
+	GetRouter(ctx context.Context) (*Router, error)
+}
+
 type RouteMatcher struct {
 	ruleRepo store.RuleRepository
 }
 
 func (r RouteMatcher) Match(req *http.Request) (*structs.Rule, error) {
-	ruleset, err := r.ruleRepo.GetAll(req.Context())
+	router, err := r.router(req.Context())
 	if err != nil {
 		return nil, err
 	}
 
-	for _, set := range ruleset {
-		for _, rule := range set.Rules {
-			router := mux.NewRouter()
-			router.StrictSlash(true)
-			route := router.NewRoute().Path(rule.Frontend.URL).Methods(rule.Frontend.Method)
-			routeMatcher := mux.RouteMatch{}
-			if route.Match(req, &routeMatcher) {
-				middleware.EnrichPathParams(req, routeMatcher.Vars)
-				return &rule, nil
-			}
-		}
+	rule, vars, ok := router.Match(req)
+	if !ok {
+		return nil, ErrUnknownRule
+	}
+	middleware.EnrichPathParams(req, vars)
+	return rule, nil
+}
+
+func (r RouteMatcher) router(ctx context.Context) (*Router, error) {
+	if repo, ok := r.ruleRepo.(RouterRepository); ok {
+		return repo.GetRouter(ctx)
+	}
+
+	// repository doesn't keep a compiled router, build it for this request
+	ruleset, err := r.ruleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return nil, ErrUnknownRule
+	router, _ := NewRouter(ruleset)
+	return router, nil
 }
 
 func NewRouteMatcher(ruleRepo store.RuleRepository) *RouteMatcher {
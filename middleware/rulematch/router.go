@@ -0,0 +1,147 @@
+package rulematch
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/odpf/shield/structs"
+
+	"github.com/gorilla/mux"
+)
+
+// Router is an immutable index of rules built once per rule refresh
+// Rules are placed in a trie by the static segments their path template
+// starts with, so only rules sharing the request path prefix are matched
+// with their compiled mux route. Rules keep the precedence of the order
+// they are defined in.
+type Router struct {
+	root *routeNode
+	size int
+}
+
+type routeNode struct {
+	children map[string]*routeNode
+	// routes keyed by http method sorted by rule order
+	routes map[string][]*compiledRoute
+}
+
+type compiledRoute struct {
+	order int
+	rule  structs.Rule
+	route *mux.Route
+}
+
+func newRouteNode() *routeNode {
+	return &routeNode{
+		children: map[string]*routeNode{},
+		routes:   map[string][]*compiledRoute{},
+	}
+}
+
+// NewRouter compiles routes of all rules, rules with an invalid path template
+// are reported in error and are never matched
+func NewRouter(rulesets []structs.Ruleset) (*Router, error) {
+	router := &Router{root: newRouteNode()}
+
+	var errs []string
+	order := 0
+	for _, set := range rulesets {
+		for _, rule := range set.Rules {
+			order++
+
+			muxRouter := mux.NewRouter()
+			muxRouter.StrictSlash(true)
+			route := muxRouter.NewRoute().Path(rule.Frontend.URL).Methods(rule.Frontend.Method)
+			if err := route.GetError(); err != nil {
+				errs = append(errs, fmt.Sprintf("%s %s: %s", rule.Frontend.Method, rule.Frontend.URL, err))
+				continue
+			}
+
+			node := router.root
+			for _, segment := range staticSegments(rule.Frontend.URL) {
+				child, ok := node.children[segment]
+				if !ok {
+					child = newRouteNode()
+					node.children[segment] = child
+				}
+				node = child
+			}
+
+			method := strings.ToUpper(rule.Frontend.Method)
+			node.routes[method] = append(node.routes[method], &compiledRoute{
+				order: order,
+				rule:  rule,
+				route: route,
+			})
+			router.size++
+		}
+	}
+
+	if len(errs) > 0 {
+		return router, fmt.Errorf("invalid rule paths: %s", strings.Join(errs, "; "))
+	}
+	return router, nil
+}
+
+// Match returns a copy of the first rule matching the request along with path params
+func (r *Router) Match(req *http.Request) (*structs.Rule, map[string]string, bool) {
+	var matched *compiledRoute
+	var vars map[string]string
+
+	node := r.root
+	segments := pathSegments(req.URL.Path)
+	for depth := 0; node != nil; depth++ {
+		for _, candidate := range node.routes[req.Method] {
+			if matched != nil && candidate.order > matched.order {
+				break
+			}
+			routeMatch := mux.RouteMatch{}
+			if candidate.route.Match(req, &routeMatch) && matchGraphQL(req, candidate.rule.Frontend) {
+				matched = candidate
+				vars = routeMatch.Vars
+				break
+			}
+		}
+
+		if depth >= len(segments) {
+			break
+		}
+		node = node.children[segments[depth]]
+	}
+
+	if matched == nil {
+		return nil, nil, false
+	}
+	rule := matched.rule
+	return &rule, vars, true
+}
+
+// Len returns number of compiled rules
+func (r *Router) Len() int {
+	return r.size
+}
+
+// staticSegments returns the path segments of a route template before the
+// first segment containing a variable
+func staticSegments(tpl string) []string {
+	var segments []string
+	for _, segment := range pathSegments(tpl) {
+		if strings.Contains(segment, "{") {
+			break
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+// pathSegments splits path by slash ignoring a trailing slash as routes
+// are matched with strict slash
+func pathSegments(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+	path = strings.TrimSuffix(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
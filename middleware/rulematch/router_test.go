@@ -0,0 +1,144 @@
+package rulematch
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/odpf/shield/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+func rule(method, url, backend string) structs.Rule {
+	return structs.Rule{
+		Frontend: structs.Frontend{URL: url, Method: method},
+		Backend:  structs.Backend{URL: backend},
+	}
+}
+
+func TestRouter(t *testing.T) {
+	router, err := NewRouter([]structs.Ruleset{
+		{Rules: []structs.Rule{
+			rule("GET", "/api/books/{urn}", "books-get"),
+			rule("POST", "/api/books/{urn}", "books-post"),
+			rule("GET", "/api/books/special", "books-special"),
+			rule("GET", "/basic1/{project:(?:.*\\/.*)}", "nested"),
+		}},
+		{Rules: []structs.Rule{
+			rule("GET", "/api/{anything}/special", "catch-all"),
+			rule("GET", "/api/authors/", "authors"),
+			rule("GET", "/{all:.*}", "fallback"),
+			rule("GET", "invalid", "invalid"),
+		}},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 7, router.Len())
+
+	table := []struct {
+		method  string
+		path    string
+		backend string
+		vars    map[string]string
+	}{
+		{"GET", "/api/books/relativity", "books-get", map[string]string{"urn": "relativity"}},
+		{"POST", "/api/books/relativity", "books-post", map[string]string{"urn": "relativity"}},
+		// first defined rule wins even if a more specific one is defined later
+		{"GET", "/api/books/special", "books-get", map[string]string{"urn": "special"}},
+		{"GET", "/api/authors/special", "catch-all", map[string]string{"anything": "authors"}},
+		{"GET", "/api/authors", "authors", map[string]string{}},
+		{"GET", "/api/authors/", "authors", map[string]string{}},
+		{"GET", "/basic1/a/b", "nested", map[string]string{"project": "a/b"}},
+		{"GET", "/somewhere/else", "fallback", map[string]string{"all": "somewhere/else"}},
+		{"DELETE", "/api/books/relativity", "", nil},
+	}
+
+	for _, tt := range table {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			matched, vars, ok := router.Match(req)
+			if tt.backend == "" {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, tt.backend, matched.Backend.URL)
+			assert.Equal(t, tt.vars, vars)
+		})
+	}
+}
+
+func graphqlRule(operation string, fields []string, backend string) structs.Rule {
+	r := rule(http.MethodPost, "/graphql", backend)
+	r.Frontend.GraphQL = structs.GraphQLMatch{Operation: operation, Fields: fields}
+	return r
+}
+
+func TestRouterGraphQL(t *testing.T) {
+	router, err := NewRouter([]structs.Ruleset{
+		{Rules: []structs.Rule{
+			graphqlRule("mutation", []string{"createProject"}, "create"),
+			graphqlRule("mutation", nil, "mutations"),
+			graphqlRule("query", []string{"projects", "project"}, "projects"),
+			rule(http.MethodPost, "/graphql", "fallback"),
+		}},
+	})
+	assert.NoError(t, err)
+
+	table := []struct {
+		body    string
+		backend string
+	}{
+		{`{"query":"mutation { createProject(name: \"books\") { id } }"}`, "create"},
+		{`{"query":"mutation { created: createProject(name: \"books\") { id } deleteProject(id: 1) }"}`, "mutations"},
+		{`{"query":"{ projects { id } project(id: 1) { id } }"}`, "projects"},
+		{`{"query":"query A { users { id } } query B { projects { id } }","operationName":"B"}`, "projects"},
+		{`{"query":"{ users { id } }"}`, "fallback"},
+		{`not graphql`, "fallback"},
+	}
+	for _, tt := range table {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(tt.body))
+		req.Header.Set("Content-Type", "application/json")
+		matched, _, ok := router.Match(req)
+		assert.True(t, ok)
+		assert.Equal(t, tt.backend, matched.Backend.URL, tt.body)
+
+		// the buffered body is proxied
+		proxied, _ := ioutil.ReadAll(req.Body)
+		assert.Equal(t, tt.body, string(proxied))
+	}
+}
+
+func benchmarkRules(n int) []structs.Ruleset {
+	var rules []structs.Rule
+	for i := 0; i < n; i++ {
+		rules = append(rules,
+			rule(http.MethodGet, fmt.Sprintf("/service-%d/resources/{id}", i), "get"),
+			rule(http.MethodPost, fmt.Sprintf("/service-%d/resources", i), "post"),
+		)
+	}
+	return []structs.Ruleset{{Rules: rules}}
+}
+
+func benchmarkRouter(b *testing.B, n int) {
+	router, err := NewRouter(benchmarkRules(n / 2))
+	if err != nil {
+		b.Fatal(err)
+	}
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/service-%d/resources/abc", n/2-1), nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, ok := router.Match(req); !ok {
+			b.Fatal("rule not matched")
+		}
+	}
+}
+
+func BenchmarkRouterMatch100(b *testing.B)   { benchmarkRouter(b, 100) }
+func BenchmarkRouterMatch1000(b *testing.B)  { benchmarkRouter(b, 1000) }
+func BenchmarkRouterMatch5000(b *testing.B)  { benchmarkRouter(b, 5000) }
+func BenchmarkRouterMatch10000(b *testing.B) { benchmarkRouter(b, 10000) }
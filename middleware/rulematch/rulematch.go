@@ -3,10 +3,15 @@ package rulematch
 import (
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/odpf/salt/log"
 	"github.com/odpf/shield/middleware"
 	"github.com/odpf/shield/structs"
+	"github.com/odpf/shield/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
 )
 
 var (
@@ -35,20 +40,51 @@ func (m Ware) Info() *structs.MiddlewareInfo {
 }
 
 func (m *Ware) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	// the span covers matching and buffering the body, not the middlewares
+	// after it
+	_, span := tracing.Tracer().Start(req.Context(), "rulematch")
+
 	// find matched rule
 	matchedRule, err := m.ruleMatcher.Match(req)
 	if err != nil {
 		m.log.Info("middleware: failed to match rule", "path", req.URL.String(), "err", err)
+		tracing.EndSpan(span, err)
 		rw.WriteHeader(http.StatusBadRequest)
 		return
 	}
 	middleware.EnrichRule(req, matchedRule)
+	middleware.ExtractAccessLog(req).MatchRule(matchedRule)
+	span.SetAttributes(
+		attribute.String("shield.rule", middleware.RuleName(matchedRule)),
+		attribute.String("shield.backend", matchedRule.Backend.Namespace),
+	)
 
-	// enriching context with request body to use it in hooks
-	if err := middleware.EnrichRequestBody(req); err != nil {
-		m.log.Info("middleware: failed to enrich ctx with request body", "err", err)
-		rw.WriteHeader(http.StatusInternalServerError)
-		return
+	// enriching context with request body to use it in middlewares and hooks,
+	// other bodies are streamed to the backend untouched
+	if matchedRule.Frontend.Body.Buffered {
+		if err := middleware.EnrichRequestBody(req, matchedRule.Frontend.Body.MaxSize); err != nil {
+			m.log.Info("middleware: failed to enrich ctx with request body", "err", err)
+			tracing.EndSpan(span, err)
+			if errors.Is(err, middleware.ErrBodyTooLarge) {
+				bodyTooLarge(rw, req)
+				return
+			}
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
 	}
+	span.End()
 	m.next.ServeHTTP(rw, req)
 }
+
+// bodyTooLarge replies with 413 or RESOURCE_EXHAUSTED to grpc clients
+func bodyTooLarge(rw http.ResponseWriter, req *http.Request) {
+	if !strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
+		rw.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/grpc")
+	rw.Header().Set("Grpc-Status", strconv.Itoa(int(codes.ResourceExhausted)))
+	rw.Header().Set("Grpc-Message", middleware.ErrBodyTooLarge.Error())
+	rw.WriteHeader(http.StatusOK)
+}
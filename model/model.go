@@ -82,6 +82,31 @@ type User struct {
 	UpdatedAt time.Time
 }
 
+// ServiceAccount is a non human identity owned by an organization, and
+// optionally a project, which authenticates with API keys
+type ServiceAccount struct {
+	Id             string
+	Name           string
+	OrganizationId string `json:"org_id"`
+	ProjectId      string `json:"project_id"`
+	Metadata       map[string]string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// APIKey of a service account, only the hash of its secret is stored and
+// Secret is set just once while creating the key
+type APIKey struct {
+	Id               string
+	Name             string
+	ServiceAccountId string `json:"service_account_id"`
+	Secret           string `json:"-"`
+	SecretHash       string `json:"-"`
+	ExpiresAt        time.Time
+	RevokedAt        time.Time
+	CreatedAt        time.Time
+}
+
 type Relation struct {
 	Id                 string
 	SubjectNamespace   Namespace
@@ -119,6 +144,23 @@ type Permission struct {
 	Name string
 }
 
+// Explanation is a node of the tree evaluated while checking a permission,
+// it tells which relations granted the permission or failed to
+type Explanation struct {
+	// Kind is one of permission, relation, union, intersection, exclusion,
+	// tuple_to_userset or subject
+	Kind       string         `json:"kind"`
+	Object     string         `json:"object"`
+	Name       string         `json:"name,omitempty"`
+	Expression string         `json:"expression,omitempty"`
+	Allowed    bool           `json:"allowed"`
+	Children   []*Explanation `json:"children,omitempty"`
+
+	// Mismatch is set on the root when Allowed is the decision of SpiceDB and
+	// the tree, evaluated from relations stored by shield, doesn't reach it
+	Mismatch bool `json:"mismatch,omitempty"`
+}
+
 type RelationType string
 
 var RelationTypes = struct {
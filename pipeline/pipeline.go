@@ -0,0 +1,174 @@
+// Package pipeline runs the middlewares and hooks of a rule in the order they
+// are declared in the rule file. Middlewares and hooks are looked up by name
+// in a Registry and chained once per rule while rules are loaded.
+//
+// Custom middlewares are registered before rules are loaded, through
+// cmd.WithPipeline when shield is built as a library, and read their config
+// from the matched rule like the built-in ones:
+//
+//	registry.RegisterMiddleware("tenant", func(next http.Handler) http.Handler {
+//		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+//			spec, _ := middleware.ExtractMiddleware(req, "tenant")
+//			config := spec.Compiled.(*TenantConfig)
+//			// ...
+//			next.ServeHTTP(rw, req)
+//		})
+//	}, compileTenantConfig)
+package pipeline
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/odpf/shield/hook"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+)
+
+// MiddlewareFactory builds an instance of a middleware calling next, it is
+// called for every rule using the middleware each time rules are loaded.
+// State shared between rules, like rate limit buckets, has to be created
+// outside of it.
+type MiddlewareFactory func(next http.Handler) http.Handler
+
+// HookFactory builds an instance of a hook calling next, or escape to skip
+// the rest of the hooks, for every rule using the hook
+type HookFactory func(next, escape hook.Service) hook.Service
+
+type middlewareEntry struct {
+	factory  MiddlewareFactory
+	compiler structs.ConfigCompiler
+}
+
+type hookEntry struct {
+	factory  HookFactory
+	compiler structs.ConfigCompiler
+}
+
+// Registry has the middlewares and hooks rules can use by name
+type Registry struct {
+	middlewares map[string]middlewareEntry
+	hooks       map[string]hookEntry
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		middlewares: map[string]middlewareEntry{},
+		hooks:       map[string]hookEntry{},
+	}
+}
+
+// RegisterMiddleware makes the middleware usable in rules by name, compiler
+// decodes and validates its config while loading rules and may be nil
+// Should be called before rules are loaded
+func (r *Registry) RegisterMiddleware(name string, factory MiddlewareFactory, compiler structs.ConfigCompiler) {
+	r.middlewares[name] = middlewareEntry{factory: factory, compiler: compiler}
+}
+
+// RegisterHook makes the hook usable in rules by name, compiler decodes and
+// validates its config while loading rules and may be nil
+// Should be called before rules are loaded
+func (r *Registry) RegisterHook(name string, factory HookFactory, compiler structs.ConfigCompiler) {
+	r.hooks[name] = hookEntry{factory: factory, compiler: compiler}
+}
+
+// Middlewares runs the middleware chain of the matched rule before next
+type Middlewares struct {
+	registry *Registry
+	next     http.Handler
+}
+
+func NewMiddlewares(registry *Registry, next http.Handler) *Middlewares {
+	return &Middlewares{
+		registry: registry,
+		next:     next,
+	}
+}
+
+// Compile compiles middleware configs of the rule and chains them in the
+// order they are declared, a rule using an unknown middleware is invalid
+func (m *Middlewares) Compile(rule *structs.Rule) error {
+	var chain http.Handler = m.next
+	for idx := len(rule.Middlewares) - 1; idx >= 0; idx-- {
+		spec := rule.Middlewares[idx]
+		entry, ok := m.registry.middlewares[spec.Name]
+		if !ok {
+			return fmt.Errorf("unknown middleware %q", spec.Name)
+		}
+		if entry.compiler != nil {
+			compiled, err := entry.compiler(spec.Config)
+			if err != nil {
+				return fmt.Errorf("middleware %s: %w", spec.Name, err)
+			}
+			rule.Middlewares[idx].Compiled = compiled
+		}
+		chain = entry.factory(chain)
+	}
+	rule.MiddlewareChain = chain
+	return nil
+}
+
+func (m *Middlewares) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	rule, ok := middleware.ExtractRule(req)
+	if !ok || rule.MiddlewareChain == nil {
+		m.next.ServeHTTP(rw, req)
+		return
+	}
+	rule.MiddlewareChain.ServeHTTP(rw, req)
+}
+
+// Hooks runs the hook chain of the matched rule on the response, root is
+// called at the end of the chain or when a hook escapes
+type Hooks struct {
+	registry *Registry
+	root     hook.Service
+}
+
+func NewHooks(registry *Registry, root hook.Service) *Hooks {
+	return &Hooks{
+		registry: registry,
+		root:     root,
+	}
+}
+
+// Compile compiles hook configs of the rule and chains them in the order
+// they are declared, a rule using an unknown hook is invalid
+func (h *Hooks) Compile(rule *structs.Rule) error {
+	chain := h.root
+	for idx := len(rule.Hooks) - 1; idx >= 0; idx-- {
+		spec := rule.Hooks[idx]
+		entry, ok := h.registry.hooks[spec.Name]
+		if !ok {
+			return fmt.Errorf("unknown hook %q", spec.Name)
+		}
+		if entry.compiler != nil {
+			compiled, err := entry.compiler(spec.Config)
+			if err != nil {
+				return fmt.Errorf("hook %s: %w", spec.Name, err)
+			}
+			rule.Hooks[idx].Compiled = compiled
+		}
+		chain = entry.factory(chain, h.root)
+	}
+	rule.HookChain = chain
+	return nil
+}
+
+func (h *Hooks) Info() hook.Info {
+	return hook.Info{
+		Name:        "_pipeline",
+		Description: "run hooks of the matched rule in declared order",
+	}
+}
+
+func (h *Hooks) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	rule, ok := hook.ExtractRule(res.Request)
+	if !ok {
+		return h.root.ServeHook(res, err)
+	}
+	chain, ok := rule.HookChain.(hook.Service)
+	if !ok {
+		return h.root.ServeHook(res, err)
+	}
+	return chain.ServeHook(res, err)
+}
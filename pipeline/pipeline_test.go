@@ -0,0 +1,118 @@
+package pipeline
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/odpf/shield/hook"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordWare appends its name to the X-Chain header of requests
+func recordWare(name string) MiddlewareFactory {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			req.Header.Add("X-Chain", name)
+			next.ServeHTTP(rw, req)
+		})
+	}
+}
+
+// recordHook appends its name to the X-Chain header of responses
+type recordHook struct {
+	name         string
+	next, escape hook.Service
+}
+
+func (h recordHook) Info() hook.Info {
+	return hook.Info{Name: h.name}
+}
+
+func (h recordHook) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	res.Header.Add("X-Chain", h.name)
+	if h.name == "escape" {
+		return h.escape.ServeHook(res, err)
+	}
+	return h.next.ServeHook(res, err)
+}
+
+func testRegistry() *Registry {
+	registry := NewRegistry()
+	for _, name := range []string{"first", "second", "third"} {
+		registry.RegisterMiddleware(name, recordWare(name), nil)
+	}
+	registry.RegisterMiddleware("strict", recordWare("strict"), func(config map[string]interface{}) (interface{}, error) {
+		if config["valid"] != true {
+			return nil, errors.New("invalid config")
+		}
+		return "compiled", nil
+	})
+	for _, name := range []string{"first", "second", "escape"} {
+		name := name
+		registry.RegisterHook(name, func(next, escape hook.Service) hook.Service {
+			return recordHook{name: name, next: next, escape: escape}
+		}, nil)
+	}
+	return registry
+}
+
+func TestMiddlewares(t *testing.T) {
+	var proxied []string
+	proxy := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		proxied = req.Header.Values("X-Chain")
+	})
+	middlewares := NewMiddlewares(testRegistry(), proxy)
+
+	rule := &structs.Rule{Middlewares: structs.MiddlewareSpecs{
+		{Name: "third"}, {Name: "first"}, {Name: "strict", Config: map[string]interface{}{"valid": true}}, {Name: "second"},
+	}}
+	assert.NoError(t, middlewares.Compile(rule))
+	assert.Equal(t, "compiled", rule.Middlewares[2].Compiled)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	middleware.EnrichRule(req, rule)
+	middlewares.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, []string{"third", "first", "strict", "second"}, proxied)
+
+	// rules without middlewares are proxied right away
+	rule = &structs.Rule{}
+	assert.NoError(t, middlewares.Compile(rule))
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	middleware.EnrichRule(req, rule)
+	middlewares.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Empty(t, proxied)
+
+	err := middlewares.Compile(&structs.Rule{Middlewares: structs.MiddlewareSpecs{{Name: "unknown"}}})
+	assert.Error(t, err)
+	err = middlewares.Compile(&structs.Rule{Middlewares: structs.MiddlewareSpecs{{Name: "strict"}}})
+	assert.Error(t, err)
+}
+
+func TestHooks(t *testing.T) {
+	hooks := NewHooks(testRegistry(), hook.New())
+
+	serve := func(specs ...string) []string {
+		rule := &structs.Rule{}
+		for _, name := range specs {
+			rule.Hooks = append(rule.Hooks, structs.HookSpec{Name: name})
+		}
+		assert.NoError(t, hooks.Compile(rule))
+
+		req := httptest.NewRequest(http.MethodGet, "/", strings.NewReader(""))
+		middleware.EnrichRule(req, rule)
+		res, err := hooks.ServeHook(&http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Request: req}, nil)
+		assert.NoError(t, err)
+		return res.Header.Values("X-Chain")
+	}
+	assert.Equal(t, []string{"second", "first"}, serve("second", "first"))
+	assert.Equal(t, []string{"first", "escape"}, serve("first", "escape", "second"))
+	assert.Empty(t, serve())
+
+	err := hooks.Compile(&structs.Rule{Hooks: structs.HookSpecs{{Name: "unknown"}}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,236 @@
+package body_extractor
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/grpcreflect"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+const (
+	// reflectionTimeout bounds resolving a service over server reflection
+	reflectionTimeout = 5 * time.Second
+
+	// reflectionFailureTTL is how long a failed resolution is returned
+	// before the backend is asked again
+	reflectionFailureTTL = 30 * time.Second
+)
+
+// MethodResolver resolves grpc methods to their descriptors so message
+// fields can be read by name
+type MethodResolver interface {
+	// ResolveMethod returns the method of a request path like
+	// /library.v1.Books/GetBook
+	ResolveMethod(fullMethod string) (*desc.MethodDescriptor, error)
+}
+
+// splitMethod returns the service and method of a grpc request path, paths
+// may be prefixed e.g. /api/library.v1.Books/GetBook
+func splitMethod(fullMethod string) (string, string, error) {
+	parts := strings.Split(strings.Trim(fullMethod, "/"), "/")
+	if len(parts) < 2 || parts[len(parts)-2] == "" || parts[len(parts)-1] == "" {
+		return "", "", fmt.Errorf("invalid grpc method %s", fullMethod)
+	}
+	return parts[len(parts)-2], parts[len(parts)-1], nil
+}
+
+// DescriptorSet resolves methods of services in a FileDescriptorSet as
+// generated by protoc --include_imports --descriptor_set_out
+type DescriptorSet struct {
+	services map[string]*desc.ServiceDescriptor
+}
+
+func NewDescriptorSet(raw []byte) (*DescriptorSet, error) {
+	var fds descriptor.FileDescriptorSet
+	if err := proto.Unmarshal(raw, &fds); err != nil {
+		return nil, fmt.Errorf("failed to parse descriptor set: %w", err)
+	}
+	files, err := desc.CreateFileDescriptorsFromSet(&fds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid descriptor set: %w", err)
+	}
+
+	set := &DescriptorSet{services: map[string]*desc.ServiceDescriptor{}}
+	for _, file := range files {
+		for _, service := range file.GetServices() {
+			set.services[service.GetFullyQualifiedName()] = service
+		}
+	}
+	return set, nil
+}
+
+func (d *DescriptorSet) ResolveMethod(fullMethod string) (*desc.MethodDescriptor, error) {
+	serviceName, methodName, err := splitMethod(fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	service, ok := d.services[serviceName]
+	if !ok {
+		return nil, fmt.Errorf("service %s not found in descriptor set", serviceName)
+	}
+	return findMethod(service, methodName)
+}
+
+// Reflection resolves methods with grpc server reflection of the backend,
+// services are resolved once and kept. Failures are kept for a short while
+// so a broken backend isn't asked on every request.
+type Reflection struct {
+	target    string
+	secure    bool
+	tlsConfig *tls.Config
+	now       func() time.Time
+
+	// resolving deduplicates concurrent resolutions of a service
+	resolving singleflight.Group
+
+	mu       sync.Mutex
+	conn     *grpc.ClientConn
+	services map[string]*desc.ServiceDescriptor
+	failures map[string]reflectionFailure
+}
+
+type reflectionFailure struct {
+	err error
+	at  time.Time
+}
+
+// NewReflection returns a resolver querying target over tls if secure, the
+// connection is made on first use. tlsConfig is the client config of the
+// backend, nil verifies the target with the system CAs.
+func NewReflection(target string, secure bool, tlsConfig *tls.Config) *Reflection {
+	return &Reflection{
+		target:    target,
+		secure:    secure,
+		tlsConfig: tlsConfig,
+		now:       time.Now,
+		services:  map[string]*desc.ServiceDescriptor{},
+		failures:  map[string]reflectionFailure{},
+	}
+}
+
+func (r *Reflection) ResolveMethod(fullMethod string) (*desc.MethodDescriptor, error) {
+	serviceName, methodName, err := splitMethod(fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	service, err := r.service(serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return findMethod(service, methodName)
+}
+
+// service returns the resolved or recently failed service, otherwise it is
+// resolved without holding the lock, once for all concurrent callers
+func (r *Reflection) service(serviceName string) (*desc.ServiceDescriptor, error) {
+	r.mu.Lock()
+	service, ok := r.services[serviceName]
+	failure, failed := r.failures[serviceName]
+	r.mu.Unlock()
+	if ok {
+		return service, nil
+	}
+	if failed && r.now().Sub(failure.at) < reflectionFailureTTL {
+		return nil, failure.err
+	}
+
+	resolved, err, _ := r.resolving.Do(serviceName, func() (interface{}, error) {
+		service, err := r.resolveService(serviceName)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if err != nil {
+			r.failures[serviceName] = reflectionFailure{err: err, at: r.now()}
+			return nil, err
+		}
+		delete(r.failures, serviceName)
+		r.services[serviceName] = service
+		return service, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resolved.(*desc.ServiceDescriptor), nil
+}
+
+// connection returns the client connection of the target, dialing it if
+// needed
+func (r *Reflection) connection() (*grpc.ClientConn, error) {
+	r.mu.Lock()
+	conn := r.conn
+	r.mu.Unlock()
+	if conn != nil {
+		return conn, nil
+	}
+
+	creds := grpc.WithInsecure()
+	if r.secure {
+		tlsConfig := r.tlsConfig
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		creds = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+	conn, err := grpc.Dial(r.target, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.conn != nil {
+		// dialed concurrently for another service
+		conn.Close()
+		return r.conn, nil
+	}
+	r.conn = conn
+	return conn, nil
+}
+
+func (r *Reflection) resolveService(serviceName string) (*desc.ServiceDescriptor, error) {
+	conn, err := r.connection()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), reflectionTimeout)
+	defer cancel()
+	client := grpcreflect.NewClient(ctx, rpb.NewServerReflectionClient(conn))
+	defer client.Reset()
+
+	service, err := client.ResolveService(serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve service %s with reflection: %w", serviceName, err)
+	}
+	return service, nil
+}
+
+func (r *Reflection) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.conn == nil {
+		return nil
+	}
+	err := r.conn.Close()
+	r.conn = nil
+	return err
+}
+
+func findMethod(service *desc.ServiceDescriptor, methodName string) (*desc.MethodDescriptor, error) {
+	method := service.FindMethodByName(methodName)
+	if method == nil {
+		return nil, fmt.Errorf("method %s not found in service %s", methodName, service.GetFullyQualifiedName())
+	}
+	return method, nil
+}
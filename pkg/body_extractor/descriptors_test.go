@@ -0,0 +1,207 @@
+package body_extractor
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	fixturesv1 "github.com/odpf/shield/pkg/body_extractor/fixtures"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
+)
+
+const greetMethod = "/hello.helloTestService/Greet"
+
+func fixtureDescriptorSet(t *testing.T) []byte {
+	msgDesc, err := desc.LoadMessageDescriptorForMessage(&fixturesv1.NestedMessageL0{})
+	assert.NoError(t, err)
+	raw, err := proto.Marshal(desc.ToFileDescriptorSet(msgDesc.GetFile()))
+	assert.NoError(t, err)
+	return raw
+}
+
+func grpcMessage(t *testing.T, msg proto.Message) []byte {
+	raw, err := proto.Marshal(msg)
+	assert.NoError(t, err)
+	frame := make([]byte, 5, 5+len(raw))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(raw)))
+	return append(frame, raw...)
+}
+
+func TestExtractPath(t *testing.T) {
+	set, err := NewDescriptorSet(fixtureDescriptorSet(t))
+	assert.NoError(t, err)
+
+	frame := grpcMessage(t, &fixturesv1.NestedMessageL0{L1: &fixturesv1.NestedMessageL1{L2: &fixturesv1.NestedMessageL2{
+		L3: []*fixturesv1.NestedMessageL3{
+			{S1L3: "first", L5: &fixturesv1.NestedMessageL5{S1L5: "nested"}},
+			{S1L3: "second"},
+		},
+		L4: []*fixturesv1.NestedMessageL4{{S3L4: []string{"two", "four"}}},
+	}}})
+
+	table := []struct {
+		path string
+		want interface{}
+		err  bool
+	}{
+		{path: "l1.l2.l3[0].s1l3", want: "first"},
+		{path: "l1.l2.l3[0].l5.s1l5", want: "nested"},
+		{path: "l1.l2.l3[*].s1l3", want: []interface{}{"first", "second"}},
+		{path: "l1.l2.l4[0].s3l4", want: []interface{}{"two", "four"}},
+		{path: "l1.l2.l4[0].s3l4[1]", want: "four"},
+		{path: "l1.l2.l3[2].s1l3", err: true},
+		{path: "l1.l2.unknown", err: true},
+		{path: "l1.l2", err: true},
+	}
+	for _, tt := range table {
+		body := ioutil.NopCloser(bytes.NewReader(frame))
+		value, err := GRPCPayloadHandler{Method: greetMethod, Resolver: set}.ExtractPath(&body, tt.path)
+		assert.Equal(t, tt.err, err != nil, tt.path)
+		if !tt.err {
+			assert.EqualValues(t, tt.want, value, tt.path)
+		}
+
+		// body is left to be read again
+		rest, _ := ioutil.ReadAll(body)
+		assert.Equal(t, frame, rest)
+	}
+
+	_, err = set.ResolveMethod("/hello.helloTestService/Unknown")
+	assert.Error(t, err)
+	_, err = set.ResolveMethod("/hello.unknownService/Greet")
+	assert.Error(t, err)
+}
+
+type greeter struct {
+	fixturesv1.UnimplementedHelloTestServiceServer
+}
+
+func TestReflection(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer()
+	fixturesv1.RegisterHelloTestServiceServer(server, greeter{})
+	reflection.Register(server)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	resolver := NewReflection(listener.Addr().String(), false, nil)
+	defer resolver.Close()
+
+	method, err := resolver.ResolveMethod("/api" + greetMethod)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello.nestedMessageL0", method.GetInputType().GetFullyQualifiedName())
+
+	_, err = resolver.ResolveMethod("/hello.unknownService/Greet")
+	assert.Error(t, err)
+}
+
+// selfSignedCert returns a certificate for 127.0.0.1 along with a pool
+// trusting it
+func selfSignedCert(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "reflection test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+func TestReflectionTLS(t *testing.T) {
+	cert, pool := selfSignedCert(t)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}})))
+	fixturesv1.RegisterHelloTestServiceServer(server, greeter{})
+	reflection.Register(server)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	// the backend CA is used to verify the server
+	resolver := NewReflection(listener.Addr().String(), true, &tls.Config{RootCAs: pool})
+	defer resolver.Close()
+	_, err = resolver.ResolveMethod(greetMethod)
+	assert.NoError(t, err)
+
+	// system CAs don't trust the server
+	untrusted := NewReflection(listener.Addr().String(), true, nil)
+	defer untrusted.Close()
+	_, err = untrusted.ResolveMethod(greetMethod)
+	assert.Error(t, err)
+}
+
+func TestReflectionResolvesOnce(t *testing.T) {
+	var streams int32
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	server := grpc.NewServer(grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		atomic.AddInt32(&streams, 1)
+		return handler(srv, ss)
+	}))
+	fixturesv1.RegisterHelloTestServiceServer(server, greeter{})
+	reflection.Register(server)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	resolver := NewReflection(listener.Addr().String(), false, nil)
+	defer resolver.Close()
+	now := time.Now()
+	resolver.now = func() time.Time { return now }
+
+	// concurrent requests share one resolution
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := resolver.ResolveMethod(greetMethod)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&streams))
+
+	// failures are kept until they expire
+	_, err = resolver.ResolveMethod("/hello.unknownService/Greet")
+	assert.Error(t, err)
+	_, err = resolver.ResolveMethod("/hello.unknownService/Greet")
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&streams))
+
+	now = now.Add(reflectionFailureTTL)
+	_, err = resolver.ResolveMethod("/hello.unknownService/Greet")
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&streams))
+}
@@ -0,0 +1,244 @@
+package body_extractor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/tidwall/gjson"
+	"google.golang.org/grpc/encoding"
+)
+
+// ItemFilter tells which items of a list are kept, it gets the ids read from
+// the items in order and returns one result for each of them
+type ItemFilter func(ids []string) ([]bool, error)
+
+// Filter removes items of the array at path for which filter returns false,
+// key is the path of the id in an item or empty if items are ids. A missing
+// array is left as is. The body is replaced and its new length returned.
+func (h JSONPayloadHandler) Filter(body *io.ReadCloser, path, key string, filter ItemFilter) (int, error) {
+	resBody, err := ioutil.ReadAll(*body)
+	if err != nil {
+		return 0, err
+	}
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(resBody))
+
+	list := gjson.GetBytes(resBody, path)
+	if !list.Exists() {
+		return len(resBody), nil
+	}
+	if !list.IsArray() {
+		return 0, fmt.Errorf("%s is not an array", path)
+	}
+	// the array is replaced in place, only simple paths point at raw json
+	if list.Index <= 0 {
+		return 0, fmt.Errorf("%s can't be rewritten", path)
+	}
+
+	items := list.Array()
+	ids := make([]string, len(items))
+	for i, item := range items {
+		id := item
+		if key != "" {
+			id = item.Get(key)
+		}
+		if !id.Exists() || id.IsObject() || id.IsArray() {
+			return 0, fmt.Errorf("item %d of %s has no id at %s", i, path, key)
+		}
+		ids[i] = id.String()
+	}
+	keep, err := filterItems(filter, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	var kept []string
+	for i, item := range items {
+		if keep[i] {
+			kept = append(kept, item.Raw)
+		}
+	}
+	filtered := make([]byte, 0, len(resBody))
+	filtered = append(filtered, resBody[:list.Index]...)
+	filtered = append(filtered, "["+strings.Join(kept, ",")+"]"...)
+	filtered = append(filtered, resBody[list.Index+len(list.Raw):]...)
+
+	*body = ioutil.NopCloser(bytes.NewReader(filtered))
+	return len(filtered), nil
+}
+
+// Filter removes items of the repeated field at path, like data.books, from
+// every message of the grpc body for which filter returns false. Messages are
+// resolved with Resolver, key is the path of the id in an item or empty if
+// items are ids. The body is replaced and its new length returned.
+func (b GRPCPayloadHandler) Filter(body *io.ReadCloser, path, key string, filter ItemFilter) (int, error) {
+	if b.Resolver == nil {
+		return 0, errors.New("proto descriptors are not configured for the backend")
+	}
+	method, err := b.Resolver.ResolveMethod(b.Method)
+	if err != nil {
+		return 0, err
+	}
+	msgDesc := method.GetInputType()
+	if b.Response {
+		msgDesc = method.GetOutputType()
+	}
+
+	resBody, err := ioutil.ReadAll(*body)
+	if err != nil {
+		return 0, err
+	}
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(resBody))
+
+	var filtered bytes.Buffer
+	parser := grpcRequestParser{r: bytes.NewReader(resBody)}
+	for {
+		pf, raw, err := parser.Parse()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if pf == compressionMade {
+			if raw, err = decompress(b.Encoding, raw); err != nil {
+				return 0, err
+			}
+		}
+
+		msg := dynamic.NewMessage(msgDesc)
+		if err := msg.Unmarshal(raw); err != nil {
+			return 0, err
+		}
+		if err := filterRepeated(msg, path, key, filter); err != nil {
+			return 0, err
+		}
+		if raw, err = msg.Marshal(); err != nil {
+			return 0, err
+		}
+		if pf == compressionMade {
+			if raw, err = compress(b.Encoding, raw); err != nil {
+				return 0, err
+			}
+		}
+
+		header := [5]byte{byte(pf)}
+		binary.BigEndian.PutUint32(header[1:], uint32(len(raw)))
+		filtered.Write(header[:])
+		filtered.Write(raw)
+	}
+
+	*body = ioutil.NopCloser(bytes.NewReader(filtered.Bytes()))
+	return filtered.Len(), nil
+}
+
+// filterRepeated walks message fields of path by name and removes items of
+// the last one, an unset message on the way has nothing to filter
+func filterRepeated(msg *dynamic.Message, path, key string, filter ItemFilter) error {
+	parts := strings.Split(path, ".")
+	for _, name := range parts[:len(parts)-1] {
+		fd := msg.GetMessageDescriptor().FindFieldByName(name)
+		if fd == nil {
+			return fmt.Errorf("field %s not found in %s", name, msg.GetMessageDescriptor().GetFullyQualifiedName())
+		}
+		if fd.IsRepeated() || fd.GetMessageType() == nil {
+			return fmt.Errorf("field %s is not a message", name)
+		}
+		if !msg.HasField(fd) {
+			return nil
+		}
+		field, err := msg.TryGetField(fd)
+		if err != nil {
+			return err
+		}
+		if msg, _ = field.(*dynamic.Message); msg == nil {
+			return fmt.Errorf("field %s is not a message", name)
+		}
+	}
+
+	name := parts[len(parts)-1]
+	fd := msg.GetMessageDescriptor().FindFieldByName(name)
+	if fd == nil {
+		return fmt.Errorf("field %s not found in %s", name, msg.GetMessageDescriptor().GetFullyQualifiedName())
+	}
+	if !fd.IsRepeated() || fd.IsMap() {
+		return fmt.Errorf("field %s is not repeated", name)
+	}
+	field, err := msg.TryGetField(fd)
+	if err != nil {
+		return err
+	}
+	items, _ := field.([]interface{})
+
+	ids := make([]string, len(items))
+	for i, item := range items {
+		id := item
+		if key != "" {
+			itemMsg, ok := item.(*dynamic.Message)
+			if !ok {
+				return fmt.Errorf("items of %s are not messages", name)
+			}
+			if id, err = fieldByPath(itemMsg, key); err != nil {
+				return err
+			}
+		}
+		if _, ok := id.(*dynamic.Message); ok {
+			return fmt.Errorf("item %d of %s has no id at %s", i, path, key)
+		}
+		ids[i] = fmt.Sprint(id)
+	}
+	keep, err := filterItems(filter, ids)
+	if err != nil {
+		return err
+	}
+
+	kept := []interface{}{}
+	for i, item := range items {
+		if keep[i] {
+			kept = append(kept, item)
+		}
+	}
+	return msg.TrySetField(fd, kept)
+}
+
+func filterItems(filter ItemFilter, ids []string) ([]bool, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	keep, err := filter(ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(keep) != len(ids) {
+		return nil, fmt.Errorf("filter returned %d results for %d items", len(keep), len(ids))
+	}
+	return keep, nil
+}
+
+// compress deflates a message with the compressor registered in grpc for the
+// encoding it was received with
+func compress(name string, msg []byte) ([]byte, error) {
+	compressor := encoding.GetCompressor(name)
+	if compressor == nil {
+		return nil, fmt.Errorf("unsupported grpc encoding %s", name)
+	}
+	var buf bytes.Buffer
+	w, err := compressor.Compress(&buf)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
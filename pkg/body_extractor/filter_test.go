@@ -0,0 +1,108 @@
+package body_extractor
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	fixturesv1 "github.com/odpf/shield/pkg/body_extractor/fixtures"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// allowIDs keeps items with ids in allowed
+func allowIDs(allowed ...string) ItemFilter {
+	return func(ids []string) ([]bool, error) {
+		keep := make([]bool, len(ids))
+		for i, id := range ids {
+			for _, a := range allowed {
+				keep[i] = keep[i] || id == a
+			}
+		}
+		return keep, nil
+	}
+}
+
+func TestFilterJSON(t *testing.T) {
+	table := []struct {
+		body string
+		path string
+		key  string
+		want string
+		err  bool
+	}{
+		{
+			body: `{"data": {"books": [{"urn": "b1"}, {"urn": "b2"}, {"urn": "b3"}]}, "count": 3}`,
+			path: "data.books",
+			key:  "urn",
+			want: `{"data": {"books": [{"urn": "b1"},{"urn": "b3"}]}, "count": 3}`,
+		},
+		{
+			body: `{"urns": ["b1", "b2"]}`,
+			path: "urns",
+			want: `{"urns": ["b1"]}`,
+		},
+		{
+			body: `{"urns": ["b2"]}`,
+			path: "urns",
+			want: `{"urns": []}`,
+		},
+		{
+			body: `{"count": 0}`,
+			path: "books",
+			key:  "urn",
+			want: `{"count": 0}`,
+		},
+		{body: `{"books": {"urn": "b1"}}`, path: "books", key: "urn", err: true},
+		{body: `{"books": [{"id": "b1"}]}`, path: "books", key: "urn", err: true},
+	}
+	for _, tt := range table {
+		body := ioutil.NopCloser(bytes.NewBufferString(tt.body))
+		n, err := JSONPayloadHandler{}.Filter(&body, tt.path, tt.key, allowIDs("b1", "b3"))
+		if tt.err {
+			assert.Error(t, err, tt.body)
+			continue
+		}
+		assert.NoError(t, err, tt.body)
+		filtered, _ := ioutil.ReadAll(body)
+		assert.Equal(t, tt.want, string(filtered))
+		assert.Equal(t, len(filtered), n)
+	}
+}
+
+func TestFilterGRPC(t *testing.T) {
+	set, err := NewDescriptorSet(fixtureDescriptorSet(t))
+	assert.NoError(t, err)
+
+	msg := &fixturesv1.NestedMessageL0{L1: &fixturesv1.NestedMessageL1{L2: &fixturesv1.NestedMessageL2{
+		L3: []*fixturesv1.NestedMessageL3{{S1L3: "first"}, {S1L3: "second"}, {S1L3: "third"}},
+	}}}
+	frame := grpcMessage(t, msg)
+	// server streams send many messages in a body
+	stream := append(append([]byte{}, frame...), frame...)
+
+	handler := GRPCPayloadHandler{Method: greetMethod, Resolver: set}
+	body := ioutil.NopCloser(bytes.NewReader(stream))
+	n, err := handler.Filter(&body, "l1.l2.l3", "s1l3", func(ids []string) ([]bool, error) {
+		assert.Equal(t, []string{"first", "second", "third"}, ids)
+		return []bool{true, false, true}, nil
+	})
+	assert.NoError(t, err)
+
+	filtered, _ := ioutil.ReadAll(body)
+	assert.Equal(t, len(filtered), n)
+	want := grpcMessage(t, &fixturesv1.NestedMessageL0{L1: &fixturesv1.NestedMessageL1{L2: &fixturesv1.NestedMessageL2{
+		L3: []*fixturesv1.NestedMessageL3{{S1L3: "first"}, {S1L3: "third"}},
+	}}})
+	assert.Equal(t, append(append([]byte{}, want...), want...), filtered)
+
+	for _, path := range []string{"l1.l2", "l1.unknown", "l1.l2.l3.s1l3"} {
+		body = ioutil.NopCloser(bytes.NewReader(frame))
+		_, err = handler.Filter(&body, path, "s1l3", allowIDs("first"))
+		assert.Error(t, err, path)
+	}
+
+	body = ioutil.NopCloser(bytes.NewReader(frame))
+	_, err = GRPCPayloadHandler{Method: greetMethod}.Filter(&body, "l1.l2.l3", "s1l3", allowIDs("first"))
+	assert.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -17,6 +18,8 @@ import (
 
 	"github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	_ "google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/status"
 )
 
@@ -29,6 +32,10 @@ const (
 
 	maxInt = int(^uint(0) >> 1)
 
+	// maxDecompressedSize bounds decompressed messages, it is the default
+	// max message size of grpc
+	maxDecompressedSize = 4 << 20
+
 	MessageArray = "MessageArray"
 	StringArray  = "StringArray"
 	String       = "String"
@@ -82,6 +89,17 @@ type Query struct {
 }
 
 type GRPCPayloadHandler struct {
+	// Encoding is the grpc-encoding header sent along the message, compressed
+	// messages are decompressed with the grpc compressor registered for it
+	Encoding string
+
+	// Method is the request path of the grpc call, the message is resolved
+	// from it with Resolver when fields are extracted by name
+	Method   string
+	Resolver MethodResolver
+	// Response tells the body is the response message of the method
+	Response bool
+
 	grpcDisabled bool
 }
 
@@ -97,9 +115,52 @@ func (b GRPCPayloadHandler) Extract(body *io.ReadCloser, protoIndex string) (int
 	return b.extractFromRequest(reqBody, protoIndex)
 }
 
+// ExtractPath extracts a field by names like project.id, books[0].urn or
+// books[*].urn out of the message type of Method
+func (b GRPCPayloadHandler) ExtractPath(body *io.ReadCloser, path string) (interface{}, error) {
+	if b.Resolver == nil {
+		return nil, errors.New("proto descriptors are not configured for the backend")
+	}
+	method, err := b.Resolver.ResolveMethod(b.Method)
+	if err != nil {
+		return nil, err
+	}
+	msgDesc := method.GetInputType()
+	if b.Response {
+		msgDesc = method.GetOutputType()
+	}
+
+	reqBody, err := ioutil.ReadAll(*body)
+	if err != nil {
+		return nil, err
+	}
+	defer (*body).Close()
+	// repopulate body
+	*body = ioutil.NopCloser(bytes.NewBuffer(reqBody))
+
+	raw, err := b.message(reqBody)
+	if err != nil {
+		return nil, err
+	}
+	msg := dynamic.NewMessage(msgDesc)
+	if err := msg.Unmarshal(raw); err != nil {
+		return nil, err
+	}
+	return fieldByPath(msg, path)
+}
+
 func (b GRPCPayloadHandler) extractFromRequest(body []byte, protoIndex string) (interface{}, error) {
+	msg, err := b.message(body)
+	if err != nil {
+		return "", err
+	}
+	return fieldFromProtoMessage(msg, protoIndex)
+}
+
+// message returns the first proto message of a grpc body, decompressed
+func (b GRPCPayloadHandler) message(body []byte) ([]byte, error) {
 	if b.grpcDisabled {
-		return fieldFromProtoMessage(body, protoIndex)
+		return body, nil
 	}
 
 	reqParser := grpcRequestParser{
@@ -108,14 +169,100 @@ func (b GRPCPayloadHandler) extractFromRequest(body []byte, protoIndex string) (
 	}
 	pf, msg, err := reqParser.Parse()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	if pf == compressionMade {
-		// unsupported for now
-		return "", errors.New("compressed message, unsupported grpc feature")
+		return decompress(b.Encoding, msg)
 	}
+	return msg, nil
+}
 
-	return fieldFromProtoMessage(msg, protoIndex)
+// fieldByPath walks message fields by name, repeated fields are indexed
+// with [n] or [*] to collect the rest of the path from every item
+func fieldByPath(msg *dynamic.Message, path string) (interface{}, error) {
+	parts := strings.Split(path, ".")
+	var value interface{} = msg
+	for i, part := range parts {
+		current, ok := value.(*dynamic.Message)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a message", strings.Join(parts[:i], "."))
+		}
+
+		name, index := part, ""
+		if open := strings.Index(part, "["); open >= 0 && strings.HasSuffix(part, "]") {
+			name, index = part[:open], part[open+1:len(part)-1]
+		}
+		fd := current.GetMessageDescriptor().FindFieldByName(name)
+		if fd == nil {
+			return nil, fmt.Errorf("field %s not found in %s", name, current.GetMessageDescriptor().GetFullyQualifiedName())
+		}
+		field, err := current.TryGetField(fd)
+		if err != nil {
+			return nil, err
+		}
+		if index == "" {
+			value = field
+			continue
+		}
+
+		items, ok := field.([]interface{})
+		if !ok || !fd.IsRepeated() {
+			return nil, fmt.Errorf("field %s is not repeated", name)
+		}
+		if index == "*" {
+			rest := strings.Join(parts[i+1:], ".")
+			if rest == "" {
+				return items, nil
+			}
+			values := []interface{}{}
+			for _, item := range items {
+				itemMsg, ok := item.(*dynamic.Message)
+				if !ok {
+					return nil, fmt.Errorf("items of %s are not messages", name)
+				}
+				itemValue, err := fieldByPath(itemMsg, rest)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, itemValue)
+			}
+			return values, nil
+		}
+		n, err := strconv.Atoi(index)
+		if err != nil || n < 0 || n >= len(items) {
+			return nil, fmt.Errorf("index %s of %s is out of range", index, name)
+		}
+		value = items[n]
+	}
+
+	if _, ok := value.(*dynamic.Message); ok {
+		return nil, fmt.Errorf("%s is a message, a field of it has to be selected", path)
+	}
+	return value, nil
+}
+
+// decompress inflates a compressed message with the compressor registered
+// in grpc for the encoding, gzip is always registered
+func decompress(name string, msg []byte) ([]byte, error) {
+	if name == "" || name == "identity" {
+		return nil, errors.New("compressed message without grpc-encoding")
+	}
+	compressor := encoding.GetCompressor(name)
+	if compressor == nil {
+		return nil, fmt.Errorf("compressed message, unsupported grpc encoding %s", name)
+	}
+	r, err := compressor.Decompress(bytes.NewReader(msg))
+	if err != nil {
+		return nil, err
+	}
+	decompressed, err := ioutil.ReadAll(io.LimitReader(r, maxDecompressedSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(decompressed) > maxDecompressedSize {
+		return nil, status.Errorf(codes.ResourceExhausted, "grpc: decompressed message larger than max length allowed (%d)", maxDecompressedSize)
+	}
+	return decompressed, nil
 }
 
 // grpcRequestParser reads complete gRPC messages from the underlying reader
@@ -142,7 +289,7 @@ type grpcRequestParser struct {
 // that the underlying io.Reader must not return an incompatible
 // error.
 func (p *grpcRequestParser) Parse() (pf GRPCPayloadCompressionFormat, msg []byte, err error) {
-	if _, err := p.r.Read(p.header[:]); err != nil {
+	if _, err := io.ReadFull(p.r, p.header[:]); err != nil {
 		return 0, nil, err
 	}
 	// first byte is for compressed or not
@@ -157,7 +304,7 @@ func (p *grpcRequestParser) Parse() (pf GRPCPayloadCompressionFormat, msg []byte
 	}
 
 	msg = make([]byte, int(length))
-	if _, err := p.r.Read(msg); err != nil {
+	if _, err := io.ReadFull(p.r, msg); err != nil {
 		if err == io.EOF {
 			err = io.ErrUnexpectedEOF
 		}
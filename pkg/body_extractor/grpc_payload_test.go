@@ -2,6 +2,8 @@ package body_extractor
 
 import (
 	"bytes"
+	"compress/gzip"
+	"encoding/binary"
 	"fmt"
 	"io/ioutil"
 	"testing"
@@ -250,3 +252,37 @@ func TestExtract(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractCompressed(t *testing.T) {
+	msg, err := proto.Marshal(&fixturesv1.NestedMessageL3{S1L3: "S1L3"})
+	assert.NoError(t, err)
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err = gz.Write(msg)
+	assert.NoError(t, err)
+	assert.NoError(t, gz.Close())
+
+	frame := make([]byte, 5, 5+compressed.Len())
+	frame[0] = byte(compressionMade)
+	binary.BigEndian.PutUint32(frame[1:], uint32(compressed.Len()))
+	frame = append(frame, compressed.Bytes()...)
+
+	table := []struct {
+		encoding string
+		want     interface{}
+		err      bool
+	}{
+		{encoding: "gzip", want: "S1L3"},
+		{encoding: "", err: true},
+		{encoding: "snappy", err: true},
+	}
+	for _, tt := range table {
+		body := ioutil.NopCloser(bytes.NewReader(frame))
+		extractedData, err := GRPCPayloadHandler{Encoding: tt.encoding}.Extract(&body, "1")
+		assert.Equal(t, tt.err, err != nil, tt.encoding)
+		if !tt.err {
+			assert.EqualValues(t, tt.want, extractedData)
+		}
+	}
+}
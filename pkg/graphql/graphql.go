@@ -0,0 +1,221 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Request is a graphql request as sent over http, in the query string of
+// GET requests or in the body of POST requests
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// ParseRequest reads a graphql request from the query string of GET
+// requests, from a json body or from an application/graphql body
+func ParseRequest(method, contentType string, query url.Values, body io.Reader) (Request, error) {
+	var req Request
+	if method == http.MethodGet {
+		req.Query = query.Get("query")
+		req.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				return req, fmt.Errorf("invalid graphql variables: %w", err)
+			}
+		}
+		return req, nil
+	}
+
+	raw, err := ioutil.ReadAll(body)
+	if err != nil {
+		return req, err
+	}
+	if strings.HasPrefix(contentType, "application/graphql") {
+		req.Query = string(raw)
+		return req, nil
+	}
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return req, fmt.Errorf("invalid graphql request: %w", err)
+	}
+	return req, nil
+}
+
+// Operation is the operation of a graphql request which is executed
+type Operation struct {
+	// Type is one of query, mutation or subscription
+	Type string
+	Name string
+
+	// Fields are names of the top-level fields selected by the operation,
+	// fields of fragments are included
+	Fields []string
+
+	fields    []*ast.Field
+	variables map[string]interface{}
+}
+
+// Parse parses the query document of the request and picks the operation
+// to execute, the document isn't validated against a schema
+func Parse(req Request) (*Operation, error) {
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, errors.New("graphql query is empty")
+	}
+	doc, gqlErr := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if gqlErr != nil {
+		return nil, fmt.Errorf("invalid graphql query: %w", gqlErr)
+	}
+
+	def := doc.Operations.ForName(req.OperationName)
+	if def == nil {
+		if req.OperationName == "" {
+			return nil, errors.New("operationName is required for documents with many operations")
+		}
+		return nil, fmt.Errorf("operation %s not found", req.OperationName)
+	}
+
+	fields, err := collectFields(doc, def.SelectionSet, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	// variables which aren't sent take their default value
+	variables := map[string]interface{}{}
+	for _, varDef := range def.VariableDefinitions {
+		if varDef.DefaultValue == nil {
+			continue
+		}
+		if variables[varDef.Variable], err = varDef.DefaultValue.Value(nil); err != nil {
+			return nil, fmt.Errorf("invalid default of $%s: %w", varDef.Variable, err)
+		}
+	}
+	for name, value := range req.Variables {
+		variables[name] = value
+	}
+
+	op := &Operation{
+		Type:      string(def.Operation),
+		Name:      def.Name,
+		fields:    fields,
+		variables: variables,
+	}
+	for _, field := range fields {
+		op.Fields = append(op.Fields, field.Name)
+	}
+	return op, nil
+}
+
+// collectFields returns fields of a selection set along with the fields of
+// its fragments, visited guards against fragments spreading themselves
+func collectFields(doc *ast.QueryDocument, set ast.SelectionSet, visited map[string]bool) ([]*ast.Field, error) {
+	var fields []*ast.Field
+	for _, selection := range set {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			fields = append(fields, selection)
+		case *ast.InlineFragment:
+			nested, err := collectFields(doc, selection.SelectionSet, visited)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, nested...)
+		case *ast.FragmentSpread:
+			fragment := doc.Fragments.ForName(selection.Name)
+			if fragment == nil {
+				return nil, fmt.Errorf("fragment %s not found", selection.Name)
+			}
+			if visited[fragment.Name] {
+				return nil, fmt.Errorf("fragment %s spreads itself", fragment.Name)
+			}
+			visited[fragment.Name] = true
+			nested, err := collectFields(doc, fragment.SelectionSet, visited)
+			if err != nil {
+				return nil, err
+			}
+			delete(visited, fragment.Name)
+			fields = append(fields, nested...)
+		}
+	}
+	return fields, nil
+}
+
+// Argument returns an argument of a top-level field with variables
+// substituted, the path is the field name, the argument name and keys of
+// nested input objects e.g. createProject.input.orgId. Paths starting with
+// $ read variables e.g. $input.orgId
+func (o *Operation) Argument(path string) (interface{}, error) {
+	keys := strings.Split(path, ".")
+
+	var value interface{}
+	if strings.HasPrefix(keys[0], "$") {
+		var ok bool
+		if value, ok = o.variables[strings.TrimPrefix(keys[0], "$")]; !ok {
+			return nil, fmt.Errorf("variable %s not found", keys[0])
+		}
+		keys = keys[1:]
+	} else {
+		if len(keys) < 2 {
+			return nil, fmt.Errorf("invalid argument path %s, expected field.argument", path)
+		}
+		field, err := o.field(keys[0])
+		if err != nil {
+			return nil, err
+		}
+		arg := field.Arguments.ForName(keys[1])
+		if arg == nil {
+			return nil, fmt.Errorf("argument %s of %s not found", keys[1], keys[0])
+		}
+		if value, err = arg.Value.Value(o.variables); err != nil {
+			return nil, err
+		}
+		keys = keys[2:]
+	}
+
+	for _, key := range keys {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("failed to find field: %s", path)
+		}
+		if value, ok = obj[key]; !ok {
+			return nil, fmt.Errorf("failed to find field: %s", path)
+		}
+	}
+	if value == nil {
+		return nil, fmt.Errorf("field %s is null", path)
+	}
+	return value, nil
+}
+
+// ResultPath returns the json path of a field in the response of the
+// operation, the path starts with the name of a top-level field which is
+// replaced by its alias e.g. createProject.id is data.created.id for
+// mutation { created: createProject(...) { id } }
+func (o *Operation) ResultPath(path string) (string, error) {
+	keys := strings.SplitN(path, ".", 2)
+	field, err := o.field(keys[0])
+	if err != nil {
+		return "", err
+	}
+	keys[0] = "data." + field.Alias
+	return strings.Join(keys, "."), nil
+}
+
+// field returns the first top-level field of the name
+func (o *Operation) field(name string) (*ast.Field, error) {
+	for _, field := range o.fields {
+		if field.Name == name {
+			return field, nil
+		}
+	}
+	return nil, fmt.Errorf("field %s not selected by the %s", name, o.Type)
+}
@@ -0,0 +1,88 @@
+package graphql
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRequest(t *testing.T) {
+	query := url.Values{}
+	query.Set("query", "{ projects { id } }")
+	query.Set("variables", `{"first":10}`)
+	req, err := ParseRequest(http.MethodGet, "", query, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "{ projects { id } }", req.Query)
+	assert.EqualValues(t, 10, req.Variables["first"])
+
+	req, err = ParseRequest(http.MethodPost, "application/json", nil, strings.NewReader(`{"query":"query Projects { projects { id } }","operationName":"Projects"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "Projects", req.OperationName)
+
+	req, err = ParseRequest(http.MethodPost, "application/graphql", nil, strings.NewReader("{ projects { id } }"))
+	assert.NoError(t, err)
+	assert.Equal(t, "{ projects { id } }", req.Query)
+
+	_, err = ParseRequest(http.MethodPost, "application/json", nil, strings.NewReader("{ projects { id } }"))
+	assert.Error(t, err)
+}
+
+func TestParse(t *testing.T) {
+	doc := `
+query Projects { projects { id } }
+
+mutation Create($org: ID!, $name: String = "default") {
+	created: createProject(input: {orgId: $org, name: $name, labels: ["a", "b"]}) { id }
+	...audit
+}
+
+fragment audit on Mutation {
+	... on Mutation { logEvent(kind: CREATE) }
+}
+`
+	op, err := Parse(Request{Query: doc, OperationName: "Create", Variables: map[string]interface{}{"org": "odpf"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "mutation", op.Type)
+	assert.Equal(t, []string{"createProject", "logEvent"}, op.Fields)
+
+	table := []struct {
+		path string
+		want interface{}
+		err  bool
+	}{
+		{path: "createProject.input.orgId", want: "odpf"},
+		{path: "createProject.input.name", want: "default"},
+		{path: "createProject.input.labels", want: []interface{}{"a", "b"}},
+		{path: "logEvent.kind", want: "CREATE"},
+		{path: "$org", want: "odpf"},
+		{path: "$missing", err: true},
+		{path: "createProject.input.unknown", err: true},
+		{path: "createProject", err: true},
+		{path: "deleteProject.id", err: true},
+	}
+	for _, tt := range table {
+		value, err := op.Argument(tt.path)
+		assert.Equal(t, tt.err, err != nil, tt.path)
+		assert.Equal(t, tt.want, value, tt.path)
+	}
+
+	path, err := op.ResultPath("createProject.id")
+	assert.NoError(t, err)
+	assert.Equal(t, "data.created.id", path)
+
+	_, err = Parse(Request{Query: doc})
+	assert.Error(t, err)
+	_, err = Parse(Request{Query: doc, OperationName: "Unknown"})
+	assert.Error(t, err)
+	_, err = Parse(Request{Query: "{ projects { id }"})
+	assert.Error(t, err)
+	_, err = Parse(Request{Query: "{ ...loop } fragment loop on Query { ...loop }"})
+	assert.Error(t, err)
+
+	op, err = Parse(Request{Query: "{ projects { id } }"})
+	assert.NoError(t, err)
+	assert.Equal(t, "query", op.Type)
+}
@@ -8,6 +8,10 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Config struct {
@@ -41,7 +45,11 @@ func New(config Config) (*SQL, error) {
 	return &SQL{DB: d, queryTimeOut: config.MaxQueryTimeoutInMS}, err
 }
 
-func (s SQL) WithTimeout(ctx context.Context, op func(ctx context.Context) error) (err error) {
+// WithTimeout runs op with the query timeout, operation names its span
+func (s SQL) WithTimeout(ctx context.Context, operation string, op func(ctx context.Context) error) (err error) {
+	ctx, span := startSpan(ctx, operation)
+	defer func() { endSpan(span, err) }()
+
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, s.queryTimeOut)
 	defer cancel()
 
@@ -49,7 +57,10 @@ func (s SQL) WithTimeout(ctx context.Context, op func(ctx context.Context) error
 }
 
 // Handling transactions: https://stackoverflow.com/a/23502629/8244298
-func (s SQL) WithTxn(ctx context.Context, txnOptions sql.TxOptions, txFunc func(*sqlx.Tx) error) (err error) {
+func (s SQL) WithTxn(ctx context.Context, operation string, txnOptions sql.TxOptions, txFunc func(*sqlx.Tx) error) (err error) {
+	ctx, span := startSpan(ctx, operation)
+	defer func() { endSpan(span, err) }()
+
 	txn, err := s.BeginTxx(ctx, &txnOptions)
 	if err != nil {
 		return err
@@ -80,3 +91,20 @@ func (s SQL) WithTxn(ctx context.Context, txnOptions sql.TxOptions, txFunc func(
 	err = txFunc(txn)
 	return err
 }
+
+// startSpan starts a span named after the store operation running the query
+func startSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return otel.Tracer("github.com/odpf/shield/pkg/sql").Start(ctx, "postgres "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.system", "postgresql")),
+	)
+}
+
+// endSpan ends the span of a query, no rows found isn't a failure
+func endSpan(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -7074,6 +7074,249 @@ func (x *UpdateResourceResponse) GetResource() *Resource {
 	return nil
 }
 
+type DeleteResourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NamespaceId string `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteResourceRequest) Reset() {
+	*x = DeleteResourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceRequest) ProtoMessage() {}
+
+func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DeleteResourceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteResourceRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *DeleteResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteResourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteResourceResponse) Reset() {
+	*x = DeleteResourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceResponse) ProtoMessage() {}
+
+func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResourceResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{135}
+}
+
+type ListAuthorizedResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	ActionId    string `protobuf:"bytes,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	UserId      string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrgId       string `protobuf:"bytes,4,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	PageSize    int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken   string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListAuthorizedResourcesRequest) Reset() {
+	*x = ListAuthorizedResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuthorizedResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorizedResourcesRequest) ProtoMessage() {}
+
+func (x *ListAuthorizedResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorizedResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthorizedResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListAuthorizedResourcesRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *ListAuthorizedResourcesRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *ListAuthorizedResourcesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAuthorizedResourcesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListAuthorizedResourcesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuthorizedResourcesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuthorizedResourcesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resources     []*Resource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListAuthorizedResourcesResponse) Reset() {
+	*x = ListAuthorizedResourcesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuthorizedResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorizedResourcesResponse) ProtoMessage() {}
+
+func (x *ListAuthorizedResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorizedResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthorizedResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ListAuthorizedResourcesResponse) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ListAuthorizedResourcesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type ResourceActionAuthzRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -7087,7 +7330,7 @@ type ResourceActionAuthzRequest struct {
 func (x *ResourceActionAuthzRequest) Reset() {
 	*x = ResourceActionAuthzRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[134]
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7100,7 +7343,7 @@ func (x *ResourceActionAuthzRequest) String() string {
 func (*ResourceActionAuthzRequest) ProtoMessage() {}
 
 func (x *ResourceActionAuthzRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[134]
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7113,7 +7356,7 @@ func (x *ResourceActionAuthzRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceActionAuthzRequest.ProtoReflect.Descriptor instead.
 func (*ResourceActionAuthzRequest) Descriptor() ([]byte, []int) {
-	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{134}
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *ResourceActionAuthzRequest) GetResourceId() string {
@@ -7148,7 +7391,7 @@ type ResourceActionAuthzResponse struct {
 func (x *ResourceActionAuthzResponse) Reset() {
 	*x = ResourceActionAuthzResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[135]
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7161,7 +7404,7 @@ func (x *ResourceActionAuthzResponse) String() string {
 func (*ResourceActionAuthzResponse) ProtoMessage() {}
 
 func (x *ResourceActionAuthzResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[135]
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7174,7 +7417,7 @@ func (x *ResourceActionAuthzResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceActionAuthzResponse.ProtoReflect.Descriptor instead.
 func (*ResourceActionAuthzResponse) Descriptor() ([]byte, []int) {
-	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{135}
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *ResourceActionAuthzResponse) GetStatus() string {
@@ -7184,73 +7427,1613 @@ func (x *ResourceActionAuthzResponse) GetStatus() string {
 	return ""
 }
 
-var File_odpf_shield_v1beta1_shield_proto protoreflect.FileDescriptor
+type PermissionCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_odpf_shield_v1beta1_shield_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x6f, 0x64, 0x70, 0x66, 0x2f, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x13, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
-	0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01,
-	0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64,
-	0x79, 0x12, 0x2b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x32, 0x10, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
-	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x60, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x33, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x4d, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64,
-	0x79, 0x22, 0xa1, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x32,
-	0x10, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b,
-	0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x1d, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
-	0x02, 0x60, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x43, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x64, 0x70, 0x66,
-	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x64,
-	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x64, 0x70, 0x66,
-	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x19, 0x55, 0x70,
+	ResourceId  string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	NamespaceId string `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	ActionId    string `protobuf:"bytes,3,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+}
+
+func (x *PermissionCheck) Reset() {
+	*x = PermissionCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionCheck) ProtoMessage() {}
+
+func (x *PermissionCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionCheck.ProtoReflect.Descriptor instead.
+func (*PermissionCheck) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *PermissionCheck) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+type PermissionCheckResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceId  string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	NamespaceId string `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	ActionId    string `protobuf:"bytes,3,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	Allowed     bool   `protobuf:"varint,4,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (x *PermissionCheckResult) Reset() {
+	*x = PermissionCheckResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionCheckResult) ProtoMessage() {}
+
+func (x *PermissionCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionCheckResult.ProtoReflect.Descriptor instead.
+func (*PermissionCheckResult) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *PermissionCheckResult) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *PermissionCheckResult) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *PermissionCheckResult) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *PermissionCheckResult) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+type CheckResourcePermissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checks []*PermissionCheck `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *CheckResourcePermissionsRequest) Reset() {
+	*x = CheckResourcePermissionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckResourcePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResourcePermissionsRequest) ProtoMessage() {}
+
+func (x *CheckResourcePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResourcePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*CheckResourcePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *CheckResourcePermissionsRequest) GetChecks() []*PermissionCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type CheckResourcePermissionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*PermissionCheckResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *CheckResourcePermissionsResponse) Reset() {
+	*x = CheckResourcePermissionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckResourcePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResourcePermissionsResponse) ProtoMessage() {}
+
+func (x *CheckResourcePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResourcePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*CheckResourcePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *CheckResourcePermissionsResponse) GetResults() []*PermissionCheckResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type PermissionExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind       string                   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Object     string                   `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Name       string                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Expression string                   `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	Allowed    bool                     `protobuf:"varint,5,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Children   []*PermissionExplanation `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+	Mismatch   bool                     `protobuf:"varint,7,opt,name=mismatch,proto3" json:"mismatch,omitempty"`
+}
+
+func (x *PermissionExplanation) Reset() {
+	*x = PermissionExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionExplanation) ProtoMessage() {}
+
+func (x *PermissionExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionExplanation.ProtoReflect.Descriptor instead.
+func (*PermissionExplanation) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *PermissionExplanation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PermissionExplanation) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *PermissionExplanation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PermissionExplanation) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *PermissionExplanation) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *PermissionExplanation) GetChildren() []*PermissionExplanation {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *PermissionExplanation) GetMismatch() bool {
+	if x != nil {
+		return x.Mismatch
+	}
+	return false
+}
+
+type ExplainResourcePermissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceId  string `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	NamespaceId string `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	ActionId    string `protobuf:"bytes,3,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	UserId      string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ExplainResourcePermissionRequest) Reset() {
+	*x = ExplainResourcePermissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainResourcePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainResourcePermissionRequest) ProtoMessage() {}
+
+func (x *ExplainResourcePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainResourcePermissionRequest.ProtoReflect.Descriptor instead.
+func (*ExplainResourcePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ExplainResourcePermissionRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ExplainResourcePermissionRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *ExplainResourcePermissionRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *ExplainResourcePermissionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExplainResourcePermissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed     bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Explanation *PermissionExplanation `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+}
+
+func (x *ExplainResourcePermissionResponse) Reset() {
+	*x = ExplainResourcePermissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainResourcePermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainResourcePermissionResponse) ProtoMessage() {}
+
+func (x *ExplainResourcePermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainResourcePermissionResponse.ProtoReflect.Descriptor instead.
+func (*ExplainResourcePermissionResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ExplainResourcePermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ExplainResourcePermissionResponse) GetExplanation() *PermissionExplanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+type ServiceAccountRequestBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OrgId     string           `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ProjectId string           `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Metadata  *structpb.Struct `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *ServiceAccountRequestBody) Reset() {
+	*x = ServiceAccountRequestBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceAccountRequestBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccountRequestBody) ProtoMessage() {}
+
+func (x *ServiceAccountRequestBody) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccountRequestBody.ProtoReflect.Descriptor instead.
+func (*ServiceAccountRequestBody) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ServiceAccountRequestBody) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceAccountRequestBody) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ServiceAccountRequestBody) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ServiceAccountRequestBody) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ServiceAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OrgId     string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	ProjectId string                 `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Metadata  *structpb.Struct       `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ServiceAccount) Reset() {
+	*x = ServiceAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceAccount) ProtoMessage() {}
+
+func (x *ServiceAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceAccount.ProtoReflect.Descriptor instead.
+func (*ServiceAccount) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ServiceAccount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateServiceAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Body *ServiceAccountRequestBody `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *CreateServiceAccountRequest) Reset() {
+	*x = CreateServiceAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountRequest) ProtoMessage() {}
+
+func (x *CreateServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *CreateServiceAccountRequest) GetBody() *ServiceAccountRequestBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type CreateServiceAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceAccount *ServiceAccount `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+}
+
+func (x *CreateServiceAccountResponse) Reset() {
+	*x = CreateServiceAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountResponse) ProtoMessage() {}
+
+func (x *CreateServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *CreateServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+type ListServiceAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+}
+
+func (x *ListServiceAccountsRequest) Reset() {
+	*x = ListServiceAccountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServiceAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsRequest) ProtoMessage() {}
+
+func (x *ListServiceAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *ListServiceAccountsRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type ListServiceAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceAccounts []*ServiceAccount `protobuf:"bytes,1,rep,name=service_accounts,json=serviceAccounts,proto3" json:"service_accounts,omitempty"`
+}
+
+func (x *ListServiceAccountsResponse) Reset() {
+	*x = ListServiceAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListServiceAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceAccountsResponse) ProtoMessage() {}
+
+func (x *ListServiceAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ListServiceAccountsResponse) GetServiceAccounts() []*ServiceAccount {
+	if x != nil {
+		return x.ServiceAccounts
+	}
+	return nil
+}
+
+type GetServiceAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetServiceAccountRequest) Reset() {
+	*x = GetServiceAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAccountRequest) ProtoMessage() {}
+
+func (x *GetServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *GetServiceAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetServiceAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceAccount *ServiceAccount `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+}
+
+func (x *GetServiceAccountResponse) Reset() {
+	*x = GetServiceAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceAccountResponse) ProtoMessage() {}
+
+func (x *GetServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *GetServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if x != nil {
+		return x.ServiceAccount
+	}
+	return nil
+}
+
+type DeleteServiceAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteServiceAccountRequest) Reset() {
+	*x = DeleteServiceAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteServiceAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountRequest) ProtoMessage() {}
+
+func (x *DeleteServiceAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *DeleteServiceAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteServiceAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteServiceAccountResponse) Reset() {
+	*x = DeleteServiceAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteServiceAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceAccountResponse) ProtoMessage() {}
+
+func (x *DeleteServiceAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{156}
+}
+
+type APIKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ServiceAccountId string                 `protobuf:"bytes,3,opt,name=service_account_id,json=serviceAccountId,proto3" json:"service_account_id,omitempty"`
+	Key              string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *APIKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetServiceAccountId() string {
+	if x != nil {
+		return x.ServiceAccountId
+	}
+	return ""
+}
+
+func (x *APIKey) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *APIKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type APIKeyRequestBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpiresIn string `protobuf:"bytes,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+}
+
+func (x *APIKeyRequestBody) Reset() {
+	*x = APIKeyRequestBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKeyRequestBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyRequestBody) ProtoMessage() {}
+
+func (x *APIKeyRequestBody) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyRequestBody.ProtoReflect.Descriptor instead.
+func (*APIKeyRequestBody) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *APIKeyRequestBody) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKeyRequestBody) GetExpiresIn() string {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return ""
+}
+
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body *APIKeyRequestBody `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *CreateAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetBody() *APIKeyRequestBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *APIKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *CreateAPIKeyResponse) GetKey() *APIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ListAPIKeysRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*APIKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ListAPIKeysResponse) GetKeys() []*APIKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RotateAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeyId       string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	GracePeriod string `protobuf:"bytes,3,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+}
+
+func (x *RotateAPIKeyRequest) Reset() {
+	*x = RotateAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyRequest) ProtoMessage() {}
+
+func (x *RotateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *RotateAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RotateAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *RotateAPIKeyRequest) GetGracePeriod() string {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return ""
+}
+
+type RotateAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *APIKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *RotateAPIKeyResponse) Reset() {
+	*x = RotateAPIKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyResponse) ProtoMessage() {}
+
+func (x *RotateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *RotateAPIKeyResponse) GetKey() *APIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeyId string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *RevokeAPIKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *APIKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_odpf_shield_v1beta1_shield_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_odpf_shield_v1beta1_shield_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *RevokeAPIKeyResponse) GetKey() *APIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+var File_odpf_shield_v1beta1_shield_proto protoreflect.FileDescriptor
+
+var file_odpf_shield_v1beta1_shield_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6f, 0x64, 0x70, 0x66, 0x2f, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01,
+	0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x2b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x32, 0x10, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x60, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x33, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x4d, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x22, 0xa1, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x32,
+	0x10, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b,
+	0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x1d, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x60, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x43, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x64, 0x70, 0x66,
+	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x64,
+	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x64, 0x70, 0x66,
+	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x19, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
@@ -7995,640 +9778,1023 @@ var file_odpf_shield_v1beta1_shield_proto_rawDesc = []byte{
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f,
 	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12,
-	0x32, 0x10, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d,
-	0x2b, 0x24, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x34,
-	0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x32, 0x10, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61,
-	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72,
-	0x12, 0x32, 0x10, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d,
-	0x5d, 0x2b, 0x24, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
-	0x22, 0x35, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x91, 0x4c, 0x0a, 0x0d, 0x53, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x09, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5e, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xcc, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6f,
+	0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x86,
+	0x01, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc8, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14,
+	0x72, 0x12, 0x32, 0x10, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f,
+	0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x34, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x72, 0x12, 0x32, 0x10, 0x5e, 0x5b, 0x41, 0x2d,
+	0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x08, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xfa, 0x42,
+	0x14, 0x72, 0x12, 0x32, 0x10, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5f, 0x2d, 0x5d, 0x2b, 0x24, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x64, 0x22, 0x35, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x72, 0x0a, 0x0f, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x92, 0x01,
+	0x0a, 0x15, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x22, 0x5f, 0x0a, 0x1f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x22, 0x68, 0x0a, 0x20, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xf5, 0x01,
+	0x0a, 0x15, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c,
+	0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x12, 0x46, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x9c, 0x01, 0x0a, 0x20, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x21, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66,
+	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x95, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x61, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x6c, 0x0a, 0x1c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x6d, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x69, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
 	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x92, 0x41,
-	0x15, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0d, 0x47, 0x65, 0x74, 0x20, 0x41, 0x6c, 0x6c,
-	0x20, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x2d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x9d, 0x02, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x46, 0x0a, 0x11, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x22, 0x61, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x3a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x45, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x22, 0x24, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x5f, 0x0a, 0x13, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x22, 0x45, 0x0a, 0x14, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3c, 0x0a, 0x13, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x32,
+	0x84, 0x61, 0x0a, 0x0d, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x8a, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x25, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x92, 0x41, 0x15, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x0d, 0x47, 0x65, 0x74, 0x20, 0x41, 0x6c, 0x6c, 0x20, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x91,
+	0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x26, 0x2e,
 	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x92, 0x41, 0x13, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0b, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x55, 0x73, 0x65, 0x72, 0x12, 0x8c, 0x01, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x64,
-	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41,
-	0x18, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x47, 0x65, 0x74, 0x20, 0x61, 0x20, 0x55,
-	0x73, 0x65, 0x72, 0x20, 0x62, 0x79, 0x20, 0x69, 0x64, 0x12, 0xad, 0x01, 0x0a, 0x0e, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2a, 0x2e, 0x6f,
-	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x92, 0x41, 0x1d, 0x0a, 0x04, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20,
-	0x6f, 0x66, 0x20, 0x61, 0x20, 0x55, 0x73, 0x65, 0x72, 0x12, 0xa1, 0x01, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x6f,
-	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x73, 0x65,
-	0x6c, 0x66, 0x92, 0x41, 0x18, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x10, 0x47, 0x65, 0x74,
-	0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x73, 0x65, 0x72, 0x12, 0x9c, 0x01,
-	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x6f,
-	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x13, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x92, 0x41, 0x19, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x20, 0x55, 0x73, 0x65, 0x72, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xb3, 0x01, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x2d, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a,
-	0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
-	0x73, 0x65, 0x6c, 0x66, 0x92, 0x41, 0x1b, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x90, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x12, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66,
-	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x92, 0x41, 0x17, 0x0a, 0x05,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
 	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x0e, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x92, 0x41, 0x13, 0x0a,
+	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x8c, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x23,
 	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
-	0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x92, 0x41, 0x15, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x90, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x2e, 0x6f,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x18, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x10, 0x47, 0x65, 0x74, 0x20, 0x61, 0x20, 0x55, 0x73, 0x65, 0x72, 0x20, 0x62, 0x79, 0x20, 0x69,
+	0x64, 0x12, 0xad, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x92, 0x41, 0x1d, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0xa1, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x6c, 0x66, 0x92, 0x41, 0x18, 0x0a, 0x04, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x10, 0x47, 0x65, 0x74, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x20, 0x75, 0x73, 0x65, 0x72, 0x12, 0x9c, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f,
 	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x18, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x0f, 0x47, 0x65, 0x74, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x62, 0x79, 0x20,
-	0x49, 0x44, 0x12, 0xa2, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x64,
-	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x04, 0x62,
-	0x6f, 0x64, 0x79, 0x1a, 0x14, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1b, 0x0a, 0x05, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xb1, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70,
+	0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x1a, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x19, 0x0a, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x55, 0x73, 0x65, 0x72, 0x20, 0x62,
+	0x79, 0x20, 0x49, 0x44, 0x12, 0xb3, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2d, 0x2e, 0x6f, 0x64, 0x70,
 	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64,
-	0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x92, 0x41, 0x21, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x18, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0xaa, 0x01, 0x0a, 0x0c,
-	0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6f,
-	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22,
-	0x1a, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x92, 0x41, 0x1a, 0x0a, 0x05,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x11, 0x41, 0x64, 0x64, 0x20, 0x55, 0x73, 0x65, 0x72, 0x20,
-	0x74, 0x6f, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0xbc, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x6f,
-	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66,
 	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x2a,
-	0x24, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1f, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x55, 0x73, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f,
-	0x6d, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0xb6, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x6f, 0x64,
-	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x92, 0x41, 0x22, 0x0a, 0x05,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x12, 0xaf, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x12, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1b, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x6c, 0x66, 0x92, 0x41, 0x1b, 0x0a,
+	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x55, 0x73, 0x65, 0x72, 0x12, 0x90, 0x01, 0x0a, 0x0a, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66,
+	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x92, 0x41, 0x17, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x47,
+	0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x97, 0x01,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x27, 0x2e,
 	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x23, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x73, 0x92, 0x41, 0x1b, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12,
-	0x41, 0x64, 0x64, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0xc1, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x2a, 0x25, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x7d, 0x92, 0x41, 0x20, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x17, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d,
-	0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x8a, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x64,
-	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x92, 0x41, 0x15, 0x0a, 0x04,
-	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0d, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
-	0x6c, 0x65, 0x12, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x64, 0x70,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x0f,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x92,
+	0x41, 0x15, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x90, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x64, 0x70,
 	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x04, 0x62, 0x6f, 0x64,
-	0x79, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65,
-	0x73, 0x92, 0x41, 0x13, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x20, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52,
-	0x6f, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
-	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x16, 0x0a, 0x04,
-	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x47, 0x65, 0x74, 0x20, 0x52, 0x6f, 0x6c, 0x65, 0x20, 0x62,
-	0x79, 0x20, 0x49, 0x44, 0x12, 0x9c, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92,
+	0x41, 0x18, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x47, 0x65, 0x74, 0x20, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xa2, 0x01, 0x0a, 0x0b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x27, 0x2e, 0x6f, 0x64, 0x70,
+	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
 	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x64,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x14, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x92, 0x41, 0x1b, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12,
+	0xb1, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x92,
+	0x41, 0x21, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x47, 0x65, 0x74, 0x20, 0x61,
+	0x6c, 0x6c, 0x20, 0x55, 0x73, 0x65, 0x72, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0xaa, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x22, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x92, 0x41, 0x1a, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x11, 0x41,
+	0x64, 0x64, 0x20, 0x55, 0x73, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0xbc, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x2a, 0x24, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1f,
+	0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20,
+	0x55, 0x73, 0x65, 0x72, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0xb6, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x73, 0x92, 0x41, 0x22, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19, 0x47,
+	0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0xaf, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x64,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x29, 0x2e, 0x6f, 0x64, 0x70,
+	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22,
+	0x1b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x92, 0x41, 0x1b, 0x0a,
+	0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x41, 0x64, 0x64, 0x20, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x20, 0x74, 0x6f, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0xc1, 0x01, 0x0a, 0x10, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
+	0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x27, 0x2a, 0x25, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x20, 0x0a, 0x05,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x8a,
+	0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x6f,
+	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x92, 0x41, 0x15, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0d, 0x47, 0x65,
+	0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x6f, 0x64, 0x70,
+	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x92, 0x41, 0x13, 0x0a, 0x04, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x8a, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x6f, 0x64,
 	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x1a, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x6c,
-	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x19, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65,
-	0x12, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x52, 0x6f, 0x6c, 0x65, 0x20, 0x62, 0x79,
-	0x20, 0x49, 0x44, 0x12, 0xb9, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x64, 0x70, 0x66,
-	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x92, 0x41, 0x16, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x47, 0x65,
+	0x74, 0x20, 0x52, 0x6f, 0x6c, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0x9c, 0x01, 0x0a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x6f, 0x64,
+	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x13, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92,
+	0x41, 0x19, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x20, 0x52, 0x6f, 0x6c, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xb9, 0x01, 0x0a, 0x11,
 	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
-	0x12, 0x16, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x24, 0x0a, 0x0c, 0x4f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x47, 0x65, 0x74, 0x20, 0x61,
-	0x6c, 0x6c, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0xc1, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a,
-	0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x23,
-	0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0xba, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x26, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x47, 0x65, 0x74, 0x20, 0x4f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44,
-	0x12, 0xcc, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
-	0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x1b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x92, 0x41, 0x29, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x4f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12,
-	0xe1, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x32, 0x2e, 0x6f, 0x64, 0x70,
-	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x92, 0x41, 0x24, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xc1, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e,
 	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x5e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x92,
-	0x41, 0x31, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x21, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73,
-	0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0xd9, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x30, 0x2e, 0x6f,
-	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
 	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x5c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22,
-	0x22, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x73, 0x92, 0x41, 0x29, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x41, 0x64, 0x64, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20,
-	0x74, 0x6f, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0xeb, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x33, 0x2e, 0x6f, 0x64,
-	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x34, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x2a, 0x2c,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x16, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x23, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xba, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f,
+	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92,
+	0x41, 0x26, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x47, 0x65, 0x74, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xcc, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x55, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x1b,
 	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x2e, 0x0a,
-	0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d,
-	0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x9b, 0x01,
-	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x28,
-	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x92, 0x41,
-	0x1a, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0f, 0x47, 0x65, 0x74, 0x20,
-	0x61, 0x6c, 0x6c, 0x20, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0xa3, 0x01, 0x0a, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x2e,
-	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x92, 0x41, 0x19, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x9c, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64,
-	0x7d, 0x92, 0x41, 0x1c, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x11, 0x47,
-	0x65, 0x74, 0x20, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44,
-	0x12, 0xae, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
-	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1e, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x16, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92,
-	0x41, 0x1f, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x20, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x62, 0x79, 0x20, 0x49,
-	0x44, 0x12, 0xc2, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x29, 0x0a, 0x0c,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xe1, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x73, 0x12, 0x32, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x92, 0x41, 0x31, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c,
+	0x6c, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xd9, 0x01, 0x0a, 0x14,
+	0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x30, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2a, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x92, 0x41, 0x29, 0x0a, 0x0c,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x41, 0x64,
+	0x64, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xeb, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x33, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x2a, 0x2c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x2e, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x9b, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
 	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d,
-	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x92, 0x41, 0x26,
-	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x47, 0x65, 0x74, 0x20, 0x61,
-	0x6c, 0x6c, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0xbb, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2b, 0x2e, 0x6f, 0x64, 0x70,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x92, 0x41, 0x1a, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x0f, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0xa3, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x92, 0x41, 0x19,
+	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x20, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x9c, 0x01, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1c, 0x0a, 0x07, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x11, 0x47, 0x65, 0x74, 0x20, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xae, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x29, 0x2e, 0x6f, 0x64, 0x70,
 	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64,
-	0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x04, 0x62,
-	0x6f, 0x64, 0x79, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x73, 0x92, 0x41, 0x1f, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14,
-	0x41, 0x64, 0x64, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0xcd, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2e, 0x2e, 0x6f, 0x64,
-	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x64,
-	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x29, 0x2a, 0x27, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x24,
-	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x96, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
-	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12,
-	0x10, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x92, 0x41, 0x19, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x47, 0x65,
-	0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x9d, 0x01,
-	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a,
+	0x16, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1f, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xc2, 0x01, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12,
+	0x2d, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
 	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x04, 0x62, 0x6f, 0x64,
-	0x79, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x92, 0x41, 0x17, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x96, 0x01,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6f, 0x64,
-	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1a, 0x0a, 0x06, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x47, 0x65, 0x74, 0x20, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xa8, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x15, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x73, 0x92, 0x41, 0x26, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x1b, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0xbb,
+	0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1d, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x92, 0x41, 0x1f, 0x0a, 0x07, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x41, 0x64, 0x64, 0x20, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x20, 0x74, 0x6f, 0x20, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0xcd, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x2a, 0x27, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x24, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x20,
+	0x66, 0x72, 0x6f, 0x6d, 0x20, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x96, 0x01, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x6f,
+	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x19, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x9d, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x18, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x17, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x96, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x64, 0x70,
+	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64,
-	0x7d, 0x92, 0x41, 0x1d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x20, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x49,
-	0x44, 0x12, 0xa8, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
-	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x92, 0x41, 0x1f, 0x0a, 0x09, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c,
-	0x6c, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0xaf, 0x01, 0x0a,
-	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x92, 0x41,
-	0x1d, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0xa8,
-	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x7d, 0x92, 0x41, 0x1a, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x47, 0x65,
+	0x74, 0x20, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xa8,
+	0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
 	0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66,
 	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x20, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x13, 0x47, 0x65, 0x74, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xba, 0x01, 0x0a, 0x0f, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2b, 0x2e,
-	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x64, 0x70,
-	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
-	0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x18, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x92, 0x41, 0x23, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0x99, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x1a, 0x15, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1d, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xa8, 0x01, 0x0a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x6f,
+	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x92, 0x41, 0x1f, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x12, 0xaf, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x92, 0x41, 0x1d, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x92, 0x41, 0x18, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x0e, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x9e, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
-	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
-	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
-	0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x92, 0x41, 0x17, 0x0a, 0x06, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x12, 0x97, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x25, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x92, 0x41, 0x1a, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x10, 0x47, 0x65, 0x74,
-	0x20, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xa9, 0x01,
-	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x28,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41,
+	0x20, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x13, 0x47, 0x65,
+	0x74, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49,
+	0x44, 0x12, 0xba, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x18,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x23, 0x0a, 0x09, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0x99,
+	0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66,
+	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x92,
+	0x41, 0x18, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0e, 0x47, 0x65, 0x74, 0x20,
+	0x61, 0x6c, 0x6c, 0x20, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x9e, 0x01, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x28, 0x2e, 0x6f, 0x64,
+	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x11,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x92, 0x41, 0x17, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x20, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x97, 0x01, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x64, 0x70, 0x66,
+	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x12, 0x16, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1a, 0x0a, 0x06, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x10, 0x47, 0x65, 0x74, 0x20, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x20,
+	0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xa9, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x16, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x92, 0x41, 0x1d, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x13, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x20, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x20, 0x62, 0x79, 0x20, 0x49,
+	0x44, 0x12, 0xa2, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
 	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x04, 0x62, 0x6f, 0x64,
-	0x79, 0x1a, 0x16, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1d, 0x0a, 0x06, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xa2, 0x01, 0x0a, 0x0d, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x6f, 0x64,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x1d, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x11, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa9, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66,
+	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x22, 0x12, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x1b, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0xa2, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x64,
 	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41,
-	0x1d, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x11, 0x47, 0x65, 0x74,
-	0x20, 0x61, 0x6c, 0x6c, 0x20, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa9,
-	0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
-	0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1a, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x1b, 0x0a,
-	0x08, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x20, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa2, 0x01, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x6f, 0x64, 0x70,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1e, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x47, 0x65, 0x74, 0x20, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xb4, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70,
 	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
-	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41,
-	0x1e, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x47, 0x65, 0x74,
-	0x20, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12,
-	0xb4, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
-	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1f, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x17, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x92, 0x41, 0x21, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xa2, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
-	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x92, 0x41, 0x1d, 0x0a, 0x08,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x11, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c,
-	0x6c, 0x20, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0xa9, 0x01, 0x0a, 0x0e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x1a, 0x17, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x21, 0x0a, 0x08, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xa2,
+	0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x64,
+	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
+	0x12, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x92, 0x41, 0x1d, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x11, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0xa9, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x12, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x92, 0x41, 0x1b, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0xa2, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x92, 0x41, 0x1e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x12, 0x47, 0x65, 0x74, 0x20, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x62,
+	0x79, 0x20, 0x49, 0x44, 0x12, 0xb4, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x17,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x21, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xc6, 0x01, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a,
 	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
 	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x64, 0x70,
 	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a,
-	0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x92, 0x41, 0x1b, 0x0a, 0x08, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0xa2, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x1e, 0x0a, 0x08,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x47, 0x65, 0x74, 0x20, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xb4, 0x01, 0x0a,
-	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x2a, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x64,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a,
+	0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x3a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x92, 0x41, 0x34,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x20, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x61, 0x6c, 0x6f, 0x6e,
+	0x67, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x73, 0x20, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xf8, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x33, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x3a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x92, 0x41, 0x4a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x3e, 0x47, 0x65, 0x74, 0x20, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x20, 0x6f,
+	0x66, 0x20, 0x61, 0x20, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x70, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x6e, 0x12,
+	0xe5, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6f, 0x64,
 	0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
-	0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x17, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92,
-	0x41, 0x21, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x20, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x62, 0x79,
-	0x20, 0x49, 0x44, 0x12, 0xe5, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x2f, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x30, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2f, 0x7b, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x3d, 0x0a, 0x05,
-	0x41, 0x75, 0x74, 0x68, 0x7a, 0x12, 0x34, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x20, 0x70, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x63, 0x74, 0x69,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x75, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f,
+	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x2f, 0x7b, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x3d, 0x0a, 0x05, 0x41, 0x75, 0x74, 0x68,
+	0x7a, 0x12, 0x34, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x20, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f,
+	0x6e, 0x20, 0x61, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x62, 0x79, 0x20,
+	0x61, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x72, 0x12, 0xf4, 0x01, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6f, 0x64, 0x70,
+	0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x3a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x92, 0x41, 0x43, 0x0a, 0x05, 0x41, 0x75, 0x74,
+	0x68, 0x7a, 0x12, 0x3a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x20, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x20, 0x6f, 0x6e, 0x20, 0x6d, 0x61, 0x6e, 0x79, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x20, 0x62, 0x79, 0x20, 0x61, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x72, 0x12, 0xff,
+	0x01, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x6f,
+	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x92, 0x41, 0x49, 0x0a, 0x05, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x12, 0x40,
+	0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x20, 0x77, 0x68, 0x79, 0x20, 0x61, 0x6e, 0x20, 0x75,
+	0x73, 0x65, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x61, 0x6e, 0x27, 0x74,
+	0x20, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x61, 0x6e, 0x20, 0x61, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x20, 0x6f, 0x6e, 0x20, 0x61, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x20, 0x62, 0x79, 0x20, 0x61, 0x6e, 0x20, 0x75, 0x73, 0x65, 0x72, 0x42, 0x6e, 0x0a, 0x1d, 0x69,
-	0x6f, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2e, 0x73, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x06, 0x53, 0x68,
-	0x69, 0x65, 0x6c, 0x64, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6f, 0x64, 0x70, 0x66, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2f, 0x73, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x92, 0x41, 0x14, 0x12, 0x0f, 0x0a, 0x06, 0x53, 0x68, 0x69, 0x65, 0x6c,
-	0x64, 0x32, 0x05, 0x30, 0x2e, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x12, 0xda, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x64, 0x70, 0x66,
+	0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x92, 0x41, 0x3d,
+	0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2b, 0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e,
+	0x20, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xce, 0x01,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x20, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x92, 0x41, 0x28, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0xc7,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x53, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x2b, 0x0a, 0x0e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x47,
+	0x65, 0x74, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x20, 0x62, 0x79, 0x20, 0x49, 0x44, 0x12, 0xe1, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x30, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x3c,
+	0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x20, 0x69, 0x74, 0x73, 0x20, 0x4b, 0x65, 0x79, 0x73, 0x12, 0xc6, 0x01, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x27, 0x2e, 0x6f,
+	0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x92, 0x41, 0x37, 0x0a, 0x0e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
+	0x47, 0x65, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x41, 0x50, 0x49, 0x20, 0x4b, 0x65, 0x79, 0x73,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0xcd, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2a, 0x3a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x92, 0x41, 0x35,
+	0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x23, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x4b, 0x65, 0x79,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0xeb, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x37, 0x3a, 0x01, 0x2a, 0x22, 0x32, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x92, 0x41, 0x45, 0x0a, 0x0e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x4b, 0x65, 0x79, 0x20,
+	0x6f, 0x66, 0x20, 0x61, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x6e, 0x65, 0x77, 0x20,
+	0x6f, 0x6e, 0x65, 0x12, 0xd0, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2d, 0x2a, 0x2b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x6b, 0x65, 0x79, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41,
+	0x35, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x23, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x20, 0x41, 0x50, 0x49, 0x20, 0x4b, 0x65,
+	0x79, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6e, 0x0a, 0x1d, 0x69, 0x6f, 0x2e, 0x6f, 0x64, 0x70,
+	0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2e, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x06, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5a,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x64, 0x70, 0x66,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2f, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2f, 0x76,
+	0x31, 0x3b, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x92,
+	0x41, 0x14, 0x12, 0x0f, 0x0a, 0x06, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x32, 0x05, 0x30, 0x2e,
+	0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8643,167 +10809,198 @@ func file_odpf_shield_v1beta1_shield_proto_rawDescGZIP() []byte {
 	return file_odpf_shield_v1beta1_shield_proto_rawDescData
 }
 
-var file_odpf_shield_v1beta1_shield_proto_msgTypes = make([]protoimpl.MessageInfo, 136)
+var file_odpf_shield_v1beta1_shield_proto_msgTypes = make([]protoimpl.MessageInfo, 167)
 var file_odpf_shield_v1beta1_shield_proto_goTypes = []interface{}{
-	(*UserRequestBody)(nil),                 // 0: odpf.shield.v1beta1.UserRequestBody
-	(*CreateUserRequest)(nil),               // 1: odpf.shield.v1beta1.CreateUserRequest
-	(*User)(nil),                            // 2: odpf.shield.v1beta1.User
-	(*CreateUserResponse)(nil),              // 3: odpf.shield.v1beta1.CreateUserResponse
-	(*GetUserResponse)(nil),                 // 4: odpf.shield.v1beta1.GetUserResponse
-	(*GetCurrentUserResponse)(nil),          // 5: odpf.shield.v1beta1.GetCurrentUserResponse
-	(*UpdateUserResponse)(nil),              // 6: odpf.shield.v1beta1.UpdateUserResponse
-	(*UpdateCurrentUserResponse)(nil),       // 7: odpf.shield.v1beta1.UpdateCurrentUserResponse
-	(*UpdateUserRequest)(nil),               // 8: odpf.shield.v1beta1.UpdateUserRequest
-	(*GetUserRequest)(nil),                  // 9: odpf.shield.v1beta1.GetUserRequest
-	(*ListUserGroupsRequest)(nil),           // 10: odpf.shield.v1beta1.ListUserGroupsRequest
-	(*GetCurrentUserRequest)(nil),           // 11: odpf.shield.v1beta1.GetCurrentUserRequest
-	(*ListUsersRequest)(nil),                // 12: odpf.shield.v1beta1.ListUsersRequest
-	(*ListUsersResponse)(nil),               // 13: odpf.shield.v1beta1.ListUsersResponse
-	(*GroupRequestBody)(nil),                // 14: odpf.shield.v1beta1.GroupRequestBody
-	(*CreateGroupRequest)(nil),              // 15: odpf.shield.v1beta1.CreateGroupRequest
-	(*ListUserGroupsResponse)(nil),          // 16: odpf.shield.v1beta1.ListUserGroupsResponse
-	(*Group)(nil),                           // 17: odpf.shield.v1beta1.Group
-	(*CreateGroupResponse)(nil),             // 18: odpf.shield.v1beta1.CreateGroupResponse
-	(*GetGroupResponse)(nil),                // 19: odpf.shield.v1beta1.GetGroupResponse
-	(*UpdateGroupResponse)(nil),             // 20: odpf.shield.v1beta1.UpdateGroupResponse
-	(*UpdateGroupRequest)(nil),              // 21: odpf.shield.v1beta1.UpdateGroupRequest
-	(*UpdateCurrentUserRequest)(nil),        // 22: odpf.shield.v1beta1.UpdateCurrentUserRequest
-	(*GetGroupRequest)(nil),                 // 23: odpf.shield.v1beta1.GetGroupRequest
-	(*ListGroupsRequest)(nil),               // 24: odpf.shield.v1beta1.ListGroupsRequest
-	(*ListGroupsResponse)(nil),              // 25: odpf.shield.v1beta1.ListGroupsResponse
-	(*ListGroupUsersRequest)(nil),           // 26: odpf.shield.v1beta1.ListGroupUsersRequest
-	(*ListGroupUsersResponse)(nil),          // 27: odpf.shield.v1beta1.ListGroupUsersResponse
-	(*AddGroupUserRequestBody)(nil),         // 28: odpf.shield.v1beta1.AddGroupUserRequestBody
-	(*AddGroupUserRequest)(nil),             // 29: odpf.shield.v1beta1.AddGroupUserRequest
-	(*AddGroupUserResponse)(nil),            // 30: odpf.shield.v1beta1.AddGroupUserResponse
-	(*RemoveGroupUserRequest)(nil),          // 31: odpf.shield.v1beta1.RemoveGroupUserRequest
-	(*RemoveGroupUserResponse)(nil),         // 32: odpf.shield.v1beta1.RemoveGroupUserResponse
-	(*ListGroupAdminsRequest)(nil),          // 33: odpf.shield.v1beta1.ListGroupAdminsRequest
-	(*ListGroupAdminsResponse)(nil),         // 34: odpf.shield.v1beta1.ListGroupAdminsResponse
-	(*AddGroupAdminRequestBody)(nil),        // 35: odpf.shield.v1beta1.AddGroupAdminRequestBody
-	(*AddGroupAdminRequest)(nil),            // 36: odpf.shield.v1beta1.AddGroupAdminRequest
-	(*AddGroupAdminResponse)(nil),           // 37: odpf.shield.v1beta1.AddGroupAdminResponse
-	(*RemoveGroupAdminRequest)(nil),         // 38: odpf.shield.v1beta1.RemoveGroupAdminRequest
-	(*RemoveGroupAdminResponse)(nil),        // 39: odpf.shield.v1beta1.RemoveGroupAdminResponse
-	(*Role)(nil),                            // 40: odpf.shield.v1beta1.Role
-	(*RoleRequestBody)(nil),                 // 41: odpf.shield.v1beta1.RoleRequestBody
-	(*CreateRoleRequest)(nil),               // 42: odpf.shield.v1beta1.CreateRoleRequest
-	(*CreateRoleResponse)(nil),              // 43: odpf.shield.v1beta1.CreateRoleResponse
-	(*GetRoleResponse)(nil),                 // 44: odpf.shield.v1beta1.GetRoleResponse
-	(*UpdateRoleResponse)(nil),              // 45: odpf.shield.v1beta1.UpdateRoleResponse
-	(*GetRoleRequest)(nil),                  // 46: odpf.shield.v1beta1.GetRoleRequest
-	(*UpdateRoleRequest)(nil),               // 47: odpf.shield.v1beta1.UpdateRoleRequest
-	(*ListRolesRequest)(nil),                // 48: odpf.shield.v1beta1.ListRolesRequest
-	(*ListRolesResponse)(nil),               // 49: odpf.shield.v1beta1.ListRolesResponse
-	(*OrganizationRequestBody)(nil),         // 50: odpf.shield.v1beta1.OrganizationRequestBody
-	(*CreateOrganizationRequest)(nil),       // 51: odpf.shield.v1beta1.CreateOrganizationRequest
-	(*Organization)(nil),                    // 52: odpf.shield.v1beta1.Organization
-	(*CreateOrganizationResponse)(nil),      // 53: odpf.shield.v1beta1.CreateOrganizationResponse
-	(*GetOrganizationResponse)(nil),         // 54: odpf.shield.v1beta1.GetOrganizationResponse
-	(*UpdateOrganizationResponse)(nil),      // 55: odpf.shield.v1beta1.UpdateOrganizationResponse
-	(*ListOrganizationsRequest)(nil),        // 56: odpf.shield.v1beta1.ListOrganizationsRequest
-	(*ListOrganizationsResponse)(nil),       // 57: odpf.shield.v1beta1.ListOrganizationsResponse
-	(*GetOrganizationRequest)(nil),          // 58: odpf.shield.v1beta1.GetOrganizationRequest
-	(*UpdateOrganizationRequest)(nil),       // 59: odpf.shield.v1beta1.UpdateOrganizationRequest
-	(*ListOrganizationAdminsRequest)(nil),   // 60: odpf.shield.v1beta1.ListOrganizationAdminsRequest
-	(*ListOrganizationAdminsResponse)(nil),  // 61: odpf.shield.v1beta1.ListOrganizationAdminsResponse
-	(*AddOrganizationAdminRequestBody)(nil), // 62: odpf.shield.v1beta1.AddOrganizationAdminRequestBody
-	(*AddOrganizationAdminRequest)(nil),     // 63: odpf.shield.v1beta1.AddOrganizationAdminRequest
-	(*AddOrganizationAdminResponse)(nil),    // 64: odpf.shield.v1beta1.AddOrganizationAdminResponse
-	(*RemoveOrganizationAdminRequest)(nil),  // 65: odpf.shield.v1beta1.RemoveOrganizationAdminRequest
-	(*RemoveOrganizationAdminResponse)(nil), // 66: odpf.shield.v1beta1.RemoveOrganizationAdminResponse
-	(*ProjectRequestBody)(nil),              // 67: odpf.shield.v1beta1.ProjectRequestBody
-	(*CreateProjectRequest)(nil),            // 68: odpf.shield.v1beta1.CreateProjectRequest
-	(*Project)(nil),                         // 69: odpf.shield.v1beta1.Project
-	(*CreateProjectResponse)(nil),           // 70: odpf.shield.v1beta1.CreateProjectResponse
-	(*GetProjectResponse)(nil),              // 71: odpf.shield.v1beta1.GetProjectResponse
-	(*UpdateProjectResponse)(nil),           // 72: odpf.shield.v1beta1.UpdateProjectResponse
-	(*ListProjectsRequest)(nil),             // 73: odpf.shield.v1beta1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),            // 74: odpf.shield.v1beta1.ListProjectsResponse
-	(*GetProjectRequest)(nil),               // 75: odpf.shield.v1beta1.GetProjectRequest
-	(*UpdateProjectRequest)(nil),            // 76: odpf.shield.v1beta1.UpdateProjectRequest
-	(*ListProjectAdminsRequest)(nil),        // 77: odpf.shield.v1beta1.ListProjectAdminsRequest
-	(*ListProjectAdminsResponse)(nil),       // 78: odpf.shield.v1beta1.ListProjectAdminsResponse
-	(*AddProjectAdminRequestBody)(nil),      // 79: odpf.shield.v1beta1.AddProjectAdminRequestBody
-	(*AddProjectAdminRequest)(nil),          // 80: odpf.shield.v1beta1.AddProjectAdminRequest
-	(*AddProjectAdminResponse)(nil),         // 81: odpf.shield.v1beta1.AddProjectAdminResponse
-	(*RemoveProjectAdminRequest)(nil),       // 82: odpf.shield.v1beta1.RemoveProjectAdminRequest
-	(*RemoveProjectAdminResponse)(nil),      // 83: odpf.shield.v1beta1.RemoveProjectAdminResponse
-	(*Action)(nil),                          // 84: odpf.shield.v1beta1.Action
-	(*Namespace)(nil),                       // 85: odpf.shield.v1beta1.Namespace
-	(*Policy)(nil),                          // 86: odpf.shield.v1beta1.Policy
-	(*ActionRequestBody)(nil),               // 87: odpf.shield.v1beta1.ActionRequestBody
-	(*NamespaceRequestBody)(nil),            // 88: odpf.shield.v1beta1.NamespaceRequestBody
-	(*PolicyRequestBody)(nil),               // 89: odpf.shield.v1beta1.PolicyRequestBody
-	(*ListActionsRequest)(nil),              // 90: odpf.shield.v1beta1.ListActionsRequest
-	(*ListActionsResponse)(nil),             // 91: odpf.shield.v1beta1.ListActionsResponse
-	(*CreateActionRequest)(nil),             // 92: odpf.shield.v1beta1.CreateActionRequest
-	(*CreateActionResponse)(nil),            // 93: odpf.shield.v1beta1.CreateActionResponse
-	(*GetActionRequest)(nil),                // 94: odpf.shield.v1beta1.GetActionRequest
-	(*GetActionResponse)(nil),               // 95: odpf.shield.v1beta1.GetActionResponse
-	(*UpdateActionRequest)(nil),             // 96: odpf.shield.v1beta1.UpdateActionRequest
-	(*UpdateActionResponse)(nil),            // 97: odpf.shield.v1beta1.UpdateActionResponse
-	(*ListNamespacesRequest)(nil),           // 98: odpf.shield.v1beta1.ListNamespacesRequest
-	(*ListNamespacesResponse)(nil),          // 99: odpf.shield.v1beta1.ListNamespacesResponse
-	(*CreateNamespaceRequest)(nil),          // 100: odpf.shield.v1beta1.CreateNamespaceRequest
-	(*CreateNamespaceResponse)(nil),         // 101: odpf.shield.v1beta1.CreateNamespaceResponse
-	(*GetNamespaceRequest)(nil),             // 102: odpf.shield.v1beta1.GetNamespaceRequest
-	(*GetNamespaceResponse)(nil),            // 103: odpf.shield.v1beta1.GetNamespaceResponse
-	(*UpdateNamespaceRequest)(nil),          // 104: odpf.shield.v1beta1.UpdateNamespaceRequest
-	(*UpdateNamespaceResponse)(nil),         // 105: odpf.shield.v1beta1.UpdateNamespaceResponse
-	(*ListPoliciesRequest)(nil),             // 106: odpf.shield.v1beta1.ListPoliciesRequest
-	(*ListPoliciesResponse)(nil),            // 107: odpf.shield.v1beta1.ListPoliciesResponse
-	(*CreatePolicyRequest)(nil),             // 108: odpf.shield.v1beta1.CreatePolicyRequest
-	(*CreatePolicyResponse)(nil),            // 109: odpf.shield.v1beta1.CreatePolicyResponse
-	(*GetPolicyRequest)(nil),                // 110: odpf.shield.v1beta1.GetPolicyRequest
-	(*GetPolicyResponse)(nil),               // 111: odpf.shield.v1beta1.GetPolicyResponse
-	(*UpdatePolicyRequest)(nil),             // 112: odpf.shield.v1beta1.UpdatePolicyRequest
-	(*UpdatePolicyResponse)(nil),            // 113: odpf.shield.v1beta1.UpdatePolicyResponse
-	(*Relation)(nil),                        // 114: odpf.shield.v1beta1.Relation
-	(*Resource)(nil),                        // 115: odpf.shield.v1beta1.Resource
-	(*ListRelationsRequest)(nil),            // 116: odpf.shield.v1beta1.ListRelationsRequest
-	(*ListRelationsResponse)(nil),           // 117: odpf.shield.v1beta1.ListRelationsResponse
-	(*RelationRequestBody)(nil),             // 118: odpf.shield.v1beta1.RelationRequestBody
-	(*CreateRelationRequest)(nil),           // 119: odpf.shield.v1beta1.CreateRelationRequest
-	(*CreateRelationResponse)(nil),          // 120: odpf.shield.v1beta1.CreateRelationResponse
-	(*GetRelationRequest)(nil),              // 121: odpf.shield.v1beta1.GetRelationRequest
-	(*GetRelationResponse)(nil),             // 122: odpf.shield.v1beta1.GetRelationResponse
-	(*UpdateRelationRequest)(nil),           // 123: odpf.shield.v1beta1.UpdateRelationRequest
-	(*UpdateRelationResponse)(nil),          // 124: odpf.shield.v1beta1.UpdateRelationResponse
-	(*ListResourcesRequest)(nil),            // 125: odpf.shield.v1beta1.ListResourcesRequest
-	(*ListResourcesResponse)(nil),           // 126: odpf.shield.v1beta1.ListResourcesResponse
-	(*ResourceRequestBody)(nil),             // 127: odpf.shield.v1beta1.ResourceRequestBody
-	(*CreateResourceRequest)(nil),           // 128: odpf.shield.v1beta1.CreateResourceRequest
-	(*CreateResourceResponse)(nil),          // 129: odpf.shield.v1beta1.CreateResourceResponse
-	(*GetResourceRequest)(nil),              // 130: odpf.shield.v1beta1.GetResourceRequest
-	(*GetResourceResponse)(nil),             // 131: odpf.shield.v1beta1.GetResourceResponse
-	(*UpdateResourceRequest)(nil),           // 132: odpf.shield.v1beta1.UpdateResourceRequest
-	(*UpdateResourceResponse)(nil),          // 133: odpf.shield.v1beta1.UpdateResourceResponse
-	(*ResourceActionAuthzRequest)(nil),      // 134: odpf.shield.v1beta1.ResourceActionAuthzRequest
-	(*ResourceActionAuthzResponse)(nil),     // 135: odpf.shield.v1beta1.ResourceActionAuthzResponse
-	(*structpb.Struct)(nil),                 // 136: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),           // 137: google.protobuf.Timestamp
+	(*UserRequestBody)(nil),                   // 0: odpf.shield.v1beta1.UserRequestBody
+	(*CreateUserRequest)(nil),                 // 1: odpf.shield.v1beta1.CreateUserRequest
+	(*User)(nil),                              // 2: odpf.shield.v1beta1.User
+	(*CreateUserResponse)(nil),                // 3: odpf.shield.v1beta1.CreateUserResponse
+	(*GetUserResponse)(nil),                   // 4: odpf.shield.v1beta1.GetUserResponse
+	(*GetCurrentUserResponse)(nil),            // 5: odpf.shield.v1beta1.GetCurrentUserResponse
+	(*UpdateUserResponse)(nil),                // 6: odpf.shield.v1beta1.UpdateUserResponse
+	(*UpdateCurrentUserResponse)(nil),         // 7: odpf.shield.v1beta1.UpdateCurrentUserResponse
+	(*UpdateUserRequest)(nil),                 // 8: odpf.shield.v1beta1.UpdateUserRequest
+	(*GetUserRequest)(nil),                    // 9: odpf.shield.v1beta1.GetUserRequest
+	(*ListUserGroupsRequest)(nil),             // 10: odpf.shield.v1beta1.ListUserGroupsRequest
+	(*GetCurrentUserRequest)(nil),             // 11: odpf.shield.v1beta1.GetCurrentUserRequest
+	(*ListUsersRequest)(nil),                  // 12: odpf.shield.v1beta1.ListUsersRequest
+	(*ListUsersResponse)(nil),                 // 13: odpf.shield.v1beta1.ListUsersResponse
+	(*GroupRequestBody)(nil),                  // 14: odpf.shield.v1beta1.GroupRequestBody
+	(*CreateGroupRequest)(nil),                // 15: odpf.shield.v1beta1.CreateGroupRequest
+	(*ListUserGroupsResponse)(nil),            // 16: odpf.shield.v1beta1.ListUserGroupsResponse
+	(*Group)(nil),                             // 17: odpf.shield.v1beta1.Group
+	(*CreateGroupResponse)(nil),               // 18: odpf.shield.v1beta1.CreateGroupResponse
+	(*GetGroupResponse)(nil),                  // 19: odpf.shield.v1beta1.GetGroupResponse
+	(*UpdateGroupResponse)(nil),               // 20: odpf.shield.v1beta1.UpdateGroupResponse
+	(*UpdateGroupRequest)(nil),                // 21: odpf.shield.v1beta1.UpdateGroupRequest
+	(*UpdateCurrentUserRequest)(nil),          // 22: odpf.shield.v1beta1.UpdateCurrentUserRequest
+	(*GetGroupRequest)(nil),                   // 23: odpf.shield.v1beta1.GetGroupRequest
+	(*ListGroupsRequest)(nil),                 // 24: odpf.shield.v1beta1.ListGroupsRequest
+	(*ListGroupsResponse)(nil),                // 25: odpf.shield.v1beta1.ListGroupsResponse
+	(*ListGroupUsersRequest)(nil),             // 26: odpf.shield.v1beta1.ListGroupUsersRequest
+	(*ListGroupUsersResponse)(nil),            // 27: odpf.shield.v1beta1.ListGroupUsersResponse
+	(*AddGroupUserRequestBody)(nil),           // 28: odpf.shield.v1beta1.AddGroupUserRequestBody
+	(*AddGroupUserRequest)(nil),               // 29: odpf.shield.v1beta1.AddGroupUserRequest
+	(*AddGroupUserResponse)(nil),              // 30: odpf.shield.v1beta1.AddGroupUserResponse
+	(*RemoveGroupUserRequest)(nil),            // 31: odpf.shield.v1beta1.RemoveGroupUserRequest
+	(*RemoveGroupUserResponse)(nil),           // 32: odpf.shield.v1beta1.RemoveGroupUserResponse
+	(*ListGroupAdminsRequest)(nil),            // 33: odpf.shield.v1beta1.ListGroupAdminsRequest
+	(*ListGroupAdminsResponse)(nil),           // 34: odpf.shield.v1beta1.ListGroupAdminsResponse
+	(*AddGroupAdminRequestBody)(nil),          // 35: odpf.shield.v1beta1.AddGroupAdminRequestBody
+	(*AddGroupAdminRequest)(nil),              // 36: odpf.shield.v1beta1.AddGroupAdminRequest
+	(*AddGroupAdminResponse)(nil),             // 37: odpf.shield.v1beta1.AddGroupAdminResponse
+	(*RemoveGroupAdminRequest)(nil),           // 38: odpf.shield.v1beta1.RemoveGroupAdminRequest
+	(*RemoveGroupAdminResponse)(nil),          // 39: odpf.shield.v1beta1.RemoveGroupAdminResponse
+	(*Role)(nil),                              // 40: odpf.shield.v1beta1.Role
+	(*RoleRequestBody)(nil),                   // 41: odpf.shield.v1beta1.RoleRequestBody
+	(*CreateRoleRequest)(nil),                 // 42: odpf.shield.v1beta1.CreateRoleRequest
+	(*CreateRoleResponse)(nil),                // 43: odpf.shield.v1beta1.CreateRoleResponse
+	(*GetRoleResponse)(nil),                   // 44: odpf.shield.v1beta1.GetRoleResponse
+	(*UpdateRoleResponse)(nil),                // 45: odpf.shield.v1beta1.UpdateRoleResponse
+	(*GetRoleRequest)(nil),                    // 46: odpf.shield.v1beta1.GetRoleRequest
+	(*UpdateRoleRequest)(nil),                 // 47: odpf.shield.v1beta1.UpdateRoleRequest
+	(*ListRolesRequest)(nil),                  // 48: odpf.shield.v1beta1.ListRolesRequest
+	(*ListRolesResponse)(nil),                 // 49: odpf.shield.v1beta1.ListRolesResponse
+	(*OrganizationRequestBody)(nil),           // 50: odpf.shield.v1beta1.OrganizationRequestBody
+	(*CreateOrganizationRequest)(nil),         // 51: odpf.shield.v1beta1.CreateOrganizationRequest
+	(*Organization)(nil),                      // 52: odpf.shield.v1beta1.Organization
+	(*CreateOrganizationResponse)(nil),        // 53: odpf.shield.v1beta1.CreateOrganizationResponse
+	(*GetOrganizationResponse)(nil),           // 54: odpf.shield.v1beta1.GetOrganizationResponse
+	(*UpdateOrganizationResponse)(nil),        // 55: odpf.shield.v1beta1.UpdateOrganizationResponse
+	(*ListOrganizationsRequest)(nil),          // 56: odpf.shield.v1beta1.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),         // 57: odpf.shield.v1beta1.ListOrganizationsResponse
+	(*GetOrganizationRequest)(nil),            // 58: odpf.shield.v1beta1.GetOrganizationRequest
+	(*UpdateOrganizationRequest)(nil),         // 59: odpf.shield.v1beta1.UpdateOrganizationRequest
+	(*ListOrganizationAdminsRequest)(nil),     // 60: odpf.shield.v1beta1.ListOrganizationAdminsRequest
+	(*ListOrganizationAdminsResponse)(nil),    // 61: odpf.shield.v1beta1.ListOrganizationAdminsResponse
+	(*AddOrganizationAdminRequestBody)(nil),   // 62: odpf.shield.v1beta1.AddOrganizationAdminRequestBody
+	(*AddOrganizationAdminRequest)(nil),       // 63: odpf.shield.v1beta1.AddOrganizationAdminRequest
+	(*AddOrganizationAdminResponse)(nil),      // 64: odpf.shield.v1beta1.AddOrganizationAdminResponse
+	(*RemoveOrganizationAdminRequest)(nil),    // 65: odpf.shield.v1beta1.RemoveOrganizationAdminRequest
+	(*RemoveOrganizationAdminResponse)(nil),   // 66: odpf.shield.v1beta1.RemoveOrganizationAdminResponse
+	(*ProjectRequestBody)(nil),                // 67: odpf.shield.v1beta1.ProjectRequestBody
+	(*CreateProjectRequest)(nil),              // 68: odpf.shield.v1beta1.CreateProjectRequest
+	(*Project)(nil),                           // 69: odpf.shield.v1beta1.Project
+	(*CreateProjectResponse)(nil),             // 70: odpf.shield.v1beta1.CreateProjectResponse
+	(*GetProjectResponse)(nil),                // 71: odpf.shield.v1beta1.GetProjectResponse
+	(*UpdateProjectResponse)(nil),             // 72: odpf.shield.v1beta1.UpdateProjectResponse
+	(*ListProjectsRequest)(nil),               // 73: odpf.shield.v1beta1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),              // 74: odpf.shield.v1beta1.ListProjectsResponse
+	(*GetProjectRequest)(nil),                 // 75: odpf.shield.v1beta1.GetProjectRequest
+	(*UpdateProjectRequest)(nil),              // 76: odpf.shield.v1beta1.UpdateProjectRequest
+	(*ListProjectAdminsRequest)(nil),          // 77: odpf.shield.v1beta1.ListProjectAdminsRequest
+	(*ListProjectAdminsResponse)(nil),         // 78: odpf.shield.v1beta1.ListProjectAdminsResponse
+	(*AddProjectAdminRequestBody)(nil),        // 79: odpf.shield.v1beta1.AddProjectAdminRequestBody
+	(*AddProjectAdminRequest)(nil),            // 80: odpf.shield.v1beta1.AddProjectAdminRequest
+	(*AddProjectAdminResponse)(nil),           // 81: odpf.shield.v1beta1.AddProjectAdminResponse
+	(*RemoveProjectAdminRequest)(nil),         // 82: odpf.shield.v1beta1.RemoveProjectAdminRequest
+	(*RemoveProjectAdminResponse)(nil),        // 83: odpf.shield.v1beta1.RemoveProjectAdminResponse
+	(*Action)(nil),                            // 84: odpf.shield.v1beta1.Action
+	(*Namespace)(nil),                         // 85: odpf.shield.v1beta1.Namespace
+	(*Policy)(nil),                            // 86: odpf.shield.v1beta1.Policy
+	(*ActionRequestBody)(nil),                 // 87: odpf.shield.v1beta1.ActionRequestBody
+	(*NamespaceRequestBody)(nil),              // 88: odpf.shield.v1beta1.NamespaceRequestBody
+	(*PolicyRequestBody)(nil),                 // 89: odpf.shield.v1beta1.PolicyRequestBody
+	(*ListActionsRequest)(nil),                // 90: odpf.shield.v1beta1.ListActionsRequest
+	(*ListActionsResponse)(nil),               // 91: odpf.shield.v1beta1.ListActionsResponse
+	(*CreateActionRequest)(nil),               // 92: odpf.shield.v1beta1.CreateActionRequest
+	(*CreateActionResponse)(nil),              // 93: odpf.shield.v1beta1.CreateActionResponse
+	(*GetActionRequest)(nil),                  // 94: odpf.shield.v1beta1.GetActionRequest
+	(*GetActionResponse)(nil),                 // 95: odpf.shield.v1beta1.GetActionResponse
+	(*UpdateActionRequest)(nil),               // 96: odpf.shield.v1beta1.UpdateActionRequest
+	(*UpdateActionResponse)(nil),              // 97: odpf.shield.v1beta1.UpdateActionResponse
+	(*ListNamespacesRequest)(nil),             // 98: odpf.shield.v1beta1.ListNamespacesRequest
+	(*ListNamespacesResponse)(nil),            // 99: odpf.shield.v1beta1.ListNamespacesResponse
+	(*CreateNamespaceRequest)(nil),            // 100: odpf.shield.v1beta1.CreateNamespaceRequest
+	(*CreateNamespaceResponse)(nil),           // 101: odpf.shield.v1beta1.CreateNamespaceResponse
+	(*GetNamespaceRequest)(nil),               // 102: odpf.shield.v1beta1.GetNamespaceRequest
+	(*GetNamespaceResponse)(nil),              // 103: odpf.shield.v1beta1.GetNamespaceResponse
+	(*UpdateNamespaceRequest)(nil),            // 104: odpf.shield.v1beta1.UpdateNamespaceRequest
+	(*UpdateNamespaceResponse)(nil),           // 105: odpf.shield.v1beta1.UpdateNamespaceResponse
+	(*ListPoliciesRequest)(nil),               // 106: odpf.shield.v1beta1.ListPoliciesRequest
+	(*ListPoliciesResponse)(nil),              // 107: odpf.shield.v1beta1.ListPoliciesResponse
+	(*CreatePolicyRequest)(nil),               // 108: odpf.shield.v1beta1.CreatePolicyRequest
+	(*CreatePolicyResponse)(nil),              // 109: odpf.shield.v1beta1.CreatePolicyResponse
+	(*GetPolicyRequest)(nil),                  // 110: odpf.shield.v1beta1.GetPolicyRequest
+	(*GetPolicyResponse)(nil),                 // 111: odpf.shield.v1beta1.GetPolicyResponse
+	(*UpdatePolicyRequest)(nil),               // 112: odpf.shield.v1beta1.UpdatePolicyRequest
+	(*UpdatePolicyResponse)(nil),              // 113: odpf.shield.v1beta1.UpdatePolicyResponse
+	(*Relation)(nil),                          // 114: odpf.shield.v1beta1.Relation
+	(*Resource)(nil),                          // 115: odpf.shield.v1beta1.Resource
+	(*ListRelationsRequest)(nil),              // 116: odpf.shield.v1beta1.ListRelationsRequest
+	(*ListRelationsResponse)(nil),             // 117: odpf.shield.v1beta1.ListRelationsResponse
+	(*RelationRequestBody)(nil),               // 118: odpf.shield.v1beta1.RelationRequestBody
+	(*CreateRelationRequest)(nil),             // 119: odpf.shield.v1beta1.CreateRelationRequest
+	(*CreateRelationResponse)(nil),            // 120: odpf.shield.v1beta1.CreateRelationResponse
+	(*GetRelationRequest)(nil),                // 121: odpf.shield.v1beta1.GetRelationRequest
+	(*GetRelationResponse)(nil),               // 122: odpf.shield.v1beta1.GetRelationResponse
+	(*UpdateRelationRequest)(nil),             // 123: odpf.shield.v1beta1.UpdateRelationRequest
+	(*UpdateRelationResponse)(nil),            // 124: odpf.shield.v1beta1.UpdateRelationResponse
+	(*ListResourcesRequest)(nil),              // 125: odpf.shield.v1beta1.ListResourcesRequest
+	(*ListResourcesResponse)(nil),             // 126: odpf.shield.v1beta1.ListResourcesResponse
+	(*ResourceRequestBody)(nil),               // 127: odpf.shield.v1beta1.ResourceRequestBody
+	(*CreateResourceRequest)(nil),             // 128: odpf.shield.v1beta1.CreateResourceRequest
+	(*CreateResourceResponse)(nil),            // 129: odpf.shield.v1beta1.CreateResourceResponse
+	(*GetResourceRequest)(nil),                // 130: odpf.shield.v1beta1.GetResourceRequest
+	(*GetResourceResponse)(nil),               // 131: odpf.shield.v1beta1.GetResourceResponse
+	(*UpdateResourceRequest)(nil),             // 132: odpf.shield.v1beta1.UpdateResourceRequest
+	(*UpdateResourceResponse)(nil),            // 133: odpf.shield.v1beta1.UpdateResourceResponse
+	(*DeleteResourceRequest)(nil),             // 134: odpf.shield.v1beta1.DeleteResourceRequest
+	(*DeleteResourceResponse)(nil),            // 135: odpf.shield.v1beta1.DeleteResourceResponse
+	(*ListAuthorizedResourcesRequest)(nil),    // 136: odpf.shield.v1beta1.ListAuthorizedResourcesRequest
+	(*ListAuthorizedResourcesResponse)(nil),   // 137: odpf.shield.v1beta1.ListAuthorizedResourcesResponse
+	(*ResourceActionAuthzRequest)(nil),        // 138: odpf.shield.v1beta1.ResourceActionAuthzRequest
+	(*ResourceActionAuthzResponse)(nil),       // 139: odpf.shield.v1beta1.ResourceActionAuthzResponse
+	(*PermissionCheck)(nil),                   // 140: odpf.shield.v1beta1.PermissionCheck
+	(*PermissionCheckResult)(nil),             // 141: odpf.shield.v1beta1.PermissionCheckResult
+	(*CheckResourcePermissionsRequest)(nil),   // 142: odpf.shield.v1beta1.CheckResourcePermissionsRequest
+	(*CheckResourcePermissionsResponse)(nil),  // 143: odpf.shield.v1beta1.CheckResourcePermissionsResponse
+	(*PermissionExplanation)(nil),             // 144: odpf.shield.v1beta1.PermissionExplanation
+	(*ExplainResourcePermissionRequest)(nil),  // 145: odpf.shield.v1beta1.ExplainResourcePermissionRequest
+	(*ExplainResourcePermissionResponse)(nil), // 146: odpf.shield.v1beta1.ExplainResourcePermissionResponse
+	(*ServiceAccountRequestBody)(nil),         // 147: odpf.shield.v1beta1.ServiceAccountRequestBody
+	(*ServiceAccount)(nil),                    // 148: odpf.shield.v1beta1.ServiceAccount
+	(*CreateServiceAccountRequest)(nil),       // 149: odpf.shield.v1beta1.CreateServiceAccountRequest
+	(*CreateServiceAccountResponse)(nil),      // 150: odpf.shield.v1beta1.CreateServiceAccountResponse
+	(*ListServiceAccountsRequest)(nil),        // 151: odpf.shield.v1beta1.ListServiceAccountsRequest
+	(*ListServiceAccountsResponse)(nil),       // 152: odpf.shield.v1beta1.ListServiceAccountsResponse
+	(*GetServiceAccountRequest)(nil),          // 153: odpf.shield.v1beta1.GetServiceAccountRequest
+	(*GetServiceAccountResponse)(nil),         // 154: odpf.shield.v1beta1.GetServiceAccountResponse
+	(*DeleteServiceAccountRequest)(nil),       // 155: odpf.shield.v1beta1.DeleteServiceAccountRequest
+	(*DeleteServiceAccountResponse)(nil),      // 156: odpf.shield.v1beta1.DeleteServiceAccountResponse
+	(*APIKey)(nil),                            // 157: odpf.shield.v1beta1.APIKey
+	(*APIKeyRequestBody)(nil),                 // 158: odpf.shield.v1beta1.APIKeyRequestBody
+	(*CreateAPIKeyRequest)(nil),               // 159: odpf.shield.v1beta1.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),              // 160: odpf.shield.v1beta1.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                // 161: odpf.shield.v1beta1.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),               // 162: odpf.shield.v1beta1.ListAPIKeysResponse
+	(*RotateAPIKeyRequest)(nil),               // 163: odpf.shield.v1beta1.RotateAPIKeyRequest
+	(*RotateAPIKeyResponse)(nil),              // 164: odpf.shield.v1beta1.RotateAPIKeyResponse
+	(*RevokeAPIKeyRequest)(nil),               // 165: odpf.shield.v1beta1.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),              // 166: odpf.shield.v1beta1.RevokeAPIKeyResponse
+	(*structpb.Struct)(nil),                   // 167: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),             // 168: google.protobuf.Timestamp
 }
 var file_odpf_shield_v1beta1_shield_proto_depIdxs = []int32{
-	136, // 0: odpf.shield.v1beta1.UserRequestBody.metadata:type_name -> google.protobuf.Struct
+	167, // 0: odpf.shield.v1beta1.UserRequestBody.metadata:type_name -> google.protobuf.Struct
 	0,   // 1: odpf.shield.v1beta1.CreateUserRequest.body:type_name -> odpf.shield.v1beta1.UserRequestBody
-	136, // 2: odpf.shield.v1beta1.User.metadata:type_name -> google.protobuf.Struct
-	137, // 3: odpf.shield.v1beta1.User.created_at:type_name -> google.protobuf.Timestamp
-	137, // 4: odpf.shield.v1beta1.User.updated_at:type_name -> google.protobuf.Timestamp
+	167, // 2: odpf.shield.v1beta1.User.metadata:type_name -> google.protobuf.Struct
+	168, // 3: odpf.shield.v1beta1.User.created_at:type_name -> google.protobuf.Timestamp
+	168, // 4: odpf.shield.v1beta1.User.updated_at:type_name -> google.protobuf.Timestamp
 	2,   // 5: odpf.shield.v1beta1.CreateUserResponse.user:type_name -> odpf.shield.v1beta1.User
 	2,   // 6: odpf.shield.v1beta1.GetUserResponse.user:type_name -> odpf.shield.v1beta1.User
 	2,   // 7: odpf.shield.v1beta1.GetCurrentUserResponse.user:type_name -> odpf.shield.v1beta1.User
 	2,   // 8: odpf.shield.v1beta1.UpdateUserResponse.user:type_name -> odpf.shield.v1beta1.User
 	2,   // 9: odpf.shield.v1beta1.UpdateCurrentUserResponse.user:type_name -> odpf.shield.v1beta1.User
 	0,   // 10: odpf.shield.v1beta1.UpdateUserRequest.body:type_name -> odpf.shield.v1beta1.UserRequestBody
-	136, // 11: odpf.shield.v1beta1.ListUsersRequest.fields:type_name -> google.protobuf.Struct
+	167, // 11: odpf.shield.v1beta1.ListUsersRequest.fields:type_name -> google.protobuf.Struct
 	2,   // 12: odpf.shield.v1beta1.ListUsersResponse.users:type_name -> odpf.shield.v1beta1.User
-	136, // 13: odpf.shield.v1beta1.GroupRequestBody.metadata:type_name -> google.protobuf.Struct
+	167, // 13: odpf.shield.v1beta1.GroupRequestBody.metadata:type_name -> google.protobuf.Struct
 	14,  // 14: odpf.shield.v1beta1.CreateGroupRequest.body:type_name -> odpf.shield.v1beta1.GroupRequestBody
 	17,  // 15: odpf.shield.v1beta1.ListUserGroupsResponse.groups:type_name -> odpf.shield.v1beta1.Group
-	136, // 16: odpf.shield.v1beta1.Group.metadata:type_name -> google.protobuf.Struct
-	137, // 17: odpf.shield.v1beta1.Group.created_at:type_name -> google.protobuf.Timestamp
-	137, // 18: odpf.shield.v1beta1.Group.updated_at:type_name -> google.protobuf.Timestamp
+	167, // 16: odpf.shield.v1beta1.Group.metadata:type_name -> google.protobuf.Struct
+	168, // 17: odpf.shield.v1beta1.Group.created_at:type_name -> google.protobuf.Timestamp
+	168, // 18: odpf.shield.v1beta1.Group.updated_at:type_name -> google.protobuf.Timestamp
 	17,  // 19: odpf.shield.v1beta1.CreateGroupResponse.group:type_name -> odpf.shield.v1beta1.Group
 	17,  // 20: odpf.shield.v1beta1.GetGroupResponse.group:type_name -> odpf.shield.v1beta1.Group
 	17,  // 21: odpf.shield.v1beta1.UpdateGroupResponse.group:type_name -> odpf.shield.v1beta1.Group
@@ -8817,21 +11014,21 @@ var file_odpf_shield_v1beta1_shield_proto_depIdxs = []int32{
 	35,  // 29: odpf.shield.v1beta1.AddGroupAdminRequest.body:type_name -> odpf.shield.v1beta1.AddGroupAdminRequestBody
 	2,   // 30: odpf.shield.v1beta1.AddGroupAdminResponse.users:type_name -> odpf.shield.v1beta1.User
 	85,  // 31: odpf.shield.v1beta1.Role.namespace:type_name -> odpf.shield.v1beta1.Namespace
-	136, // 32: odpf.shield.v1beta1.Role.metadata:type_name -> google.protobuf.Struct
-	137, // 33: odpf.shield.v1beta1.Role.created_at:type_name -> google.protobuf.Timestamp
-	137, // 34: odpf.shield.v1beta1.Role.updated_at:type_name -> google.protobuf.Timestamp
-	136, // 35: odpf.shield.v1beta1.RoleRequestBody.metadata:type_name -> google.protobuf.Struct
+	167, // 32: odpf.shield.v1beta1.Role.metadata:type_name -> google.protobuf.Struct
+	168, // 33: odpf.shield.v1beta1.Role.created_at:type_name -> google.protobuf.Timestamp
+	168, // 34: odpf.shield.v1beta1.Role.updated_at:type_name -> google.protobuf.Timestamp
+	167, // 35: odpf.shield.v1beta1.RoleRequestBody.metadata:type_name -> google.protobuf.Struct
 	41,  // 36: odpf.shield.v1beta1.CreateRoleRequest.body:type_name -> odpf.shield.v1beta1.RoleRequestBody
 	40,  // 37: odpf.shield.v1beta1.CreateRoleResponse.role:type_name -> odpf.shield.v1beta1.Role
 	40,  // 38: odpf.shield.v1beta1.GetRoleResponse.role:type_name -> odpf.shield.v1beta1.Role
 	40,  // 39: odpf.shield.v1beta1.UpdateRoleResponse.role:type_name -> odpf.shield.v1beta1.Role
 	41,  // 40: odpf.shield.v1beta1.UpdateRoleRequest.body:type_name -> odpf.shield.v1beta1.RoleRequestBody
 	40,  // 41: odpf.shield.v1beta1.ListRolesResponse.roles:type_name -> odpf.shield.v1beta1.Role
-	136, // 42: odpf.shield.v1beta1.OrganizationRequestBody.metadata:type_name -> google.protobuf.Struct
+	167, // 42: odpf.shield.v1beta1.OrganizationRequestBody.metadata:type_name -> google.protobuf.Struct
 	50,  // 43: odpf.shield.v1beta1.CreateOrganizationRequest.body:type_name -> odpf.shield.v1beta1.OrganizationRequestBody
-	136, // 44: odpf.shield.v1beta1.Organization.metadata:type_name -> google.protobuf.Struct
-	137, // 45: odpf.shield.v1beta1.Organization.created_at:type_name -> google.protobuf.Timestamp
-	137, // 46: odpf.shield.v1beta1.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	167, // 44: odpf.shield.v1beta1.Organization.metadata:type_name -> google.protobuf.Struct
+	168, // 45: odpf.shield.v1beta1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	168, // 46: odpf.shield.v1beta1.Organization.updated_at:type_name -> google.protobuf.Timestamp
 	52,  // 47: odpf.shield.v1beta1.CreateOrganizationResponse.organization:type_name -> odpf.shield.v1beta1.Organization
 	52,  // 48: odpf.shield.v1beta1.GetOrganizationResponse.organization:type_name -> odpf.shield.v1beta1.Organization
 	52,  // 49: odpf.shield.v1beta1.UpdateOrganizationResponse.organization:type_name -> odpf.shield.v1beta1.Organization
@@ -8840,11 +11037,11 @@ var file_odpf_shield_v1beta1_shield_proto_depIdxs = []int32{
 	2,   // 52: odpf.shield.v1beta1.ListOrganizationAdminsResponse.users:type_name -> odpf.shield.v1beta1.User
 	62,  // 53: odpf.shield.v1beta1.AddOrganizationAdminRequest.body:type_name -> odpf.shield.v1beta1.AddOrganizationAdminRequestBody
 	2,   // 54: odpf.shield.v1beta1.AddOrganizationAdminResponse.users:type_name -> odpf.shield.v1beta1.User
-	136, // 55: odpf.shield.v1beta1.ProjectRequestBody.metadata:type_name -> google.protobuf.Struct
+	167, // 55: odpf.shield.v1beta1.ProjectRequestBody.metadata:type_name -> google.protobuf.Struct
 	67,  // 56: odpf.shield.v1beta1.CreateProjectRequest.body:type_name -> odpf.shield.v1beta1.ProjectRequestBody
-	136, // 57: odpf.shield.v1beta1.Project.metadata:type_name -> google.protobuf.Struct
-	137, // 58: odpf.shield.v1beta1.Project.created_at:type_name -> google.protobuf.Timestamp
-	137, // 59: odpf.shield.v1beta1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	167, // 57: odpf.shield.v1beta1.Project.metadata:type_name -> google.protobuf.Struct
+	168, // 58: odpf.shield.v1beta1.Project.created_at:type_name -> google.protobuf.Timestamp
+	168, // 59: odpf.shield.v1beta1.Project.updated_at:type_name -> google.protobuf.Timestamp
 	69,  // 60: odpf.shield.v1beta1.CreateProjectResponse.project:type_name -> odpf.shield.v1beta1.Project
 	69,  // 61: odpf.shield.v1beta1.GetProjectResponse.project:type_name -> odpf.shield.v1beta1.Project
 	69,  // 62: odpf.shield.v1beta1.UpdateProjectResponse.project:type_name -> odpf.shield.v1beta1.Project
@@ -8854,15 +11051,15 @@ var file_odpf_shield_v1beta1_shield_proto_depIdxs = []int32{
 	79,  // 66: odpf.shield.v1beta1.AddProjectAdminRequest.body:type_name -> odpf.shield.v1beta1.AddProjectAdminRequestBody
 	2,   // 67: odpf.shield.v1beta1.AddProjectAdminResponse.users:type_name -> odpf.shield.v1beta1.User
 	85,  // 68: odpf.shield.v1beta1.Action.namespace:type_name -> odpf.shield.v1beta1.Namespace
-	137, // 69: odpf.shield.v1beta1.Action.created_at:type_name -> google.protobuf.Timestamp
-	137, // 70: odpf.shield.v1beta1.Action.updated_at:type_name -> google.protobuf.Timestamp
-	137, // 71: odpf.shield.v1beta1.Namespace.created_at:type_name -> google.protobuf.Timestamp
-	137, // 72: odpf.shield.v1beta1.Namespace.updated_at:type_name -> google.protobuf.Timestamp
+	168, // 69: odpf.shield.v1beta1.Action.created_at:type_name -> google.protobuf.Timestamp
+	168, // 70: odpf.shield.v1beta1.Action.updated_at:type_name -> google.protobuf.Timestamp
+	168, // 71: odpf.shield.v1beta1.Namespace.created_at:type_name -> google.protobuf.Timestamp
+	168, // 72: odpf.shield.v1beta1.Namespace.updated_at:type_name -> google.protobuf.Timestamp
 	40,  // 73: odpf.shield.v1beta1.Policy.role:type_name -> odpf.shield.v1beta1.Role
 	84,  // 74: odpf.shield.v1beta1.Policy.action:type_name -> odpf.shield.v1beta1.Action
 	85,  // 75: odpf.shield.v1beta1.Policy.namespace:type_name -> odpf.shield.v1beta1.Namespace
-	137, // 76: odpf.shield.v1beta1.Policy.created_at:type_name -> google.protobuf.Timestamp
-	137, // 77: odpf.shield.v1beta1.Policy.updated_at:type_name -> google.protobuf.Timestamp
+	168, // 76: odpf.shield.v1beta1.Policy.created_at:type_name -> google.protobuf.Timestamp
+	168, // 77: odpf.shield.v1beta1.Policy.updated_at:type_name -> google.protobuf.Timestamp
 	84,  // 78: odpf.shield.v1beta1.ListActionsResponse.actions:type_name -> odpf.shield.v1beta1.Action
 	87,  // 79: odpf.shield.v1beta1.CreateActionRequest.body:type_name -> odpf.shield.v1beta1.ActionRequestBody
 	84,  // 80: odpf.shield.v1beta1.CreateActionResponse.action:type_name -> odpf.shield.v1beta1.Action
@@ -8884,14 +11081,14 @@ var file_odpf_shield_v1beta1_shield_proto_depIdxs = []int32{
 	85,  // 96: odpf.shield.v1beta1.Relation.subject_type:type_name -> odpf.shield.v1beta1.Namespace
 	85,  // 97: odpf.shield.v1beta1.Relation.object_type:type_name -> odpf.shield.v1beta1.Namespace
 	40,  // 98: odpf.shield.v1beta1.Relation.role:type_name -> odpf.shield.v1beta1.Role
-	137, // 99: odpf.shield.v1beta1.Relation.created_at:type_name -> google.protobuf.Timestamp
-	137, // 100: odpf.shield.v1beta1.Relation.updated_at:type_name -> google.protobuf.Timestamp
+	168, // 99: odpf.shield.v1beta1.Relation.created_at:type_name -> google.protobuf.Timestamp
+	168, // 100: odpf.shield.v1beta1.Relation.updated_at:type_name -> google.protobuf.Timestamp
 	17,  // 101: odpf.shield.v1beta1.Resource.group:type_name -> odpf.shield.v1beta1.Group
 	69,  // 102: odpf.shield.v1beta1.Resource.project:type_name -> odpf.shield.v1beta1.Project
 	52,  // 103: odpf.shield.v1beta1.Resource.organization:type_name -> odpf.shield.v1beta1.Organization
 	85,  // 104: odpf.shield.v1beta1.Resource.namespace:type_name -> odpf.shield.v1beta1.Namespace
-	137, // 105: odpf.shield.v1beta1.Resource.created_at:type_name -> google.protobuf.Timestamp
-	137, // 106: odpf.shield.v1beta1.Resource.updated_at:type_name -> google.protobuf.Timestamp
+	168, // 105: odpf.shield.v1beta1.Resource.created_at:type_name -> google.protobuf.Timestamp
+	168, // 106: odpf.shield.v1beta1.Resource.updated_at:type_name -> google.protobuf.Timestamp
 	2,   // 107: odpf.shield.v1beta1.Resource.user:type_name -> odpf.shield.v1beta1.User
 	114, // 108: odpf.shield.v1beta1.ListRelationsResponse.relations:type_name -> odpf.shield.v1beta1.Relation
 	118, // 109: odpf.shield.v1beta1.CreateRelationRequest.body:type_name -> odpf.shield.v1beta1.RelationRequestBody
@@ -8905,123 +11102,168 @@ var file_odpf_shield_v1beta1_shield_proto_depIdxs = []int32{
 	115, // 117: odpf.shield.v1beta1.GetResourceResponse.resource:type_name -> odpf.shield.v1beta1.Resource
 	127, // 118: odpf.shield.v1beta1.UpdateResourceRequest.body:type_name -> odpf.shield.v1beta1.ResourceRequestBody
 	115, // 119: odpf.shield.v1beta1.UpdateResourceResponse.resource:type_name -> odpf.shield.v1beta1.Resource
-	12,  // 120: odpf.shield.v1beta1.ShieldService.ListUsers:input_type -> odpf.shield.v1beta1.ListUsersRequest
-	1,   // 121: odpf.shield.v1beta1.ShieldService.CreateUser:input_type -> odpf.shield.v1beta1.CreateUserRequest
-	9,   // 122: odpf.shield.v1beta1.ShieldService.GetUser:input_type -> odpf.shield.v1beta1.GetUserRequest
-	10,  // 123: odpf.shield.v1beta1.ShieldService.ListUserGroups:input_type -> odpf.shield.v1beta1.ListUserGroupsRequest
-	11,  // 124: odpf.shield.v1beta1.ShieldService.GetCurrentUser:input_type -> odpf.shield.v1beta1.GetCurrentUserRequest
-	8,   // 125: odpf.shield.v1beta1.ShieldService.UpdateUser:input_type -> odpf.shield.v1beta1.UpdateUserRequest
-	22,  // 126: odpf.shield.v1beta1.ShieldService.UpdateCurrentUser:input_type -> odpf.shield.v1beta1.UpdateCurrentUserRequest
-	24,  // 127: odpf.shield.v1beta1.ShieldService.ListGroups:input_type -> odpf.shield.v1beta1.ListGroupsRequest
-	15,  // 128: odpf.shield.v1beta1.ShieldService.CreateGroup:input_type -> odpf.shield.v1beta1.CreateGroupRequest
-	23,  // 129: odpf.shield.v1beta1.ShieldService.GetGroup:input_type -> odpf.shield.v1beta1.GetGroupRequest
-	21,  // 130: odpf.shield.v1beta1.ShieldService.UpdateGroup:input_type -> odpf.shield.v1beta1.UpdateGroupRequest
-	26,  // 131: odpf.shield.v1beta1.ShieldService.ListGroupUsers:input_type -> odpf.shield.v1beta1.ListGroupUsersRequest
-	29,  // 132: odpf.shield.v1beta1.ShieldService.AddGroupUser:input_type -> odpf.shield.v1beta1.AddGroupUserRequest
-	31,  // 133: odpf.shield.v1beta1.ShieldService.RemoveGroupUser:input_type -> odpf.shield.v1beta1.RemoveGroupUserRequest
-	33,  // 134: odpf.shield.v1beta1.ShieldService.ListGroupAdmins:input_type -> odpf.shield.v1beta1.ListGroupAdminsRequest
-	36,  // 135: odpf.shield.v1beta1.ShieldService.AddGroupAdmin:input_type -> odpf.shield.v1beta1.AddGroupAdminRequest
-	38,  // 136: odpf.shield.v1beta1.ShieldService.RemoveGroupAdmin:input_type -> odpf.shield.v1beta1.RemoveGroupAdminRequest
-	48,  // 137: odpf.shield.v1beta1.ShieldService.ListRoles:input_type -> odpf.shield.v1beta1.ListRolesRequest
-	42,  // 138: odpf.shield.v1beta1.ShieldService.CreateRole:input_type -> odpf.shield.v1beta1.CreateRoleRequest
-	46,  // 139: odpf.shield.v1beta1.ShieldService.GetRole:input_type -> odpf.shield.v1beta1.GetRoleRequest
-	47,  // 140: odpf.shield.v1beta1.ShieldService.UpdateRole:input_type -> odpf.shield.v1beta1.UpdateRoleRequest
-	56,  // 141: odpf.shield.v1beta1.ShieldService.ListOrganizations:input_type -> odpf.shield.v1beta1.ListOrganizationsRequest
-	51,  // 142: odpf.shield.v1beta1.ShieldService.CreateOrganization:input_type -> odpf.shield.v1beta1.CreateOrganizationRequest
-	58,  // 143: odpf.shield.v1beta1.ShieldService.GetOrganization:input_type -> odpf.shield.v1beta1.GetOrganizationRequest
-	59,  // 144: odpf.shield.v1beta1.ShieldService.UpdateOrganization:input_type -> odpf.shield.v1beta1.UpdateOrganizationRequest
-	60,  // 145: odpf.shield.v1beta1.ShieldService.ListOrganizationAdmins:input_type -> odpf.shield.v1beta1.ListOrganizationAdminsRequest
-	63,  // 146: odpf.shield.v1beta1.ShieldService.AddOrganizationAdmin:input_type -> odpf.shield.v1beta1.AddOrganizationAdminRequest
-	65,  // 147: odpf.shield.v1beta1.ShieldService.RemoveOrganizationAdmin:input_type -> odpf.shield.v1beta1.RemoveOrganizationAdminRequest
-	73,  // 148: odpf.shield.v1beta1.ShieldService.ListProjects:input_type -> odpf.shield.v1beta1.ListProjectsRequest
-	68,  // 149: odpf.shield.v1beta1.ShieldService.CreateProject:input_type -> odpf.shield.v1beta1.CreateProjectRequest
-	75,  // 150: odpf.shield.v1beta1.ShieldService.GetProject:input_type -> odpf.shield.v1beta1.GetProjectRequest
-	76,  // 151: odpf.shield.v1beta1.ShieldService.UpdateProject:input_type -> odpf.shield.v1beta1.UpdateProjectRequest
-	77,  // 152: odpf.shield.v1beta1.ShieldService.ListProjectAdmins:input_type -> odpf.shield.v1beta1.ListProjectAdminsRequest
-	80,  // 153: odpf.shield.v1beta1.ShieldService.AddProjectAdmin:input_type -> odpf.shield.v1beta1.AddProjectAdminRequest
-	82,  // 154: odpf.shield.v1beta1.ShieldService.RemoveProjectAdmin:input_type -> odpf.shield.v1beta1.RemoveProjectAdminRequest
-	90,  // 155: odpf.shield.v1beta1.ShieldService.ListActions:input_type -> odpf.shield.v1beta1.ListActionsRequest
-	92,  // 156: odpf.shield.v1beta1.ShieldService.CreateAction:input_type -> odpf.shield.v1beta1.CreateActionRequest
-	94,  // 157: odpf.shield.v1beta1.ShieldService.GetAction:input_type -> odpf.shield.v1beta1.GetActionRequest
-	96,  // 158: odpf.shield.v1beta1.ShieldService.UpdateAction:input_type -> odpf.shield.v1beta1.UpdateActionRequest
-	98,  // 159: odpf.shield.v1beta1.ShieldService.ListNamespaces:input_type -> odpf.shield.v1beta1.ListNamespacesRequest
-	100, // 160: odpf.shield.v1beta1.ShieldService.CreateNamespace:input_type -> odpf.shield.v1beta1.CreateNamespaceRequest
-	102, // 161: odpf.shield.v1beta1.ShieldService.GetNamespace:input_type -> odpf.shield.v1beta1.GetNamespaceRequest
-	104, // 162: odpf.shield.v1beta1.ShieldService.UpdateNamespace:input_type -> odpf.shield.v1beta1.UpdateNamespaceRequest
-	106, // 163: odpf.shield.v1beta1.ShieldService.ListPolicies:input_type -> odpf.shield.v1beta1.ListPoliciesRequest
-	108, // 164: odpf.shield.v1beta1.ShieldService.CreatePolicy:input_type -> odpf.shield.v1beta1.CreatePolicyRequest
-	110, // 165: odpf.shield.v1beta1.ShieldService.GetPolicy:input_type -> odpf.shield.v1beta1.GetPolicyRequest
-	112, // 166: odpf.shield.v1beta1.ShieldService.UpdatePolicy:input_type -> odpf.shield.v1beta1.UpdatePolicyRequest
-	116, // 167: odpf.shield.v1beta1.ShieldService.ListRelations:input_type -> odpf.shield.v1beta1.ListRelationsRequest
-	119, // 168: odpf.shield.v1beta1.ShieldService.CreateRelation:input_type -> odpf.shield.v1beta1.CreateRelationRequest
-	121, // 169: odpf.shield.v1beta1.ShieldService.GetRelation:input_type -> odpf.shield.v1beta1.GetRelationRequest
-	123, // 170: odpf.shield.v1beta1.ShieldService.UpdateRelation:input_type -> odpf.shield.v1beta1.UpdateRelationRequest
-	125, // 171: odpf.shield.v1beta1.ShieldService.ListResources:input_type -> odpf.shield.v1beta1.ListResourcesRequest
-	128, // 172: odpf.shield.v1beta1.ShieldService.CreateResource:input_type -> odpf.shield.v1beta1.CreateResourceRequest
-	130, // 173: odpf.shield.v1beta1.ShieldService.GetResource:input_type -> odpf.shield.v1beta1.GetResourceRequest
-	132, // 174: odpf.shield.v1beta1.ShieldService.UpdateResource:input_type -> odpf.shield.v1beta1.UpdateResourceRequest
-	134, // 175: odpf.shield.v1beta1.ShieldService.CheckResourcePermission:input_type -> odpf.shield.v1beta1.ResourceActionAuthzRequest
-	13,  // 176: odpf.shield.v1beta1.ShieldService.ListUsers:output_type -> odpf.shield.v1beta1.ListUsersResponse
-	3,   // 177: odpf.shield.v1beta1.ShieldService.CreateUser:output_type -> odpf.shield.v1beta1.CreateUserResponse
-	4,   // 178: odpf.shield.v1beta1.ShieldService.GetUser:output_type -> odpf.shield.v1beta1.GetUserResponse
-	16,  // 179: odpf.shield.v1beta1.ShieldService.ListUserGroups:output_type -> odpf.shield.v1beta1.ListUserGroupsResponse
-	5,   // 180: odpf.shield.v1beta1.ShieldService.GetCurrentUser:output_type -> odpf.shield.v1beta1.GetCurrentUserResponse
-	6,   // 181: odpf.shield.v1beta1.ShieldService.UpdateUser:output_type -> odpf.shield.v1beta1.UpdateUserResponse
-	7,   // 182: odpf.shield.v1beta1.ShieldService.UpdateCurrentUser:output_type -> odpf.shield.v1beta1.UpdateCurrentUserResponse
-	25,  // 183: odpf.shield.v1beta1.ShieldService.ListGroups:output_type -> odpf.shield.v1beta1.ListGroupsResponse
-	18,  // 184: odpf.shield.v1beta1.ShieldService.CreateGroup:output_type -> odpf.shield.v1beta1.CreateGroupResponse
-	19,  // 185: odpf.shield.v1beta1.ShieldService.GetGroup:output_type -> odpf.shield.v1beta1.GetGroupResponse
-	20,  // 186: odpf.shield.v1beta1.ShieldService.UpdateGroup:output_type -> odpf.shield.v1beta1.UpdateGroupResponse
-	27,  // 187: odpf.shield.v1beta1.ShieldService.ListGroupUsers:output_type -> odpf.shield.v1beta1.ListGroupUsersResponse
-	30,  // 188: odpf.shield.v1beta1.ShieldService.AddGroupUser:output_type -> odpf.shield.v1beta1.AddGroupUserResponse
-	32,  // 189: odpf.shield.v1beta1.ShieldService.RemoveGroupUser:output_type -> odpf.shield.v1beta1.RemoveGroupUserResponse
-	34,  // 190: odpf.shield.v1beta1.ShieldService.ListGroupAdmins:output_type -> odpf.shield.v1beta1.ListGroupAdminsResponse
-	37,  // 191: odpf.shield.v1beta1.ShieldService.AddGroupAdmin:output_type -> odpf.shield.v1beta1.AddGroupAdminResponse
-	39,  // 192: odpf.shield.v1beta1.ShieldService.RemoveGroupAdmin:output_type -> odpf.shield.v1beta1.RemoveGroupAdminResponse
-	49,  // 193: odpf.shield.v1beta1.ShieldService.ListRoles:output_type -> odpf.shield.v1beta1.ListRolesResponse
-	43,  // 194: odpf.shield.v1beta1.ShieldService.CreateRole:output_type -> odpf.shield.v1beta1.CreateRoleResponse
-	44,  // 195: odpf.shield.v1beta1.ShieldService.GetRole:output_type -> odpf.shield.v1beta1.GetRoleResponse
-	45,  // 196: odpf.shield.v1beta1.ShieldService.UpdateRole:output_type -> odpf.shield.v1beta1.UpdateRoleResponse
-	57,  // 197: odpf.shield.v1beta1.ShieldService.ListOrganizations:output_type -> odpf.shield.v1beta1.ListOrganizationsResponse
-	53,  // 198: odpf.shield.v1beta1.ShieldService.CreateOrganization:output_type -> odpf.shield.v1beta1.CreateOrganizationResponse
-	54,  // 199: odpf.shield.v1beta1.ShieldService.GetOrganization:output_type -> odpf.shield.v1beta1.GetOrganizationResponse
-	55,  // 200: odpf.shield.v1beta1.ShieldService.UpdateOrganization:output_type -> odpf.shield.v1beta1.UpdateOrganizationResponse
-	61,  // 201: odpf.shield.v1beta1.ShieldService.ListOrganizationAdmins:output_type -> odpf.shield.v1beta1.ListOrganizationAdminsResponse
-	64,  // 202: odpf.shield.v1beta1.ShieldService.AddOrganizationAdmin:output_type -> odpf.shield.v1beta1.AddOrganizationAdminResponse
-	66,  // 203: odpf.shield.v1beta1.ShieldService.RemoveOrganizationAdmin:output_type -> odpf.shield.v1beta1.RemoveOrganizationAdminResponse
-	74,  // 204: odpf.shield.v1beta1.ShieldService.ListProjects:output_type -> odpf.shield.v1beta1.ListProjectsResponse
-	70,  // 205: odpf.shield.v1beta1.ShieldService.CreateProject:output_type -> odpf.shield.v1beta1.CreateProjectResponse
-	71,  // 206: odpf.shield.v1beta1.ShieldService.GetProject:output_type -> odpf.shield.v1beta1.GetProjectResponse
-	72,  // 207: odpf.shield.v1beta1.ShieldService.UpdateProject:output_type -> odpf.shield.v1beta1.UpdateProjectResponse
-	78,  // 208: odpf.shield.v1beta1.ShieldService.ListProjectAdmins:output_type -> odpf.shield.v1beta1.ListProjectAdminsResponse
-	81,  // 209: odpf.shield.v1beta1.ShieldService.AddProjectAdmin:output_type -> odpf.shield.v1beta1.AddProjectAdminResponse
-	83,  // 210: odpf.shield.v1beta1.ShieldService.RemoveProjectAdmin:output_type -> odpf.shield.v1beta1.RemoveProjectAdminResponse
-	91,  // 211: odpf.shield.v1beta1.ShieldService.ListActions:output_type -> odpf.shield.v1beta1.ListActionsResponse
-	93,  // 212: odpf.shield.v1beta1.ShieldService.CreateAction:output_type -> odpf.shield.v1beta1.CreateActionResponse
-	95,  // 213: odpf.shield.v1beta1.ShieldService.GetAction:output_type -> odpf.shield.v1beta1.GetActionResponse
-	97,  // 214: odpf.shield.v1beta1.ShieldService.UpdateAction:output_type -> odpf.shield.v1beta1.UpdateActionResponse
-	99,  // 215: odpf.shield.v1beta1.ShieldService.ListNamespaces:output_type -> odpf.shield.v1beta1.ListNamespacesResponse
-	101, // 216: odpf.shield.v1beta1.ShieldService.CreateNamespace:output_type -> odpf.shield.v1beta1.CreateNamespaceResponse
-	103, // 217: odpf.shield.v1beta1.ShieldService.GetNamespace:output_type -> odpf.shield.v1beta1.GetNamespaceResponse
-	105, // 218: odpf.shield.v1beta1.ShieldService.UpdateNamespace:output_type -> odpf.shield.v1beta1.UpdateNamespaceResponse
-	107, // 219: odpf.shield.v1beta1.ShieldService.ListPolicies:output_type -> odpf.shield.v1beta1.ListPoliciesResponse
-	109, // 220: odpf.shield.v1beta1.ShieldService.CreatePolicy:output_type -> odpf.shield.v1beta1.CreatePolicyResponse
-	111, // 221: odpf.shield.v1beta1.ShieldService.GetPolicy:output_type -> odpf.shield.v1beta1.GetPolicyResponse
-	113, // 222: odpf.shield.v1beta1.ShieldService.UpdatePolicy:output_type -> odpf.shield.v1beta1.UpdatePolicyResponse
-	117, // 223: odpf.shield.v1beta1.ShieldService.ListRelations:output_type -> odpf.shield.v1beta1.ListRelationsResponse
-	120, // 224: odpf.shield.v1beta1.ShieldService.CreateRelation:output_type -> odpf.shield.v1beta1.CreateRelationResponse
-	122, // 225: odpf.shield.v1beta1.ShieldService.GetRelation:output_type -> odpf.shield.v1beta1.GetRelationResponse
-	124, // 226: odpf.shield.v1beta1.ShieldService.UpdateRelation:output_type -> odpf.shield.v1beta1.UpdateRelationResponse
-	126, // 227: odpf.shield.v1beta1.ShieldService.ListResources:output_type -> odpf.shield.v1beta1.ListResourcesResponse
-	129, // 228: odpf.shield.v1beta1.ShieldService.CreateResource:output_type -> odpf.shield.v1beta1.CreateResourceResponse
-	131, // 229: odpf.shield.v1beta1.ShieldService.GetResource:output_type -> odpf.shield.v1beta1.GetResourceResponse
-	133, // 230: odpf.shield.v1beta1.ShieldService.UpdateResource:output_type -> odpf.shield.v1beta1.UpdateResourceResponse
-	135, // 231: odpf.shield.v1beta1.ShieldService.CheckResourcePermission:output_type -> odpf.shield.v1beta1.ResourceActionAuthzResponse
-	176, // [176:232] is the sub-list for method output_type
-	120, // [120:176] is the sub-list for method input_type
-	120, // [120:120] is the sub-list for extension type_name
-	120, // [120:120] is the sub-list for extension extendee
-	0,   // [0:120] is the sub-list for field type_name
+	115, // 120: odpf.shield.v1beta1.ListAuthorizedResourcesResponse.resources:type_name -> odpf.shield.v1beta1.Resource
+	140, // 121: odpf.shield.v1beta1.CheckResourcePermissionsRequest.checks:type_name -> odpf.shield.v1beta1.PermissionCheck
+	141, // 122: odpf.shield.v1beta1.CheckResourcePermissionsResponse.results:type_name -> odpf.shield.v1beta1.PermissionCheckResult
+	144, // 123: odpf.shield.v1beta1.PermissionExplanation.children:type_name -> odpf.shield.v1beta1.PermissionExplanation
+	144, // 124: odpf.shield.v1beta1.ExplainResourcePermissionResponse.explanation:type_name -> odpf.shield.v1beta1.PermissionExplanation
+	167, // 125: odpf.shield.v1beta1.ServiceAccountRequestBody.metadata:type_name -> google.protobuf.Struct
+	167, // 126: odpf.shield.v1beta1.ServiceAccount.metadata:type_name -> google.protobuf.Struct
+	168, // 127: odpf.shield.v1beta1.ServiceAccount.created_at:type_name -> google.protobuf.Timestamp
+	168, // 128: odpf.shield.v1beta1.ServiceAccount.updated_at:type_name -> google.protobuf.Timestamp
+	147, // 129: odpf.shield.v1beta1.CreateServiceAccountRequest.body:type_name -> odpf.shield.v1beta1.ServiceAccountRequestBody
+	148, // 130: odpf.shield.v1beta1.CreateServiceAccountResponse.service_account:type_name -> odpf.shield.v1beta1.ServiceAccount
+	148, // 131: odpf.shield.v1beta1.ListServiceAccountsResponse.service_accounts:type_name -> odpf.shield.v1beta1.ServiceAccount
+	148, // 132: odpf.shield.v1beta1.GetServiceAccountResponse.service_account:type_name -> odpf.shield.v1beta1.ServiceAccount
+	168, // 133: odpf.shield.v1beta1.APIKey.expires_at:type_name -> google.protobuf.Timestamp
+	168, // 134: odpf.shield.v1beta1.APIKey.revoked_at:type_name -> google.protobuf.Timestamp
+	168, // 135: odpf.shield.v1beta1.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	158, // 136: odpf.shield.v1beta1.CreateAPIKeyRequest.body:type_name -> odpf.shield.v1beta1.APIKeyRequestBody
+	157, // 137: odpf.shield.v1beta1.CreateAPIKeyResponse.key:type_name -> odpf.shield.v1beta1.APIKey
+	157, // 138: odpf.shield.v1beta1.ListAPIKeysResponse.keys:type_name -> odpf.shield.v1beta1.APIKey
+	157, // 139: odpf.shield.v1beta1.RotateAPIKeyResponse.key:type_name -> odpf.shield.v1beta1.APIKey
+	157, // 140: odpf.shield.v1beta1.RevokeAPIKeyResponse.key:type_name -> odpf.shield.v1beta1.APIKey
+	12,  // 141: odpf.shield.v1beta1.ShieldService.ListUsers:input_type -> odpf.shield.v1beta1.ListUsersRequest
+	1,   // 142: odpf.shield.v1beta1.ShieldService.CreateUser:input_type -> odpf.shield.v1beta1.CreateUserRequest
+	9,   // 143: odpf.shield.v1beta1.ShieldService.GetUser:input_type -> odpf.shield.v1beta1.GetUserRequest
+	10,  // 144: odpf.shield.v1beta1.ShieldService.ListUserGroups:input_type -> odpf.shield.v1beta1.ListUserGroupsRequest
+	11,  // 145: odpf.shield.v1beta1.ShieldService.GetCurrentUser:input_type -> odpf.shield.v1beta1.GetCurrentUserRequest
+	8,   // 146: odpf.shield.v1beta1.ShieldService.UpdateUser:input_type -> odpf.shield.v1beta1.UpdateUserRequest
+	22,  // 147: odpf.shield.v1beta1.ShieldService.UpdateCurrentUser:input_type -> odpf.shield.v1beta1.UpdateCurrentUserRequest
+	24,  // 148: odpf.shield.v1beta1.ShieldService.ListGroups:input_type -> odpf.shield.v1beta1.ListGroupsRequest
+	15,  // 149: odpf.shield.v1beta1.ShieldService.CreateGroup:input_type -> odpf.shield.v1beta1.CreateGroupRequest
+	23,  // 150: odpf.shield.v1beta1.ShieldService.GetGroup:input_type -> odpf.shield.v1beta1.GetGroupRequest
+	21,  // 151: odpf.shield.v1beta1.ShieldService.UpdateGroup:input_type -> odpf.shield.v1beta1.UpdateGroupRequest
+	26,  // 152: odpf.shield.v1beta1.ShieldService.ListGroupUsers:input_type -> odpf.shield.v1beta1.ListGroupUsersRequest
+	29,  // 153: odpf.shield.v1beta1.ShieldService.AddGroupUser:input_type -> odpf.shield.v1beta1.AddGroupUserRequest
+	31,  // 154: odpf.shield.v1beta1.ShieldService.RemoveGroupUser:input_type -> odpf.shield.v1beta1.RemoveGroupUserRequest
+	33,  // 155: odpf.shield.v1beta1.ShieldService.ListGroupAdmins:input_type -> odpf.shield.v1beta1.ListGroupAdminsRequest
+	36,  // 156: odpf.shield.v1beta1.ShieldService.AddGroupAdmin:input_type -> odpf.shield.v1beta1.AddGroupAdminRequest
+	38,  // 157: odpf.shield.v1beta1.ShieldService.RemoveGroupAdmin:input_type -> odpf.shield.v1beta1.RemoveGroupAdminRequest
+	48,  // 158: odpf.shield.v1beta1.ShieldService.ListRoles:input_type -> odpf.shield.v1beta1.ListRolesRequest
+	42,  // 159: odpf.shield.v1beta1.ShieldService.CreateRole:input_type -> odpf.shield.v1beta1.CreateRoleRequest
+	46,  // 160: odpf.shield.v1beta1.ShieldService.GetRole:input_type -> odpf.shield.v1beta1.GetRoleRequest
+	47,  // 161: odpf.shield.v1beta1.ShieldService.UpdateRole:input_type -> odpf.shield.v1beta1.UpdateRoleRequest
+	56,  // 162: odpf.shield.v1beta1.ShieldService.ListOrganizations:input_type -> odpf.shield.v1beta1.ListOrganizationsRequest
+	51,  // 163: odpf.shield.v1beta1.ShieldService.CreateOrganization:input_type -> odpf.shield.v1beta1.CreateOrganizationRequest
+	58,  // 164: odpf.shield.v1beta1.ShieldService.GetOrganization:input_type -> odpf.shield.v1beta1.GetOrganizationRequest
+	59,  // 165: odpf.shield.v1beta1.ShieldService.UpdateOrganization:input_type -> odpf.shield.v1beta1.UpdateOrganizationRequest
+	60,  // 166: odpf.shield.v1beta1.ShieldService.ListOrganizationAdmins:input_type -> odpf.shield.v1beta1.ListOrganizationAdminsRequest
+	63,  // 167: odpf.shield.v1beta1.ShieldService.AddOrganizationAdmin:input_type -> odpf.shield.v1beta1.AddOrganizationAdminRequest
+	65,  // 168: odpf.shield.v1beta1.ShieldService.RemoveOrganizationAdmin:input_type -> odpf.shield.v1beta1.RemoveOrganizationAdminRequest
+	73,  // 169: odpf.shield.v1beta1.ShieldService.ListProjects:input_type -> odpf.shield.v1beta1.ListProjectsRequest
+	68,  // 170: odpf.shield.v1beta1.ShieldService.CreateProject:input_type -> odpf.shield.v1beta1.CreateProjectRequest
+	75,  // 171: odpf.shield.v1beta1.ShieldService.GetProject:input_type -> odpf.shield.v1beta1.GetProjectRequest
+	76,  // 172: odpf.shield.v1beta1.ShieldService.UpdateProject:input_type -> odpf.shield.v1beta1.UpdateProjectRequest
+	77,  // 173: odpf.shield.v1beta1.ShieldService.ListProjectAdmins:input_type -> odpf.shield.v1beta1.ListProjectAdminsRequest
+	80,  // 174: odpf.shield.v1beta1.ShieldService.AddProjectAdmin:input_type -> odpf.shield.v1beta1.AddProjectAdminRequest
+	82,  // 175: odpf.shield.v1beta1.ShieldService.RemoveProjectAdmin:input_type -> odpf.shield.v1beta1.RemoveProjectAdminRequest
+	90,  // 176: odpf.shield.v1beta1.ShieldService.ListActions:input_type -> odpf.shield.v1beta1.ListActionsRequest
+	92,  // 177: odpf.shield.v1beta1.ShieldService.CreateAction:input_type -> odpf.shield.v1beta1.CreateActionRequest
+	94,  // 178: odpf.shield.v1beta1.ShieldService.GetAction:input_type -> odpf.shield.v1beta1.GetActionRequest
+	96,  // 179: odpf.shield.v1beta1.ShieldService.UpdateAction:input_type -> odpf.shield.v1beta1.UpdateActionRequest
+	98,  // 180: odpf.shield.v1beta1.ShieldService.ListNamespaces:input_type -> odpf.shield.v1beta1.ListNamespacesRequest
+	100, // 181: odpf.shield.v1beta1.ShieldService.CreateNamespace:input_type -> odpf.shield.v1beta1.CreateNamespaceRequest
+	102, // 182: odpf.shield.v1beta1.ShieldService.GetNamespace:input_type -> odpf.shield.v1beta1.GetNamespaceRequest
+	104, // 183: odpf.shield.v1beta1.ShieldService.UpdateNamespace:input_type -> odpf.shield.v1beta1.UpdateNamespaceRequest
+	106, // 184: odpf.shield.v1beta1.ShieldService.ListPolicies:input_type -> odpf.shield.v1beta1.ListPoliciesRequest
+	108, // 185: odpf.shield.v1beta1.ShieldService.CreatePolicy:input_type -> odpf.shield.v1beta1.CreatePolicyRequest
+	110, // 186: odpf.shield.v1beta1.ShieldService.GetPolicy:input_type -> odpf.shield.v1beta1.GetPolicyRequest
+	112, // 187: odpf.shield.v1beta1.ShieldService.UpdatePolicy:input_type -> odpf.shield.v1beta1.UpdatePolicyRequest
+	116, // 188: odpf.shield.v1beta1.ShieldService.ListRelations:input_type -> odpf.shield.v1beta1.ListRelationsRequest
+	119, // 189: odpf.shield.v1beta1.ShieldService.CreateRelation:input_type -> odpf.shield.v1beta1.CreateRelationRequest
+	121, // 190: odpf.shield.v1beta1.ShieldService.GetRelation:input_type -> odpf.shield.v1beta1.GetRelationRequest
+	123, // 191: odpf.shield.v1beta1.ShieldService.UpdateRelation:input_type -> odpf.shield.v1beta1.UpdateRelationRequest
+	125, // 192: odpf.shield.v1beta1.ShieldService.ListResources:input_type -> odpf.shield.v1beta1.ListResourcesRequest
+	128, // 193: odpf.shield.v1beta1.ShieldService.CreateResource:input_type -> odpf.shield.v1beta1.CreateResourceRequest
+	130, // 194: odpf.shield.v1beta1.ShieldService.GetResource:input_type -> odpf.shield.v1beta1.GetResourceRequest
+	132, // 195: odpf.shield.v1beta1.ShieldService.UpdateResource:input_type -> odpf.shield.v1beta1.UpdateResourceRequest
+	134, // 196: odpf.shield.v1beta1.ShieldService.DeleteResource:input_type -> odpf.shield.v1beta1.DeleteResourceRequest
+	136, // 197: odpf.shield.v1beta1.ShieldService.ListAuthorizedResources:input_type -> odpf.shield.v1beta1.ListAuthorizedResourcesRequest
+	138, // 198: odpf.shield.v1beta1.ShieldService.CheckResourcePermission:input_type -> odpf.shield.v1beta1.ResourceActionAuthzRequest
+	142, // 199: odpf.shield.v1beta1.ShieldService.CheckResourcePermissions:input_type -> odpf.shield.v1beta1.CheckResourcePermissionsRequest
+	145, // 200: odpf.shield.v1beta1.ShieldService.ExplainResourcePermission:input_type -> odpf.shield.v1beta1.ExplainResourcePermissionRequest
+	151, // 201: odpf.shield.v1beta1.ShieldService.ListServiceAccounts:input_type -> odpf.shield.v1beta1.ListServiceAccountsRequest
+	149, // 202: odpf.shield.v1beta1.ShieldService.CreateServiceAccount:input_type -> odpf.shield.v1beta1.CreateServiceAccountRequest
+	153, // 203: odpf.shield.v1beta1.ShieldService.GetServiceAccount:input_type -> odpf.shield.v1beta1.GetServiceAccountRequest
+	155, // 204: odpf.shield.v1beta1.ShieldService.DeleteServiceAccount:input_type -> odpf.shield.v1beta1.DeleteServiceAccountRequest
+	161, // 205: odpf.shield.v1beta1.ShieldService.ListAPIKeys:input_type -> odpf.shield.v1beta1.ListAPIKeysRequest
+	159, // 206: odpf.shield.v1beta1.ShieldService.CreateAPIKey:input_type -> odpf.shield.v1beta1.CreateAPIKeyRequest
+	163, // 207: odpf.shield.v1beta1.ShieldService.RotateAPIKey:input_type -> odpf.shield.v1beta1.RotateAPIKeyRequest
+	165, // 208: odpf.shield.v1beta1.ShieldService.RevokeAPIKey:input_type -> odpf.shield.v1beta1.RevokeAPIKeyRequest
+	13,  // 209: odpf.shield.v1beta1.ShieldService.ListUsers:output_type -> odpf.shield.v1beta1.ListUsersResponse
+	3,   // 210: odpf.shield.v1beta1.ShieldService.CreateUser:output_type -> odpf.shield.v1beta1.CreateUserResponse
+	4,   // 211: odpf.shield.v1beta1.ShieldService.GetUser:output_type -> odpf.shield.v1beta1.GetUserResponse
+	16,  // 212: odpf.shield.v1beta1.ShieldService.ListUserGroups:output_type -> odpf.shield.v1beta1.ListUserGroupsResponse
+	5,   // 213: odpf.shield.v1beta1.ShieldService.GetCurrentUser:output_type -> odpf.shield.v1beta1.GetCurrentUserResponse
+	6,   // 214: odpf.shield.v1beta1.ShieldService.UpdateUser:output_type -> odpf.shield.v1beta1.UpdateUserResponse
+	7,   // 215: odpf.shield.v1beta1.ShieldService.UpdateCurrentUser:output_type -> odpf.shield.v1beta1.UpdateCurrentUserResponse
+	25,  // 216: odpf.shield.v1beta1.ShieldService.ListGroups:output_type -> odpf.shield.v1beta1.ListGroupsResponse
+	18,  // 217: odpf.shield.v1beta1.ShieldService.CreateGroup:output_type -> odpf.shield.v1beta1.CreateGroupResponse
+	19,  // 218: odpf.shield.v1beta1.ShieldService.GetGroup:output_type -> odpf.shield.v1beta1.GetGroupResponse
+	20,  // 219: odpf.shield.v1beta1.ShieldService.UpdateGroup:output_type -> odpf.shield.v1beta1.UpdateGroupResponse
+	27,  // 220: odpf.shield.v1beta1.ShieldService.ListGroupUsers:output_type -> odpf.shield.v1beta1.ListGroupUsersResponse
+	30,  // 221: odpf.shield.v1beta1.ShieldService.AddGroupUser:output_type -> odpf.shield.v1beta1.AddGroupUserResponse
+	32,  // 222: odpf.shield.v1beta1.ShieldService.RemoveGroupUser:output_type -> odpf.shield.v1beta1.RemoveGroupUserResponse
+	34,  // 223: odpf.shield.v1beta1.ShieldService.ListGroupAdmins:output_type -> odpf.shield.v1beta1.ListGroupAdminsResponse
+	37,  // 224: odpf.shield.v1beta1.ShieldService.AddGroupAdmin:output_type -> odpf.shield.v1beta1.AddGroupAdminResponse
+	39,  // 225: odpf.shield.v1beta1.ShieldService.RemoveGroupAdmin:output_type -> odpf.shield.v1beta1.RemoveGroupAdminResponse
+	49,  // 226: odpf.shield.v1beta1.ShieldService.ListRoles:output_type -> odpf.shield.v1beta1.ListRolesResponse
+	43,  // 227: odpf.shield.v1beta1.ShieldService.CreateRole:output_type -> odpf.shield.v1beta1.CreateRoleResponse
+	44,  // 228: odpf.shield.v1beta1.ShieldService.GetRole:output_type -> odpf.shield.v1beta1.GetRoleResponse
+	45,  // 229: odpf.shield.v1beta1.ShieldService.UpdateRole:output_type -> odpf.shield.v1beta1.UpdateRoleResponse
+	57,  // 230: odpf.shield.v1beta1.ShieldService.ListOrganizations:output_type -> odpf.shield.v1beta1.ListOrganizationsResponse
+	53,  // 231: odpf.shield.v1beta1.ShieldService.CreateOrganization:output_type -> odpf.shield.v1beta1.CreateOrganizationResponse
+	54,  // 232: odpf.shield.v1beta1.ShieldService.GetOrganization:output_type -> odpf.shield.v1beta1.GetOrganizationResponse
+	55,  // 233: odpf.shield.v1beta1.ShieldService.UpdateOrganization:output_type -> odpf.shield.v1beta1.UpdateOrganizationResponse
+	61,  // 234: odpf.shield.v1beta1.ShieldService.ListOrganizationAdmins:output_type -> odpf.shield.v1beta1.ListOrganizationAdminsResponse
+	64,  // 235: odpf.shield.v1beta1.ShieldService.AddOrganizationAdmin:output_type -> odpf.shield.v1beta1.AddOrganizationAdminResponse
+	66,  // 236: odpf.shield.v1beta1.ShieldService.RemoveOrganizationAdmin:output_type -> odpf.shield.v1beta1.RemoveOrganizationAdminResponse
+	74,  // 237: odpf.shield.v1beta1.ShieldService.ListProjects:output_type -> odpf.shield.v1beta1.ListProjectsResponse
+	70,  // 238: odpf.shield.v1beta1.ShieldService.CreateProject:output_type -> odpf.shield.v1beta1.CreateProjectResponse
+	71,  // 239: odpf.shield.v1beta1.ShieldService.GetProject:output_type -> odpf.shield.v1beta1.GetProjectResponse
+	72,  // 240: odpf.shield.v1beta1.ShieldService.UpdateProject:output_type -> odpf.shield.v1beta1.UpdateProjectResponse
+	78,  // 241: odpf.shield.v1beta1.ShieldService.ListProjectAdmins:output_type -> odpf.shield.v1beta1.ListProjectAdminsResponse
+	81,  // 242: odpf.shield.v1beta1.ShieldService.AddProjectAdmin:output_type -> odpf.shield.v1beta1.AddProjectAdminResponse
+	83,  // 243: odpf.shield.v1beta1.ShieldService.RemoveProjectAdmin:output_type -> odpf.shield.v1beta1.RemoveProjectAdminResponse
+	91,  // 244: odpf.shield.v1beta1.ShieldService.ListActions:output_type -> odpf.shield.v1beta1.ListActionsResponse
+	93,  // 245: odpf.shield.v1beta1.ShieldService.CreateAction:output_type -> odpf.shield.v1beta1.CreateActionResponse
+	95,  // 246: odpf.shield.v1beta1.ShieldService.GetAction:output_type -> odpf.shield.v1beta1.GetActionResponse
+	97,  // 247: odpf.shield.v1beta1.ShieldService.UpdateAction:output_type -> odpf.shield.v1beta1.UpdateActionResponse
+	99,  // 248: odpf.shield.v1beta1.ShieldService.ListNamespaces:output_type -> odpf.shield.v1beta1.ListNamespacesResponse
+	101, // 249: odpf.shield.v1beta1.ShieldService.CreateNamespace:output_type -> odpf.shield.v1beta1.CreateNamespaceResponse
+	103, // 250: odpf.shield.v1beta1.ShieldService.GetNamespace:output_type -> odpf.shield.v1beta1.GetNamespaceResponse
+	105, // 251: odpf.shield.v1beta1.ShieldService.UpdateNamespace:output_type -> odpf.shield.v1beta1.UpdateNamespaceResponse
+	107, // 252: odpf.shield.v1beta1.ShieldService.ListPolicies:output_type -> odpf.shield.v1beta1.ListPoliciesResponse
+	109, // 253: odpf.shield.v1beta1.ShieldService.CreatePolicy:output_type -> odpf.shield.v1beta1.CreatePolicyResponse
+	111, // 254: odpf.shield.v1beta1.ShieldService.GetPolicy:output_type -> odpf.shield.v1beta1.GetPolicyResponse
+	113, // 255: odpf.shield.v1beta1.ShieldService.UpdatePolicy:output_type -> odpf.shield.v1beta1.UpdatePolicyResponse
+	117, // 256: odpf.shield.v1beta1.ShieldService.ListRelations:output_type -> odpf.shield.v1beta1.ListRelationsResponse
+	120, // 257: odpf.shield.v1beta1.ShieldService.CreateRelation:output_type -> odpf.shield.v1beta1.CreateRelationResponse
+	122, // 258: odpf.shield.v1beta1.ShieldService.GetRelation:output_type -> odpf.shield.v1beta1.GetRelationResponse
+	124, // 259: odpf.shield.v1beta1.ShieldService.UpdateRelation:output_type -> odpf.shield.v1beta1.UpdateRelationResponse
+	126, // 260: odpf.shield.v1beta1.ShieldService.ListResources:output_type -> odpf.shield.v1beta1.ListResourcesResponse
+	129, // 261: odpf.shield.v1beta1.ShieldService.CreateResource:output_type -> odpf.shield.v1beta1.CreateResourceResponse
+	131, // 262: odpf.shield.v1beta1.ShieldService.GetResource:output_type -> odpf.shield.v1beta1.GetResourceResponse
+	133, // 263: odpf.shield.v1beta1.ShieldService.UpdateResource:output_type -> odpf.shield.v1beta1.UpdateResourceResponse
+	135, // 264: odpf.shield.v1beta1.ShieldService.DeleteResource:output_type -> odpf.shield.v1beta1.DeleteResourceResponse
+	137, // 265: odpf.shield.v1beta1.ShieldService.ListAuthorizedResources:output_type -> odpf.shield.v1beta1.ListAuthorizedResourcesResponse
+	139, // 266: odpf.shield.v1beta1.ShieldService.CheckResourcePermission:output_type -> odpf.shield.v1beta1.ResourceActionAuthzResponse
+	143, // 267: odpf.shield.v1beta1.ShieldService.CheckResourcePermissions:output_type -> odpf.shield.v1beta1.CheckResourcePermissionsResponse
+	146, // 268: odpf.shield.v1beta1.ShieldService.ExplainResourcePermission:output_type -> odpf.shield.v1beta1.ExplainResourcePermissionResponse
+	152, // 269: odpf.shield.v1beta1.ShieldService.ListServiceAccounts:output_type -> odpf.shield.v1beta1.ListServiceAccountsResponse
+	150, // 270: odpf.shield.v1beta1.ShieldService.CreateServiceAccount:output_type -> odpf.shield.v1beta1.CreateServiceAccountResponse
+	154, // 271: odpf.shield.v1beta1.ShieldService.GetServiceAccount:output_type -> odpf.shield.v1beta1.GetServiceAccountResponse
+	156, // 272: odpf.shield.v1beta1.ShieldService.DeleteServiceAccount:output_type -> odpf.shield.v1beta1.DeleteServiceAccountResponse
+	162, // 273: odpf.shield.v1beta1.ShieldService.ListAPIKeys:output_type -> odpf.shield.v1beta1.ListAPIKeysResponse
+	160, // 274: odpf.shield.v1beta1.ShieldService.CreateAPIKey:output_type -> odpf.shield.v1beta1.CreateAPIKeyResponse
+	164, // 275: odpf.shield.v1beta1.ShieldService.RotateAPIKey:output_type -> odpf.shield.v1beta1.RotateAPIKeyResponse
+	166, // 276: odpf.shield.v1beta1.ShieldService.RevokeAPIKey:output_type -> odpf.shield.v1beta1.RevokeAPIKeyResponse
+	209, // [209:277] is the sub-list for method output_type
+	141, // [141:209] is the sub-list for method input_type
+	141, // [141:141] is the sub-list for extension type_name
+	141, // [141:141] is the sub-list for extension extendee
+	0,   // [0:141] is the sub-list for field type_name
 }
 
 func init() { file_odpf_shield_v1beta1_shield_proto_init() }
@@ -9042,8 +11284,356 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCurrentUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRequestBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCurrentUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGroupUserRequestBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGroupUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGroupUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9054,8 +11644,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveGroupUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9066,8 +11656,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveGroupUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9078,8 +11668,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupAdminsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9090,8 +11680,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCurrentUserResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupAdminsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9102,8 +11692,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGroupAdminRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9114,8 +11704,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCurrentUserResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGroupAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9126,8 +11716,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGroupAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9138,8 +11728,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveGroupAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9150,8 +11740,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserGroupsRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveGroupAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9162,8 +11752,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCurrentUserRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Role); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9174,8 +11764,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUsersRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9186,8 +11776,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUsersResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9198,8 +11788,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9210,8 +11800,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGroupRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9222,8 +11812,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserGroupsResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9234,8 +11824,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Group); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9246,8 +11836,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGroupResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9258,8 +11848,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGroupResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRolesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9270,8 +11860,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateGroupResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRolesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9282,8 +11872,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateGroupRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrganizationRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9294,8 +11884,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCurrentUserRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrganizationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9306,8 +11896,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGroupRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Organization); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9318,8 +11908,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGroupsRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrganizationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9330,8 +11920,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGroupsResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrganizationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9342,8 +11932,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGroupUsersRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOrganizationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9354,8 +11944,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGroupUsersResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrganizationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9366,8 +11956,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddGroupUserRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrganizationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9378,8 +11968,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddGroupUserRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrganizationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9390,8 +11980,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddGroupUserResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOrganizationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9402,8 +11992,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveGroupUserRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrganizationAdminsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9414,8 +12004,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveGroupUserResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrganizationAdminsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9426,8 +12016,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGroupAdminsRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddOrganizationAdminRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9438,8 +12028,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGroupAdminsResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddOrganizationAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9450,8 +12040,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddGroupAdminRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddOrganizationAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9462,8 +12052,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddGroupAdminRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveOrganizationAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9474,8 +12064,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddGroupAdminResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveOrganizationAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9486,8 +12076,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveGroupAdminRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9498,8 +12088,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveGroupAdminResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9510,8 +12100,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Role); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9522,8 +12112,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9534,8 +12124,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoleRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9546,8 +12136,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoleResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9558,8 +12148,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoleResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9570,8 +12160,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRoleResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9582,8 +12172,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoleRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9594,8 +12184,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRoleRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9606,8 +12196,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRolesRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectAdminsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9618,8 +12208,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRolesResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectAdminsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9630,8 +12220,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrganizationRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectAdminRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9642,8 +12232,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrganizationRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9654,8 +12244,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Organization); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9666,8 +12256,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrganizationResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveProjectAdminRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9678,8 +12268,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrganizationResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveProjectAdminResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9690,8 +12280,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrganizationResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Action); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9702,8 +12292,32 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrganizationsRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Namespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Policy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9714,8 +12328,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrganizationsResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamespaceRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9726,8 +12340,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrganizationRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9738,8 +12352,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateOrganizationRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListActionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9750,8 +12364,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrganizationAdminsRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListActionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9762,8 +12376,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListOrganizationAdminsResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateActionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9774,8 +12388,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddOrganizationAdminRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateActionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9786,8 +12400,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddOrganizationAdminRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9798,8 +12412,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddOrganizationAdminResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9810,8 +12424,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveOrganizationAdminRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateActionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9822,8 +12436,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveOrganizationAdminResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateActionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9834,8 +12448,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNamespacesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9846,8 +12460,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProjectRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNamespacesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9858,8 +12472,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Project); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9870,8 +12484,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProjectResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateNamespaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9882,8 +12496,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9894,8 +12508,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNamespaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9906,8 +12520,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProjectsRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9918,8 +12532,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProjectsResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNamespaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9930,8 +12544,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPoliciesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9942,8 +12556,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPoliciesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9954,8 +12568,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProjectAdminsRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9966,8 +12580,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProjectAdminsResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9978,8 +12592,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProjectAdminRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9990,8 +12604,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProjectAdminRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10002,8 +12616,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProjectAdminResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10014,8 +12628,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveProjectAdminRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10026,8 +12640,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveProjectAdminResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Relation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10038,8 +12652,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Action); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10050,8 +12664,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Namespace); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRelationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10062,8 +12676,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Policy); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRelationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10074,8 +12688,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActionRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10086,8 +12700,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NamespaceRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRelationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10098,8 +12712,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRelationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10110,8 +12724,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListActionsRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10122,8 +12736,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListActionsResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10134,8 +12748,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateActionRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRelationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10146,8 +12760,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateActionResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRelationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10158,8 +12772,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetActionRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResourcesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10170,8 +12784,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetActionResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResourcesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10182,8 +12796,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateActionRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10194,8 +12808,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateActionResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResourceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10206,8 +12820,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListNamespacesRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResourceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10218,8 +12832,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListNamespacesResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10230,8 +12844,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateNamespaceRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10242,8 +12856,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateNamespaceResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResourceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10254,8 +12868,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNamespaceRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResourceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10266,8 +12880,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNamespaceResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResourceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10278,8 +12892,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateNamespaceRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResourceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10290,8 +12904,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateNamespaceResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuthorizedResourcesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10302,8 +12916,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPoliciesRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuthorizedResourcesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10314,8 +12928,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPoliciesResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceActionAuthzRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10326,8 +12940,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePolicyRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceActionAuthzResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10338,8 +12952,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePolicyResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionCheck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10350,8 +12964,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPolicyRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionCheckResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10362,8 +12976,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPolicyResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckResourcePermissionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10374,8 +12988,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePolicyRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckResourcePermissionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10386,8 +13000,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePolicyResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionExplanation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10398,8 +13012,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Relation); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainResourcePermissionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10410,8 +13024,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Resource); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExplainResourcePermissionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10422,8 +13036,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRelationsRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceAccountRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10434,8 +13048,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRelationsResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10446,8 +13060,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelationRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServiceAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10458,8 +13072,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRelationRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServiceAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10470,8 +13084,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRelationResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServiceAccountsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10482,8 +13096,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRelationRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListServiceAccountsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10494,8 +13108,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRelationResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10506,8 +13120,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRelationRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10518,8 +13132,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRelationResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteServiceAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10530,8 +13144,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListResourcesRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteServiceAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10542,8 +13156,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListResourcesResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10554,8 +13168,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceRequestBody); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKeyRequestBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10566,8 +13180,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResourceRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10578,8 +13192,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResourceResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10590,8 +13204,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResourceRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAPIKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10602,8 +13216,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResourceResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAPIKeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10614,8 +13228,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateResourceRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateAPIKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10626,8 +13240,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateResourceResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateAPIKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10638,8 +13252,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceActionAuthzRequest); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAPIKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10650,8 +13264,8 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 				return nil
 			}
 		}
-		file_odpf_shield_v1beta1_shield_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceActionAuthzResponse); i {
+		file_odpf_shield_v1beta1_shield_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAPIKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10669,7 +13283,7 @@ func file_odpf_shield_v1beta1_shield_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_odpf_shield_v1beta1_shield_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   136,
+			NumMessages:   167,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
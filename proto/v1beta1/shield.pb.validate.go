@@ -17590,74 +17590,3935 @@ var _ interface {
 	ErrorName() string
 } = UpdateResourceResponseValidationError{}
 
-// Validate checks the field values on ResourceActionAuthzRequest with the
+// Validate checks the field values on DeleteResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteResourceRequestMultiError, or nil if none found.
+func (m *DeleteResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteResourceRequestMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteResourceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteResourceRequestMultiError) AllErrors() []error { return m }
+
+// DeleteResourceRequestValidationError is the validation error returned by
+// DeleteResourceRequest.Validate if the designated constraints aren't met.
+type DeleteResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteResourceRequestValidationError) ErrorName() string {
+	return "DeleteResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteResourceRequestValidationError{}
+
+// Validate checks the field values on DeleteResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteResourceResponseMultiError, or nil if none found.
+func (m *DeleteResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteResourceResponseMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteResourceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteResourceResponseMultiError) AllErrors() []error { return m }
+
+// DeleteResourceResponseValidationError is the validation error returned by
+// DeleteResourceResponse.Validate if the designated constraints aren't met.
+type DeleteResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteResourceResponseValidationError) ErrorName() string {
+	return "DeleteResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteResourceResponseValidationError{}
+
+// Validate checks the field values on ListAuthorizedResourcesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuthorizedResourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuthorizedResourcesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAuthorizedResourcesRequestMultiError, or nil if none found.
+func (m *ListAuthorizedResourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuthorizedResourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for ActionId
+
+	// no validation rules for UserId
+
+	// no validation rules for OrgId
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListAuthorizedResourcesRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListAuthorizedResourcesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListAuthorizedResourcesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListAuthorizedResourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuthorizedResourcesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuthorizedResourcesRequestMultiError) AllErrors() []error { return m }
+
+// ListAuthorizedResourcesRequestValidationError is the validation error
+// returned by ListAuthorizedResourcesRequest.Validate if the designated
+// constraints aren't met.
+type ListAuthorizedResourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuthorizedResourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuthorizedResourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuthorizedResourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuthorizedResourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuthorizedResourcesRequestValidationError) ErrorName() string {
+	return "ListAuthorizedResourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuthorizedResourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuthorizedResourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuthorizedResourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuthorizedResourcesRequestValidationError{}
+
+// Validate checks the field values on ListAuthorizedResourcesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResourceActionAuthzRequest) Validate() error {
+func (m *ListAuthorizedResourcesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuthorizedResourcesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAuthorizedResourcesResponseMultiError, or nil if none found.
+func (m *ListAuthorizedResourcesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuthorizedResourcesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuthorizedResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuthorizedResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuthorizedResourcesResponseValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListAuthorizedResourcesResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListAuthorizedResourcesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAuthorizedResourcesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListAuthorizedResourcesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuthorizedResourcesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuthorizedResourcesResponseMultiError) AllErrors() []error { return m }
+
+// ListAuthorizedResourcesResponseValidationError is the validation error
+// returned by ListAuthorizedResourcesResponse.Validate if the designated
+// constraints aren't met.
+type ListAuthorizedResourcesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuthorizedResourcesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuthorizedResourcesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuthorizedResourcesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuthorizedResourcesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuthorizedResourcesResponseValidationError) ErrorName() string {
+	return "ListAuthorizedResourcesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuthorizedResourcesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuthorizedResourcesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuthorizedResourcesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuthorizedResourcesResponseValidationError{}
+
+// Validate checks the field values on ResourceActionAuthzRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResourceActionAuthzRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceActionAuthzRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceActionAuthzRequestMultiError, or nil if none found.
+func (m *ResourceActionAuthzRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceActionAuthzRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ResourceActionAuthzRequest_ResourceId_Pattern.MatchString(m.GetResourceId()) {
+		err := ResourceActionAuthzRequestValidationError{
+			field:  "ResourceId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ResourceActionAuthzRequest_ActionId_Pattern.MatchString(m.GetActionId()) {
+		err := ResourceActionAuthzRequestValidationError{
+			field:  "ActionId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ResourceActionAuthzRequest_NamespaceId_Pattern.MatchString(m.GetNamespaceId()) {
+		err := ResourceActionAuthzRequestValidationError{
+			field:  "NamespaceId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResourceActionAuthzRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ResourceActionAuthzRequestMultiError is an error wrapping multiple
+// validation errors returned by ResourceActionAuthzRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ResourceActionAuthzRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceActionAuthzRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceActionAuthzRequestMultiError) AllErrors() []error { return m }
+
+// ResourceActionAuthzRequestValidationError is the validation error returned
+// by ResourceActionAuthzRequest.Validate if the designated constraints aren't met.
+type ResourceActionAuthzRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceActionAuthzRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceActionAuthzRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceActionAuthzRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceActionAuthzRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceActionAuthzRequestValidationError) ErrorName() string {
+	return "ResourceActionAuthzRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResourceActionAuthzRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceActionAuthzRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceActionAuthzRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceActionAuthzRequestValidationError{}
+
+var _ResourceActionAuthzRequest_ResourceId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _ResourceActionAuthzRequest_ActionId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _ResourceActionAuthzRequest_NamespaceId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ResourceActionAuthzResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResourceActionAuthzResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceActionAuthzResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceActionAuthzResponseMultiError, or nil if none found.
+func (m *ResourceActionAuthzResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceActionAuthzResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return ResourceActionAuthzResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ResourceActionAuthzResponseMultiError is an error wrapping multiple
+// validation errors returned by ResourceActionAuthzResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ResourceActionAuthzResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceActionAuthzResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceActionAuthzResponseMultiError) AllErrors() []error { return m }
+
+// ResourceActionAuthzResponseValidationError is the validation error returned
+// by ResourceActionAuthzResponse.Validate if the designated constraints
+// aren't met.
+type ResourceActionAuthzResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceActionAuthzResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceActionAuthzResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceActionAuthzResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceActionAuthzResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceActionAuthzResponseValidationError) ErrorName() string {
+	return "ResourceActionAuthzResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResourceActionAuthzResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceActionAuthzResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceActionAuthzResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceActionAuthzResponseValidationError{}
+
+// Validate checks the field values on PermissionCheck with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PermissionCheck) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionCheck with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionCheckMultiError, or nil if none found.
+func (m *PermissionCheck) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionCheck) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceId
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for ActionId
+
+	if len(errors) > 0 {
+		return PermissionCheckMultiError(errors)
+	}
+	return nil
+}
+
+// PermissionCheckMultiError is an error wrapping multiple validation errors
+// returned by PermissionCheck.ValidateAll() if the designated constraints
+// aren't met.
+type PermissionCheckMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionCheckMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionCheckMultiError) AllErrors() []error { return m }
+
+// PermissionCheckValidationError is the validation error returned by
+// PermissionCheck.Validate if the designated constraints aren't met.
+type PermissionCheckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionCheckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionCheckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionCheckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionCheckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionCheckValidationError) ErrorName() string { return "PermissionCheckValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PermissionCheckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionCheck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionCheckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionCheckValidationError{}
+
+// Validate checks the field values on PermissionCheckResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionCheckResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionCheckResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionCheckResultMultiError, or nil if none found.
+func (m *PermissionCheckResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionCheckResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceId
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for ActionId
+
+	// no validation rules for Allowed
+
+	if len(errors) > 0 {
+		return PermissionCheckResultMultiError(errors)
+	}
+	return nil
+}
+
+// PermissionCheckResultMultiError is an error wrapping multiple validation
+// errors returned by PermissionCheckResult.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionCheckResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionCheckResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionCheckResultMultiError) AllErrors() []error { return m }
+
+// PermissionCheckResultValidationError is the validation error returned by
+// PermissionCheckResult.Validate if the designated constraints aren't met.
+type PermissionCheckResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionCheckResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionCheckResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionCheckResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionCheckResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionCheckResultValidationError) ErrorName() string {
+	return "PermissionCheckResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionCheckResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionCheckResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionCheckResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionCheckResultValidationError{}
+
+// Validate checks the field values on CheckResourcePermissionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckResourcePermissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckResourcePermissionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckResourcePermissionsRequestMultiError, or nil if none found.
+func (m *CheckResourcePermissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourcePermissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChecks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckResourcePermissionsRequestValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckResourcePermissionsRequestValidationError{
+						field:  fmt.Sprintf("Checks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckResourcePermissionsRequestValidationError{
+					field:  fmt.Sprintf("Checks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckResourcePermissionsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// CheckResourcePermissionsRequestMultiError is an error wrapping multiple
+// validation errors returned by CheckResourcePermissionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CheckResourcePermissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourcePermissionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourcePermissionsRequestMultiError) AllErrors() []error { return m }
+
+// CheckResourcePermissionsRequestValidationError is the validation error
+// returned by CheckResourcePermissionsRequest.Validate if the designated
+// constraints aren't met.
+type CheckResourcePermissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourcePermissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourcePermissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourcePermissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResourcePermissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResourcePermissionsRequestValidationError) ErrorName() string {
+	return "CheckResourcePermissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckResourcePermissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResourcePermissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResourcePermissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResourcePermissionsRequestValidationError{}
+
+// Validate checks the field values on CheckResourcePermissionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CheckResourcePermissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckResourcePermissionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckResourcePermissionsResponseMultiError, or nil if none found.
+func (m *CheckResourcePermissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResourcePermissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckResourcePermissionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckResourcePermissionsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckResourcePermissionsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckResourcePermissionsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// CheckResourcePermissionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CheckResourcePermissionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckResourcePermissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResourcePermissionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResourcePermissionsResponseMultiError) AllErrors() []error { return m }
+
+// CheckResourcePermissionsResponseValidationError is the validation error
+// returned by CheckResourcePermissionsResponse.Validate if the designated
+// constraints aren't met.
+type CheckResourcePermissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResourcePermissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResourcePermissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResourcePermissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResourcePermissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResourcePermissionsResponseValidationError) ErrorName() string {
+	return "CheckResourcePermissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckResourcePermissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResourcePermissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResourcePermissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResourcePermissionsResponseValidationError{}
+
+// Validate checks the field values on PermissionExplanation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionExplanation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionExplanation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionExplanationMultiError, or nil if none found.
+func (m *PermissionExplanation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionExplanation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Object
+
+	// no validation rules for Name
+
+	// no validation rules for Expression
+
+	// no validation rules for Allowed
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PermissionExplanationValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PermissionExplanationValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PermissionExplanationValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Mismatch
+
+	if len(errors) > 0 {
+		return PermissionExplanationMultiError(errors)
+	}
+	return nil
+}
+
+// PermissionExplanationMultiError is an error wrapping multiple validation
+// errors returned by PermissionExplanation.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionExplanationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionExplanationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionExplanationMultiError) AllErrors() []error { return m }
+
+// PermissionExplanationValidationError is the validation error returned by
+// PermissionExplanation.Validate if the designated constraints aren't met.
+type PermissionExplanationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionExplanationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionExplanationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionExplanationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionExplanationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionExplanationValidationError) ErrorName() string {
+	return "PermissionExplanationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionExplanationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionExplanation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionExplanationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionExplanationValidationError{}
+
+// Validate checks the field values on ExplainResourcePermissionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ExplainResourcePermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainResourcePermissionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExplainResourcePermissionRequestMultiError, or nil if none found.
+func (m *ExplainResourcePermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainResourcePermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceId
+
+	// no validation rules for NamespaceId
+
+	// no validation rules for ActionId
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return ExplainResourcePermissionRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ExplainResourcePermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ExplainResourcePermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExplainResourcePermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainResourcePermissionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainResourcePermissionRequestMultiError) AllErrors() []error { return m }
+
+// ExplainResourcePermissionRequestValidationError is the validation error
+// returned by ExplainResourcePermissionRequest.Validate if the designated
+// constraints aren't met.
+type ExplainResourcePermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainResourcePermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainResourcePermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainResourcePermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainResourcePermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainResourcePermissionRequestValidationError) ErrorName() string {
+	return "ExplainResourcePermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainResourcePermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainResourcePermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainResourcePermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainResourcePermissionRequestValidationError{}
+
+// Validate checks the field values on ExplainResourcePermissionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ExplainResourcePermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExplainResourcePermissionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ExplainResourcePermissionResponseMultiError, or nil if none found.
+func (m *ExplainResourcePermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExplainResourcePermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	if all {
+		switch v := interface{}(m.GetExplanation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExplainResourcePermissionResponseValidationError{
+					field:  "Explanation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExplainResourcePermissionResponseValidationError{
+					field:  "Explanation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExplanation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainResourcePermissionResponseValidationError{
+				field:  "Explanation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExplainResourcePermissionResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ExplainResourcePermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ExplainResourcePermissionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExplainResourcePermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExplainResourcePermissionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExplainResourcePermissionResponseMultiError) AllErrors() []error { return m }
+
+// ExplainResourcePermissionResponseValidationError is the validation error
+// returned by ExplainResourcePermissionResponse.Validate if the designated
+// constraints aren't met.
+type ExplainResourcePermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainResourcePermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainResourcePermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainResourcePermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainResourcePermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainResourcePermissionResponseValidationError) ErrorName() string {
+	return "ExplainResourcePermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainResourcePermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainResourcePermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainResourcePermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainResourcePermissionResponseValidationError{}
+
+// Validate checks the field values on ServiceAccountRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ServiceAccountRequestBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceAccountRequestBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ServiceAccountRequestBodyMultiError, or nil if none found.
+func (m *ServiceAccountRequestBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceAccountRequestBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for OrgId
+
+	// no validation rules for ProjectId
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountRequestBodyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountRequestBodyValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountRequestBodyValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ServiceAccountRequestBodyMultiError(errors)
+	}
+	return nil
+}
+
+// ServiceAccountRequestBodyMultiError is an error wrapping multiple validation
+// errors returned by ServiceAccountRequestBody.ValidateAll() if the
+// designated constraints aren't met.
+type ServiceAccountRequestBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceAccountRequestBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceAccountRequestBodyMultiError) AllErrors() []error { return m }
+
+// ServiceAccountRequestBodyValidationError is the validation error returned by
+// ServiceAccountRequestBody.Validate if the designated constraints aren't met.
+type ServiceAccountRequestBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceAccountRequestBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceAccountRequestBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceAccountRequestBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceAccountRequestBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceAccountRequestBodyValidationError) ErrorName() string {
+	return "ServiceAccountRequestBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServiceAccountRequestBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceAccountRequestBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceAccountRequestBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceAccountRequestBodyValidationError{}
+
+// Validate checks the field values on ServiceAccount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ServiceAccount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceAccount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ServiceAccountMultiError,
+// or nil if none found.
+func (m *ServiceAccount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceAccount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OrgId
+
+	// no validation rules for ProjectId
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceAccountValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceAccountValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ServiceAccountMultiError(errors)
+	}
+	return nil
+}
+
+// ServiceAccountMultiError is an error wrapping multiple validation errors
+// returned by ServiceAccount.ValidateAll() if the designated constraints
+// aren't met.
+type ServiceAccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceAccountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceAccountMultiError) AllErrors() []error { return m }
+
+// ServiceAccountValidationError is the validation error returned by
+// ServiceAccount.Validate if the designated constraints aren't met.
+type ServiceAccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceAccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceAccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceAccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceAccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceAccountValidationError) ErrorName() string { return "ServiceAccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceAccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceAccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceAccountValidationError{}
+
+// Validate checks the field values on CreateServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateServiceAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateServiceAccountRequestMultiError, or nil if none found.
+func (m *CreateServiceAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateServiceAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateServiceAccountRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateServiceAccountRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateServiceAccountRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateServiceAccountRequestMultiError(errors)
+	}
+	return nil
+}
+
+// CreateServiceAccountRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateServiceAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateServiceAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateServiceAccountRequestMultiError) AllErrors() []error { return m }
+
+// CreateServiceAccountRequestValidationError is the validation error returned
+// by CreateServiceAccountRequest.Validate if the designated constraints
+// aren't met.
+type CreateServiceAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateServiceAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateServiceAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateServiceAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateServiceAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateServiceAccountRequestValidationError) ErrorName() string {
+	return "CreateServiceAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateServiceAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateServiceAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateServiceAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateServiceAccountRequestValidationError{}
+
+// Validate checks the field values on CreateServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateServiceAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateServiceAccountResponseMultiError, or nil if none found.
+func (m *CreateServiceAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateServiceAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetServiceAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateServiceAccountResponseValidationError{
+					field:  "ServiceAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateServiceAccountResponseValidationError{
+					field:  "ServiceAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetServiceAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateServiceAccountResponseValidationError{
+				field:  "ServiceAccount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateServiceAccountResponseMultiError(errors)
+	}
+	return nil
+}
+
+// CreateServiceAccountResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateServiceAccountResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateServiceAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateServiceAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateServiceAccountResponseMultiError) AllErrors() []error { return m }
+
+// CreateServiceAccountResponseValidationError is the validation error returned
+// by CreateServiceAccountResponse.Validate if the designated constraints
+// aren't met.
+type CreateServiceAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateServiceAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateServiceAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateServiceAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateServiceAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateServiceAccountResponseValidationError) ErrorName() string {
+	return "CreateServiceAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateServiceAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateServiceAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateServiceAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateServiceAccountResponseValidationError{}
+
+// Validate checks the field values on ListServiceAccountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListServiceAccountsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListServiceAccountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListServiceAccountsRequestMultiError, or nil if none found.
+func (m *ListServiceAccountsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListServiceAccountsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrgId
+
+	if len(errors) > 0 {
+		return ListServiceAccountsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListServiceAccountsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAccountsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAccountsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListServiceAccountsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListServiceAccountsRequestMultiError) AllErrors() []error { return m }
+
+// ListServiceAccountsRequestValidationError is the validation error returned
+// by ListServiceAccountsRequest.Validate if the designated constraints aren't met.
+type ListServiceAccountsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListServiceAccountsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListServiceAccountsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListServiceAccountsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListServiceAccountsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListServiceAccountsRequestValidationError) ErrorName() string {
+	return "ListServiceAccountsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListServiceAccountsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListServiceAccountsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListServiceAccountsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListServiceAccountsRequestValidationError{}
+
+// Validate checks the field values on ListServiceAccountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListServiceAccountsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListServiceAccountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListServiceAccountsResponseMultiError, or nil if none found.
+func (m *ListServiceAccountsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListServiceAccountsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetServiceAccounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServiceAccountsResponseValidationError{
+						field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServiceAccountsResponseValidationError{
+						field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListServiceAccountsResponseValidationError{
+					field:  fmt.Sprintf("ServiceAccounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListServiceAccountsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListServiceAccountsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAccountsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAccountsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListServiceAccountsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListServiceAccountsResponseMultiError) AllErrors() []error { return m }
+
+// ListServiceAccountsResponseValidationError is the validation error returned
+// by ListServiceAccountsResponse.Validate if the designated constraints
+// aren't met.
+type ListServiceAccountsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListServiceAccountsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListServiceAccountsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListServiceAccountsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListServiceAccountsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListServiceAccountsResponseValidationError) ErrorName() string {
+	return "ListServiceAccountsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListServiceAccountsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListServiceAccountsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListServiceAccountsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListServiceAccountsResponseValidationError{}
+
+// Validate checks the field values on GetServiceAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetServiceAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetServiceAccountRequestMultiError, or nil if none found.
+func (m *GetServiceAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetServiceAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetServiceAccountRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GetServiceAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by GetServiceAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetServiceAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetServiceAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetServiceAccountRequestMultiError) AllErrors() []error { return m }
+
+// GetServiceAccountRequestValidationError is the validation error returned by
+// GetServiceAccountRequest.Validate if the designated constraints aren't met.
+type GetServiceAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetServiceAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetServiceAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetServiceAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetServiceAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetServiceAccountRequestValidationError) ErrorName() string {
+	return "GetServiceAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetServiceAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetServiceAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetServiceAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetServiceAccountRequestValidationError{}
+
+// Validate checks the field values on GetServiceAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetServiceAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetServiceAccountResponseMultiError, or nil if none found.
+func (m *GetServiceAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetServiceAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetServiceAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetServiceAccountResponseValidationError{
+					field:  "ServiceAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetServiceAccountResponseValidationError{
+					field:  "ServiceAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetServiceAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetServiceAccountResponseValidationError{
+				field:  "ServiceAccount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetServiceAccountResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetServiceAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by GetServiceAccountResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetServiceAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetServiceAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetServiceAccountResponseMultiError) AllErrors() []error { return m }
+
+// GetServiceAccountResponseValidationError is the validation error returned by
+// GetServiceAccountResponse.Validate if the designated constraints aren't met.
+type GetServiceAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetServiceAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetServiceAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetServiceAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetServiceAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetServiceAccountResponseValidationError) ErrorName() string {
+	return "GetServiceAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetServiceAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetServiceAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetServiceAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetServiceAccountResponseValidationError{}
+
+// Validate checks the field values on DeleteServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteServiceAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteServiceAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteServiceAccountRequestMultiError, or nil if none found.
+func (m *DeleteServiceAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteServiceAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteServiceAccountRequestMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteServiceAccountRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteServiceAccountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteServiceAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteServiceAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteServiceAccountRequestMultiError) AllErrors() []error { return m }
+
+// DeleteServiceAccountRequestValidationError is the validation error returned
+// by DeleteServiceAccountRequest.Validate if the designated constraints
+// aren't met.
+type DeleteServiceAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteServiceAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteServiceAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteServiceAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteServiceAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteServiceAccountRequestValidationError) ErrorName() string {
+	return "DeleteServiceAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteServiceAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteServiceAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteServiceAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteServiceAccountRequestValidationError{}
+
+// Validate checks the field values on DeleteServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteServiceAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteServiceAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteServiceAccountResponseMultiError, or nil if none found.
+func (m *DeleteServiceAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteServiceAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteServiceAccountResponseMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteServiceAccountResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteServiceAccountResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteServiceAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteServiceAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteServiceAccountResponseMultiError) AllErrors() []error { return m }
+
+// DeleteServiceAccountResponseValidationError is the validation error returned
+// by DeleteServiceAccountResponse.Validate if the designated constraints
+// aren't met.
+type DeleteServiceAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteServiceAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteServiceAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteServiceAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteServiceAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteServiceAccountResponseValidationError) ErrorName() string {
+	return "DeleteServiceAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteServiceAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteServiceAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteServiceAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteServiceAccountResponseValidationError{}
+
+// Validate checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in APIKeyMultiError, or nil if none found.
+func (m *APIKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for ServiceAccountId
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "RevokedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return APIKeyMultiError(errors)
+	}
+	return nil
+}
+
+// APIKeyMultiError is an error wrapping multiple validation errors returned by
+// APIKey.ValidateAll() if the designated constraints aren't met.
+type APIKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIKeyMultiError) AllErrors() []error { return m }
+
+// APIKeyValidationError is the validation error returned by APIKey.Validate if
+// the designated constraints aren't met.
+type APIKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyValidationError) ErrorName() string { return "APIKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyValidationError{}
+
+// Validate checks the field values on APIKeyRequestBody with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *APIKeyRequestBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIKeyRequestBody with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// APIKeyRequestBodyMultiError, or nil if none found.
+func (m *APIKeyRequestBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIKeyRequestBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return APIKeyRequestBodyMultiError(errors)
+	}
+	return nil
+}
+
+// APIKeyRequestBodyMultiError is an error wrapping multiple validation errors
+// returned by APIKeyRequestBody.ValidateAll() if the designated constraints
+// aren't met.
+type APIKeyRequestBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIKeyRequestBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIKeyRequestBodyMultiError) AllErrors() []error { return m }
+
+// APIKeyRequestBodyValidationError is the validation error returned by
+// APIKeyRequestBody.Validate if the designated constraints aren't met.
+type APIKeyRequestBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyRequestBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyRequestBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyRequestBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyRequestBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyRequestBodyValidationError) ErrorName() string {
+	return "APIKeyRequestBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e APIKeyRequestBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKeyRequestBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyRequestBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyRequestBodyValidationError{}
+
+// Validate checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKeyRequestMultiError, or nil if none found.
+func (m *CreateAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPIKeyRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPIKeyRequestValidationError{
+					field:  "Body",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyRequestValidationError{
+				field:  "Body",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAPIKeyRequestMultiError(errors)
+	}
+	return nil
+}
+
+// CreateAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyRequestValidationError is the validation error returned by
+// CreateAPIKeyRequest.Validate if the designated constraints aren't met.
+type CreateAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyRequestValidationError) ErrorName() string {
+	return "CreateAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyRequestValidationError{}
+
+// Validate checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKeyResponseMultiError, or nil if none found.
+func (m *CreateAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAPIKeyResponseMultiError(errors)
+	}
+	return nil
+}
+
+// CreateAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyResponseValidationError is the validation error returned by
+// CreateAPIKeyResponse.Validate if the designated constraints aren't met.
+type CreateAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyResponseValidationError) ErrorName() string {
+	return "CreateAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyResponseValidationError{}
+
+// Validate checks the field values on ListAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAPIKeysRequestMultiError, or nil if none found.
+func (m *ListAPIKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ListAPIKeysRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListAPIKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAPIKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAPIKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListAPIKeysRequestValidationError is the validation error returned by
+// ListAPIKeysRequest.Validate if the designated constraints aren't met.
+type ListAPIKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysRequestValidationError) ErrorName() string {
+	return "ListAPIKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysRequestValidationError{}
+
+// Validate checks the field values on ListAPIKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAPIKeysResponseMultiError, or nil if none found.
+func (m *ListAPIKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAPIKeysResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAPIKeysResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListAPIKeysResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAPIKeysResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAPIKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeysResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeysResponseMultiError) AllErrors() []error { return m }
+
+// ListAPIKeysResponseValidationError is the validation error returned by
+// ListAPIKeysResponse.Validate if the designated constraints aren't met.
+type ListAPIKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysResponseValidationError) ErrorName() string {
+	return "ListAPIKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysResponseValidationError{}
+
+// Validate checks the field values on RotateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateAPIKeyRequestMultiError, or nil if none found.
+func (m *RotateAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for KeyId
+
+	// no validation rules for GracePeriod
+
+	if len(errors) > 0 {
+		return RotateAPIKeyRequestMultiError(errors)
+	}
+	return nil
+}
+
+// RotateAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RotateAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RotateAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateAPIKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// RotateAPIKeyRequestValidationError is the validation error returned by
+// RotateAPIKeyRequest.Validate if the designated constraints aren't met.
+type RotateAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateAPIKeyRequestValidationError) ErrorName() string {
+	return "RotateAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateAPIKeyRequestValidationError{}
+
+// Validate checks the field values on RotateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateAPIKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResourceActionAuthzRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RotateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResourceActionAuthzRequestMultiError, or nil if none found.
-func (m *ResourceActionAuthzRequest) ValidateAll() error {
+// RotateAPIKeyResponseMultiError, or nil if none found.
+func (m *RotateAPIKeyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResourceActionAuthzRequest) validate(all bool) error {
+func (m *RotateAPIKeyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_ResourceActionAuthzRequest_ResourceId_Pattern.MatchString(m.GetResourceId()) {
-		err := ResourceActionAuthzRequestValidationError{
-			field:  "ResourceId",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RotateAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RotateAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RotateAPIKeyResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if !_ResourceActionAuthzRequest_ActionId_Pattern.MatchString(m.GetActionId()) {
-		err := ResourceActionAuthzRequestValidationError{
-			field:  "ActionId",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return RotateAPIKeyResponseMultiError(errors)
 	}
+	return nil
+}
 
-	if !_ResourceActionAuthzRequest_NamespaceId_Pattern.MatchString(m.GetNamespaceId()) {
-		err := ResourceActionAuthzRequestValidationError{
-			field:  "NamespaceId",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+// RotateAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RotateAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RotateAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateAPIKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// RotateAPIKeyResponseValidationError is the validation error returned by
+// RotateAPIKeyResponse.Validate if the designated constraints aren't met.
+type RotateAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateAPIKeyResponseValidationError) ErrorName() string {
+	return "RotateAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateAPIKeyResponseValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKeyRequestMultiError, or nil if none found.
+func (m *RevokeAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for KeyId
+
 	if len(errors) > 0 {
-		return ResourceActionAuthzRequestMultiError(errors)
+		return RevokeAPIKeyRequestMultiError(errors)
 	}
 	return nil
 }
 
-// ResourceActionAuthzRequestMultiError is an error wrapping multiple
-// validation errors returned by ResourceActionAuthzRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ResourceActionAuthzRequestMultiError []error
+// RevokeAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResourceActionAuthzRequestMultiError) Error() string {
+func (m RevokeAPIKeyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17666,11 +21527,11 @@ func (m ResourceActionAuthzRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResourceActionAuthzRequestMultiError) AllErrors() []error { return m }
+func (m RevokeAPIKeyRequestMultiError) AllErrors() []error { return m }
 
-// ResourceActionAuthzRequestValidationError is the validation error returned
-// by ResourceActionAuthzRequest.Validate if the designated constraints aren't met.
-type ResourceActionAuthzRequestValidationError struct {
+// RevokeAPIKeyRequestValidationError is the validation error returned by
+// RevokeAPIKeyRequest.Validate if the designated constraints aren't met.
+type RevokeAPIKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17678,24 +21539,24 @@ type ResourceActionAuthzRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResourceActionAuthzRequestValidationError) Field() string { return e.field }
+func (e RevokeAPIKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResourceActionAuthzRequestValidationError) Reason() string { return e.reason }
+func (e RevokeAPIKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResourceActionAuthzRequestValidationError) Cause() error { return e.cause }
+func (e RevokeAPIKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResourceActionAuthzRequestValidationError) Key() bool { return e.key }
+func (e RevokeAPIKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResourceActionAuthzRequestValidationError) ErrorName() string {
-	return "ResourceActionAuthzRequestValidationError"
+func (e RevokeAPIKeyRequestValidationError) ErrorName() string {
+	return "RevokeAPIKeyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ResourceActionAuthzRequestValidationError) Error() string {
+func (e RevokeAPIKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17707,14 +21568,14 @@ func (e ResourceActionAuthzRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResourceActionAuthzRequest.%s: %s%s",
+		"invalid %sRevokeAPIKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResourceActionAuthzRequestValidationError{}
+var _ error = RevokeAPIKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17722,51 +21583,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResourceActionAuthzRequestValidationError{}
-
-var _ResourceActionAuthzRequest_ResourceId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-var _ResourceActionAuthzRequest_ActionId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-var _ResourceActionAuthzRequest_NamespaceId_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = RevokeAPIKeyRequestValidationError{}
 
-// Validate checks the field values on ResourceActionAuthzResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ResourceActionAuthzResponse) Validate() error {
+func (m *RevokeAPIKeyResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResourceActionAuthzResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResourceActionAuthzResponseMultiError, or nil if none found.
-func (m *ResourceActionAuthzResponse) ValidateAll() error {
+// RevokeAPIKeyResponseMultiError, or nil if none found.
+func (m *RevokeAPIKeyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResourceActionAuthzResponse) validate(all bool) error {
+func (m *RevokeAPIKeyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevokeAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevokeAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevokeAPIKeyResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ResourceActionAuthzResponseMultiError(errors)
+		return RevokeAPIKeyResponseMultiError(errors)
 	}
 	return nil
 }
 
-// ResourceActionAuthzResponseMultiError is an error wrapping multiple
-// validation errors returned by ResourceActionAuthzResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ResourceActionAuthzResponseMultiError []error
+// RevokeAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKeyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResourceActionAuthzResponseMultiError) Error() string {
+func (m RevokeAPIKeyResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17775,12 +21657,11 @@ func (m ResourceActionAuthzResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResourceActionAuthzResponseMultiError) AllErrors() []error { return m }
+func (m RevokeAPIKeyResponseMultiError) AllErrors() []error { return m }
 
-// ResourceActionAuthzResponseValidationError is the validation error returned
-// by ResourceActionAuthzResponse.Validate if the designated constraints
-// aren't met.
-type ResourceActionAuthzResponseValidationError struct {
+// RevokeAPIKeyResponseValidationError is the validation error returned by
+// RevokeAPIKeyResponse.Validate if the designated constraints aren't met.
+type RevokeAPIKeyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17788,24 +21669,24 @@ type ResourceActionAuthzResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResourceActionAuthzResponseValidationError) Field() string { return e.field }
+func (e RevokeAPIKeyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResourceActionAuthzResponseValidationError) Reason() string { return e.reason }
+func (e RevokeAPIKeyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResourceActionAuthzResponseValidationError) Cause() error { return e.cause }
+func (e RevokeAPIKeyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResourceActionAuthzResponseValidationError) Key() bool { return e.key }
+func (e RevokeAPIKeyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResourceActionAuthzResponseValidationError) ErrorName() string {
-	return "ResourceActionAuthzResponseValidationError"
+func (e RevokeAPIKeyResponseValidationError) ErrorName() string {
+	return "RevokeAPIKeyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ResourceActionAuthzResponseValidationError) Error() string {
+func (e RevokeAPIKeyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17817,14 +21698,14 @@ func (e ResourceActionAuthzResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResourceActionAuthzResponse.%s: %s%s",
+		"invalid %sRevokeAPIKeyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResourceActionAuthzResponseValidationError{}
+var _ error = RevokeAPIKeyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17832,4 +21713,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResourceActionAuthzResponseValidationError{}
+} = RevokeAPIKeyResponseValidationError{}
@@ -0,0 +1,504 @@
+package proxy
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/odpf/salt/log"
+)
+
+const (
+	PolicyRoundRobin     = "round_robin"
+	PolicyLeastRequests  = "least_requests"
+	PolicyConsistentHash = "consistent_hash"
+)
+
+var ErrNoEndpoints = errors.New("no endpoint available for backend")
+
+type LoadBalancerConfig struct {
+	Policy string `mapstructure:"policy"`
+
+	// HashOn is the request attribute hashed by consistent_hash policy, one
+	// of header:<name>, query:<name>, path_param:<name>, claim:<path> or
+	// identity:<email|project|organization>
+	HashOn string `mapstructure:"hash_on"`
+
+	// HashAttribute is hashed instead of HashOn, it's extracted like
+	// attributes of middlewares e.g. from json or grpc payload
+	HashAttribute middleware.Attribute `mapstructure:"hash_attribute"`
+
+	HealthCheck HealthCheckConfig `mapstructure:"health_check"`
+	Ejection    EjectionConfig    `mapstructure:"ejection"`
+}
+
+// HealthCheckConfig of active checks, endpoints are checked only if the
+// health_check block is set
+type HealthCheckConfig struct {
+	// Type is http or grpc, grpc uses grpc.health.v1.Health/Check
+	Type     string        `mapstructure:"type"`
+	Path     string        `mapstructure:"path"`
+	Service  string        `mapstructure:"service"`
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+
+	HealthyThreshold   int `mapstructure:"healthy_threshold"`
+	UnhealthyThreshold int `mapstructure:"unhealthy_threshold"`
+
+	enabled bool
+}
+
+// EjectionConfig of passive checks, an endpoint failing consecutively is
+// taken out of rotation for a while
+type EjectionConfig struct {
+	ConsecutiveFailures int           `mapstructure:"consecutive_failures"`
+	Duration            time.Duration `mapstructure:"duration"`
+}
+
+// Balancer keeps upstreams of backends of a proxy service along with health
+// checks of their endpoints, circuit breakers and retry budgets. Upstreams
+// are reused across rule reloads as long as the backend doesn't change.
+type Balancer struct {
+	log log.Logger
+
+	mu        sync.Mutex
+	upstreams map[string]*Upstream
+}
+
+func NewBalancer(logger log.Logger) *Balancer {
+	return &Balancer{
+		log:       logger,
+		upstreams: map[string]*Upstream{},
+	}
+}
+
+// upstreamConfig is everything configured on a backend, it also keys the
+// upstream of the backend
+type upstreamConfig struct {
+	Balanced       bool
+	LoadBalancer   LoadBalancerConfig
+	Timeouts       TimeoutConfig
+	Retries        RetryConfig
+	CircuitBreaker CircuitBreakerConfig
+	TLS            BackendTLSConfig
+}
+
+// CompileBackend returns the upstream of a backend with multiple targets or
+// any of load balancer, timeouts, retries, circuit breaker or tls configured,
+// nil is returned for plain backends
+func (b *Balancer) CompileBackend(backend structs.Backend) (interface{}, error) {
+	balanced := len(backend.Targets) > 1 || len(backend.LoadBalancer) > 0
+	if !balanced && len(backend.Timeouts) == 0 && len(backend.Retries) == 0 &&
+		backend.CircuitBreaker == nil && len(backend.TLS) == 0 {
+		return nil, nil
+	}
+
+	conf := upstreamConfig{Balanced: balanced}
+	var endpoints []*endpoint
+	if balanced {
+		lbConf, err := parseLoadBalancerConfig(backend.LoadBalancer)
+		if err != nil {
+			return nil, err
+		}
+		conf.LoadBalancer = *lbConf
+		if endpoints, err = parseTargets(backend.Targets); err != nil {
+			return nil, err
+		}
+	}
+	var err error
+	if conf.Timeouts, err = parseTimeoutConfig(backend.Timeouts); err != nil {
+		return nil, fmt.Errorf("timeouts: %w", err)
+	}
+	if conf.Retries, err = parseRetryConfig(backend.Retries); err != nil {
+		return nil, fmt.Errorf("retries: %w", err)
+	}
+	if conf.CircuitBreaker, err = parseCircuitBreakerConfig(backend.CircuitBreaker); err != nil {
+		return nil, fmt.Errorf("circuit_breaker: %w", err)
+	}
+	if conf.TLS, err = parseBackendTLSConfig(backend.TLS); err != nil {
+		return nil, fmt.Errorf("tls: %w", err)
+	}
+
+	key := fmt.Sprintf("%s|%s|%+v", backend.Namespace, strings.Join(backend.Targets, ","), conf)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if upstream, ok := b.upstreams[key]; ok {
+		return upstream, nil
+	}
+	tlsConf, err := conf.TLS.clientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("tls: %w", err)
+	}
+	var pool *Pool
+	if balanced {
+		pool = newPool(b.log, backend.Namespace, conf.LoadBalancer, tlsConf, endpoints)
+	}
+	upstream := newUpstream(b.log, backend.Namespace, conf, tlsConf, pool)
+	b.upstreams[key] = upstream
+	upstream.start()
+	return upstream, nil
+}
+
+// Sync stops upstreams which are not used by rulesets anymore
+func (b *Balancer) Sync(rulesets []structs.Ruleset) {
+	used := map[*Upstream]bool{}
+	for _, ruleset := range rulesets {
+		for _, rule := range ruleset.Rules {
+			if upstream, ok := rule.Backend.Compiled.(*Upstream); ok {
+				used[upstream] = true
+			}
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for key, upstream := range b.upstreams {
+		if !used[upstream] {
+			upstream.stop()
+			delete(b.upstreams, key)
+		}
+	}
+}
+
+func (b *Balancer) Close() error {
+	b.Sync(nil)
+	return nil
+}
+
+// decodeConfig decodes a backend config block, durations are given as
+// strings like 500ms or 2s
+func decodeConfig(rawConfig map[string]interface{}, out interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+		Result:     out,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(rawConfig)
+}
+
+func parseLoadBalancerConfig(rawConfig map[string]interface{}) (*LoadBalancerConfig, error) {
+	conf := &LoadBalancerConfig{}
+	if err := decodeConfig(rawConfig, conf); err != nil {
+		return nil, err
+	}
+
+	switch conf.Policy {
+	case "":
+		conf.Policy = PolicyRoundRobin
+	case PolicyRoundRobin, PolicyLeastRequests:
+	case PolicyConsistentHash:
+		switch {
+		case conf.HashAttribute.Type != "" && conf.HashOn != "":
+			return nil, errors.New("only one of hash_on and hash_attribute can be set")
+		case conf.HashAttribute.Type != "":
+			if err := conf.HashAttribute.Validate(); err != nil {
+				return nil, fmt.Errorf("hash_attribute: %w", err)
+			}
+		default:
+			if _, _, err := parseHashOn(conf.HashOn); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown load balancer policy %s", conf.Policy)
+	}
+
+	if _, ok := rawConfig["health_check"]; ok {
+		hc := &conf.HealthCheck
+		hc.enabled = true
+		switch hc.Type {
+		case "":
+			hc.Type = "http"
+		case "http", "grpc":
+		default:
+			return nil, fmt.Errorf("unknown health check type %s", hc.Type)
+		}
+		if hc.Type == "http" && hc.Path == "" {
+			hc.Path = "/ping"
+		}
+		if hc.Interval <= 0 {
+			hc.Interval = 10 * time.Second
+		}
+		if hc.Timeout <= 0 {
+			hc.Timeout = 2 * time.Second
+		}
+		if hc.HealthyThreshold <= 0 {
+			hc.HealthyThreshold = 1
+		}
+		if hc.UnhealthyThreshold <= 0 {
+			hc.UnhealthyThreshold = 2
+		}
+	}
+
+	if conf.Ejection.ConsecutiveFailures <= 0 {
+		conf.Ejection.ConsecutiveFailures = 5
+	}
+	if conf.Ejection.Duration <= 0 {
+		conf.Ejection.Duration = 30 * time.Second
+	}
+	return conf, nil
+}
+
+// parseTargets parses target urls, they may only differ in scheme and host
+// as the request path is joined with the target path by Director
+func parseTargets(targets []string) ([]*endpoint, error) {
+	if len(targets) == 0 {
+		return nil, errors.New("at least one target is required")
+	}
+
+	var endpoints []*endpoint
+	for _, target := range targets {
+		u, err := url.Parse(target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target %s: %w", target, err)
+		}
+		if u.Host == "" {
+			return nil, fmt.Errorf("invalid target %s: host is required", target)
+		}
+		if len(endpoints) > 0 {
+			first := endpoints[0].url
+			if u.Path != first.Path || u.RawQuery != first.RawQuery {
+				return nil, fmt.Errorf("target %s must have the same path as %s", target, first)
+			}
+		}
+		endpoints = append(endpoints, &endpoint{url: u, healthy: true})
+	}
+	return endpoints, nil
+}
+
+func parseHashOn(hashOn string) (string, string, error) {
+	parts := strings.SplitN(hashOn, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("hash_on must be <source>:<key>, got %q", hashOn)
+	}
+	switch parts[0] {
+	case "header", "query", "path_param", "claim":
+	case "identity":
+		switch parts[1] {
+		case "email", "project", "organization":
+		default:
+			return "", "", fmt.Errorf("unknown identity attribute %s", parts[1])
+		}
+	default:
+		return "", "", fmt.Errorf("unknown hash_on source %s", parts[0])
+	}
+	return parts[0], parts[1], nil
+}
+
+// Pool balances requests of a backend over its endpoints
+type Pool struct {
+	log     log.Logger
+	name    string
+	config  LoadBalancerConfig
+	hashSrc string
+	hashKey string
+
+	// tlsConfig is used by health checks of https targets
+	tlsConfig *tls.Config
+
+	endpoints []*endpoint
+	next      uint64
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func newPool(logger log.Logger, name string, conf LoadBalancerConfig, tlsConf *tls.Config, endpoints []*endpoint) *Pool {
+	pool := &Pool{
+		log:       logger,
+		name:      name,
+		config:    conf,
+		tlsConfig: tlsConf,
+		endpoints: endpoints,
+		stopCh:    make(chan struct{}),
+	}
+	if conf.Policy == PolicyConsistentHash && conf.HashAttribute.Type == "" {
+		pool.hashSrc, pool.hashKey, _ = parseHashOn(conf.HashOn)
+	}
+	return pool
+}
+
+func (p *Pool) start() {
+	if p.config.HealthCheck.enabled {
+		go p.runHealthChecks()
+	}
+}
+
+func (p *Pool) stop() {
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+	})
+}
+
+// pick selects an endpoint for the request among available endpoints, all
+// endpoints are considered when none of them is available
+func (p *Pool) pick(req *http.Request) (*endpoint, error) {
+	if len(p.endpoints) == 0 {
+		return nil, ErrNoEndpoints
+	}
+
+	now := time.Now()
+	candidates := make([]*endpoint, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		if e.available(now) {
+			candidates = append(candidates, e)
+		}
+	}
+	if len(candidates) == 0 {
+		p.log.Warn("no healthy endpoint, balancing over all of them", "backend", p.name)
+		candidates = p.endpoints
+	}
+
+	switch p.config.Policy {
+	case PolicyLeastRequests:
+		return leastRequests(candidates), nil
+	case PolicyConsistentHash:
+		if key := p.requestHashKey(req); key != "" {
+			return rendezvous(candidates, key), nil
+		}
+	}
+	return candidates[atomic.AddUint64(&p.next, 1)%uint64(len(candidates))], nil
+}
+
+func (p *Pool) requestHashKey(req *http.Request) string {
+	if p.config.HashAttribute.Type != "" {
+		key, err := middleware.AttributeValue(req, p.config.HashAttribute)
+		if err != nil {
+			p.log.Debug("failed to extract hash attribute", "backend", p.name, "err", err)
+			return ""
+		}
+		return key
+	}
+	switch p.hashSrc {
+	case "header":
+		return req.Header.Get(p.hashKey)
+	case "query":
+		return req.URL.Query().Get(p.hashKey)
+	case "path_param":
+		params, _ := middleware.ExtractPathParams(req)
+		return params[p.hashKey]
+	case "claim":
+		if claim, ok := middleware.ExtractClaim(req, p.hashKey); ok && claim != nil {
+			return fmt.Sprint(claim)
+		}
+	case "identity":
+		identity, _ := middleware.ExtractIdentity(req)
+		switch p.hashKey {
+		case "email":
+			return identity.Email
+		case "project":
+			return identity.ProjectId
+		case "organization":
+			return identity.OrganizationId
+		}
+	}
+	return ""
+}
+
+// leastRequests picks the less loaded of two random endpoints
+func leastRequests(candidates []*endpoint) *endpoint {
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	i := rand.Intn(len(candidates))
+	j := rand.Intn(len(candidates) - 1)
+	if j >= i {
+		j++
+	}
+	a, b := candidates[i], candidates[j]
+	if atomic.LoadInt64(&b.active) < atomic.LoadInt64(&a.active) {
+		return b
+	}
+	return a
+}
+
+// rendezvous picks the endpoint with the highest hash for the key, only
+// keys of an endpoint are moved when it goes out of rotation
+func rendezvous(candidates []*endpoint, key string) *endpoint {
+	var (
+		best      *endpoint
+		bestScore uint64
+	)
+	for _, e := range candidates {
+		h := fnv.New64a()
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+		h.Write([]byte(e.url.Host))
+		if score := h.Sum64(); best == nil || score > bestScore {
+			best, bestScore = e, score
+		}
+	}
+	return best
+}
+
+type endpoint struct {
+	url    *url.URL
+	active int64
+
+	mu                  sync.Mutex
+	healthy             bool
+	checkStreak         int
+	consecutiveFailures int
+	ejectedUntil        time.Time
+}
+
+func (e *endpoint) available(now time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.healthy && !now.Before(e.ejectedUntil)
+}
+
+// direct points the request to the endpoint
+func (e *endpoint) direct(req *http.Request) {
+	req.URL.Scheme = e.url.Scheme
+	if req.URL.Scheme == "" {
+		req.URL.Scheme = "http"
+	}
+	req.URL.Host = e.url.Host
+	req.Host = e.url.Host
+}
+
+func (e *endpoint) begin() {
+	atomic.AddInt64(&e.active, 1)
+}
+
+// release ends a request without recording its outcome
+func (e *endpoint) release() {
+	atomic.AddInt64(&e.active, -1)
+}
+
+// end records the outcome of a request, the endpoint is ejected after
+// consecutive backend failures
+func (e *endpoint) end(res *http.Response, err error, conf EjectionConfig) bool {
+	e.release()
+	failed := isBackendFailure(res, err)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !failed {
+		e.consecutiveFailures = 0
+		return false
+	}
+	e.consecutiveFailures++
+	if e.consecutiveFailures < conf.ConsecutiveFailures {
+		return false
+	}
+	e.consecutiveFailures = 0
+	e.ejectedUntil = time.Now().Add(conf.Duration)
+	return true
+}
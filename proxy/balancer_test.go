@@ -0,0 +1,224 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func compilePool(t *testing.T, b *Balancer, targets []string, lb map[string]interface{}) *Pool {
+	compiled, err := b.CompileBackend(structs.Backend{Namespace: "entropy", Targets: targets, LoadBalancer: lb})
+	assert.NoError(t, err)
+	upstream, ok := compiled.(*Upstream)
+	assert.True(t, ok)
+	return upstream.pool
+}
+
+func TestCompileBackend(t *testing.T) {
+	b := NewBalancer(log.NewNoop())
+	defer b.Close()
+
+	compiled, err := b.CompileBackend(structs.Backend{Targets: []string{"http://a:80"}})
+	assert.NoError(t, err)
+	assert.Nil(t, compiled)
+
+	first := compilePool(t, b, []string{"http://a:80/api", "http://b:80/api"}, nil)
+	assert.Equal(t, PolicyRoundRobin, first.config.Policy)
+	assert.Same(t, first, compilePool(t, b, []string{"http://a:80/api", "http://b:80/api"}, nil))
+
+	invalid := []map[string]interface{}{
+		{"policy": "random"},
+		{"policy": "consistent_hash"},
+		{"policy": "consistent_hash", "hash_on": "cookie:session"},
+		{"policy": "consistent_hash", "hash_attribute": map[string]interface{}{"type": "json_payload"}},
+		{"policy": "consistent_hash", "hash_on": "header:X-Tenant", "hash_attribute": map[string]interface{}{"type": "json_payload", "key": "tenant"}},
+		{"health_check": map[string]interface{}{"type": "tcp"}},
+	}
+	for _, lb := range invalid {
+		_, err := b.CompileBackend(structs.Backend{Targets: []string{"http://a:80", "http://b:80"}, LoadBalancer: lb})
+		assert.Error(t, err, lb)
+	}
+
+	_, err = b.CompileBackend(structs.Backend{Targets: []string{"http://a:80/v1", "http://b:80/v2"}})
+	assert.Error(t, err)
+
+	upstream, _ := b.CompileBackend(structs.Backend{Namespace: "entropy", Targets: []string{"http://a:80/api", "http://b:80/api"}})
+	b.Sync([]structs.Ruleset{{Rules: []structs.Rule{{Backend: structs.Backend{Compiled: upstream}}}}})
+	assert.Len(t, b.upstreams, 1)
+	b.Sync(nil)
+	assert.Len(t, b.upstreams, 0)
+}
+
+func TestPoolPick(t *testing.T) {
+	b := NewBalancer(log.NewNoop())
+	defer b.Close()
+	targets := []string{"http://a:80", "http://b:80", "http://c:80"}
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	t.Run("round robin", func(t *testing.T) {
+		pool := compilePool(t, b, targets, map[string]interface{}{"policy": "round_robin"})
+		seen := map[string]int{}
+		for i := 0; i < 30; i++ {
+			e, err := pool.pick(req)
+			assert.NoError(t, err)
+			seen[e.url.Host]++
+		}
+		assert.Equal(t, map[string]int{"a:80": 10, "b:80": 10, "c:80": 10}, seen)
+	})
+
+	t.Run("least requests", func(t *testing.T) {
+		pool := compilePool(t, b, targets[:2], map[string]interface{}{"policy": "least_requests"})
+		pool.endpoints[0].begin()
+		for i := 0; i < 10; i++ {
+			e, _ := pool.pick(req)
+			assert.Equal(t, "b:80", e.url.Host)
+		}
+	})
+
+	t.Run("consistent hash", func(t *testing.T) {
+		pool := compilePool(t, b, targets, map[string]interface{}{"policy": "consistent_hash", "hash_on": "header:X-Tenant"})
+		owners := map[string]string{}
+		for i := 0; i < 50; i++ {
+			tenant := fmt.Sprintf("tenant-%d", i)
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("X-Tenant", tenant)
+			e, _ := pool.pick(r)
+			again, _ := pool.pick(r)
+			assert.Same(t, e, again)
+			owners[tenant] = e.url.Host
+		}
+
+		// only keys of the ejected endpoint move
+		pool.endpoints[0].ejectedUntil = time.Now().Add(time.Minute)
+		for tenant, owner := range owners {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("X-Tenant", tenant)
+			e, _ := pool.pick(r)
+			if owner != "a:80" {
+				assert.Equal(t, owner, e.url.Host)
+			} else {
+				assert.NotEqual(t, "a:80", e.url.Host)
+			}
+		}
+	})
+}
+
+func TestPoolPickHashAttribute(t *testing.T) {
+	b := NewBalancer(log.NewNoop())
+	defer b.Close()
+	pool := compilePool(t, b, []string{"http://a:80", "http://b:80", "http://c:80"}, map[string]interface{}{
+		"policy":         "consistent_hash",
+		"hash_attribute": map[string]interface{}{"type": "json_payload", "key": "tenant"},
+	})
+
+	pick := func(body string) *endpoint {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		assert.NoError(t, middleware.EnrichRequestBody(r, 0))
+		e, err := pool.pick(r)
+		assert.NoError(t, err)
+		return e
+	}
+	for i := 0; i < 20; i++ {
+		body := fmt.Sprintf(`{"tenant":"tenant-%d"}`, i)
+		assert.Same(t, pick(body), pick(body))
+	}
+}
+
+func TestPassiveEjection(t *testing.T) {
+	b := NewBalancer(log.NewNoop())
+	defer b.Close()
+	pool := compilePool(t, b, []string{"http://a:80", "http://b:80"}, map[string]interface{}{
+		"ejection": map[string]interface{}{"consecutive_failures": 2, "duration": "1m"},
+	})
+	conf := pool.config.Ejection
+	a := pool.endpoints[0]
+
+	a.begin()
+	assert.False(t, a.end(nil, errors.New("connection refused"), conf))
+	a.begin()
+	assert.False(t, a.end(&http.Response{StatusCode: http.StatusOK}, nil, conf))
+	a.begin()
+	assert.False(t, a.end(&http.Response{StatusCode: http.StatusServiceUnavailable}, nil, conf))
+	a.begin()
+	assert.True(t, a.end(nil, errors.New("connection refused"), conf))
+	a.ejectedUntil = time.Time{}
+
+	// trailers-only grpc responses fail with a status of their own
+	unavailable := &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Grpc-Status": []string{strconv.Itoa(int(codes.Unavailable))}}}
+	a.begin()
+	assert.False(t, a.end(unavailable, nil, conf))
+	a.begin()
+	assert.True(t, a.end(unavailable, nil, conf))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	for i := 0; i < 4; i++ {
+		e, _ := pool.pick(req)
+		assert.Equal(t, "b:80", e.url.Host)
+	}
+
+	// all endpoints are used when none is available
+	pool.endpoints[1].ejectedUntil = time.Now().Add(time.Minute)
+	_, err := pool.pick(req)
+	assert.NoError(t, err)
+}
+
+func TestHealthCheck(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer backend.Close()
+
+	b := NewBalancer(log.NewNoop())
+	defer b.Close()
+	pool := compilePool(t, b, []string{backend.URL, "http://127.0.0.1:1"}, map[string]interface{}{
+		"health_check": map[string]interface{}{"path": "/healthz", "interval": "10ms", "timeout": "100ms", "unhealthy_threshold": 1},
+	})
+
+	assert.Eventually(t, func() bool {
+		return pool.endpoints[0].available(time.Now()) && !pool.endpoints[1].available(time.Now())
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestEjectionIgnoresClientCancellation(t *testing.T) {
+	b := NewBalancer(log.NewNoop())
+	defer b.Close()
+	upstream := compileUpstream(t, b, structs.Backend{
+		Namespace:    "entropy",
+		Targets:      []string{"http://a:80", "http://b:80"},
+		LoadBalancer: map[string]interface{}{"ejection": map[string]interface{}{"consecutive_failures": 1}},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, req.Context().Err()
+	})
+	for i := 0; i < 4; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+		_, err := upstream.send(ctx, transport, req)
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+	for _, e := range upstream.pool.endpoints {
+		assert.True(t, e.available(time.Now()), e.url.Host)
+		assert.Zero(t, e.active)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
@@ -0,0 +1,136 @@
+package proxy
+
+import (
+	"sync"
+	"time"
+)
+
+type CircuitBreakerConfig struct {
+	// ConsecutiveFailures of the backend which open the breaker, requests
+	// fail right away while it is open
+	ConsecutiveFailures int `mapstructure:"consecutive_failures"`
+
+	// OpenDuration is how long the breaker stays open before letting
+	// HalfOpenRequests through to probe if the backend recovered
+	OpenDuration     time.Duration `mapstructure:"open_duration"`
+	HalfOpenRequests int           `mapstructure:"half_open_requests"`
+
+	enabled bool
+}
+
+func parseCircuitBreakerConfig(rawConfig map[string]interface{}) (CircuitBreakerConfig, error) {
+	conf := CircuitBreakerConfig{}
+	if rawConfig == nil {
+		return conf, nil
+	}
+	if err := decodeConfig(rawConfig, &conf); err != nil {
+		return conf, err
+	}
+	conf.enabled = true
+	if conf.ConsecutiveFailures <= 0 {
+		conf.ConsecutiveFailures = 5
+	}
+	if conf.OpenDuration <= 0 {
+		conf.OpenDuration = 30 * time.Second
+	}
+	if conf.HalfOpenRequests <= 0 {
+		conf.HalfOpenRequests = 1
+	}
+	return conf, nil
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+type circuitBreaker struct {
+	conf CircuitBreakerConfig
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probes   int
+}
+
+func newCircuitBreaker(conf CircuitBreakerConfig) *circuitBreaker {
+	return &circuitBreaker{conf: conf}
+}
+
+// allow tells if a request may be sent to the backend, every allowed request
+// must be followed by record or release
+func (c *circuitBreaker) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case breakerOpen:
+		if time.Since(c.openedAt) < c.conf.OpenDuration {
+			return false
+		}
+		c.state = breakerHalfOpen
+		c.probes = 0
+		fallthrough
+	case breakerHalfOpen:
+		if c.probes >= c.conf.HalfOpenRequests {
+			return false
+		}
+		c.probes++
+	}
+	return true
+}
+
+// record the outcome of an allowed request, a failed probe opens the breaker
+// again and a successful one closes it. true is returned if the breaker was
+// opened.
+func (c *circuitBreaker) record(failed bool) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case breakerClosed:
+		if !failed {
+			c.failures = 0
+			return false
+		}
+		c.failures++
+		if c.failures >= c.conf.ConsecutiveFailures {
+			c.open()
+			return true
+		}
+	case breakerHalfOpen:
+		c.releaseProbe()
+		if failed {
+			c.open()
+			return true
+		}
+		c.state = breakerClosed
+		c.failures = 0
+	}
+	return false
+}
+
+// release an allowed request whose outcome says nothing about the backend
+func (c *circuitBreaker) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.state == breakerHalfOpen {
+		c.releaseProbe()
+	}
+}
+
+func (c *circuitBreaker) releaseProbe() {
+	if c.probes > 0 {
+		c.probes--
+	}
+}
+
+func (c *circuitBreaker) open() {
+	c.state = breakerOpen
+	c.openedAt = time.Now()
+	c.failures = 0
+}
@@ -1,40 +1,85 @@
 package proxy
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httputil"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
+
+	"google.golang.org/grpc/codes"
 )
 
+// statusClientClosedRequest is used when the client went away before the
+// backend replied, no response reaches the client anyway
+const statusClientClosedRequest = 499
+
 // H2c
 type H2c struct {
-	proxy        *httputil.ReverseProxy
-	bufferPool   httputil.BufferPool
-	roundTripper http.RoundTripper
-
-	director RequestDirector
+	proxy *httputil.ReverseProxy
 }
 
+// NewH2c configures the reverse proxy once, it is shared by concurrent
+// requests
 func NewH2c(roundTripper http.RoundTripper, director RequestDirector) *H2c {
 	return &H2c{
-		proxy:        &httputil.ReverseProxy{},
-		bufferPool:   newBufferPool(),
-		roundTripper: roundTripper,
-		director:     director,
+		proxy: &httputil.ReverseProxy{
+			Transport:     roundTripper,
+			FlushInterval: 100 * time.Millisecond,
+			BufferPool:    newBufferPool(),
+			Director:      director.Direct,
+			ErrorHandler:  handleProxyError,
+		},
 	}
 }
 
 func (p *H2c) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("X-Forwarded-For", r.Host)
-
-	p.proxy.Transport = p.roundTripper
-	p.proxy.FlushInterval = 100 * time.Millisecond
-	p.proxy.BufferPool = p.bufferPool
-	p.proxy.Director = p.director.Direct
 	p.proxy.ServeHTTP(w, r)
 }
 
+// handleProxyError replies to requests the backend couldn't serve, grpc
+// clients get a trailers-only response with the grpc status of the error
+func handleProxyError(w http.ResponseWriter, r *http.Request, err error) {
+	status, code := http.StatusBadGateway, codes.Unavailable
+	switch {
+	case errors.Is(err, ErrCircuitOpen), errors.Is(err, ErrNoEndpoints):
+		status = http.StatusServiceUnavailable
+	case errors.Is(err, ErrBackendTimeout):
+		status, code = http.StatusGatewayTimeout, codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		status, code = statusClientClosedRequest, codes.Canceled
+	}
+
+	if !isGRPCRequest(r) {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/grpc")
+	w.Header().Set("Grpc-Status", strconv.Itoa(int(code)))
+	w.Header().Set("Grpc-Message", encodeGrpcMessage(err.Error()))
+	w.WriteHeader(http.StatusOK)
+}
+
+// encodeGrpcMessage percent encodes a grpc-message header value as the grpc
+// http2 protocol asks
+func encodeGrpcMessage(msg string) string {
+	var sb strings.Builder
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			sb.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&sb, "%%%02X", c)
+	}
+	return sb.String()
+}
+
 const bufferPoolSize = 32 * 1024
 
 func newBufferPool() *bufferPool {
@@ -0,0 +1,173 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// runHealthChecks checks all endpoints every interval until the pool is stopped
+func (p *Pool) runHealthChecks() {
+	conf := p.config.HealthCheck
+	checker := newHealthChecker(conf, p.tlsConfig)
+	defer checker.close()
+
+	ticker := time.NewTicker(conf.Interval)
+	defer ticker.Stop()
+	for {
+		var wg sync.WaitGroup
+		for _, e := range p.endpoints {
+			wg.Add(1)
+			go func(e *endpoint) {
+				defer wg.Done()
+				ctx, cancel := context.WithTimeout(context.Background(), conf.Timeout)
+				defer cancel()
+
+				err := checker.check(ctx, e)
+				if changed, healthy := e.recordCheck(err == nil, conf); changed {
+					p.log.Warn("backend endpoint health changed", "backend", p.name,
+						"endpoint", e.url.Host, "healthy", healthy, "err", err)
+				}
+			}(e)
+		}
+		wg.Wait()
+
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// recordCheck updates health after a check, the state flips only after the
+// configured number of checks in a row
+func (e *endpoint) recordCheck(passed bool, conf HealthCheckConfig) (bool, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if passed == e.healthy {
+		e.checkStreak = 0
+		return false, e.healthy
+	}
+
+	e.checkStreak++
+	threshold := conf.UnhealthyThreshold
+	if passed {
+		threshold = conf.HealthyThreshold
+	}
+	if e.checkStreak < threshold {
+		return false, e.healthy
+	}
+
+	e.checkStreak = 0
+	e.healthy = passed
+	return true, e.healthy
+}
+
+type healthChecker struct {
+	conf      HealthCheckConfig
+	tlsConfig *tls.Config
+	client    *http.Client
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func newHealthChecker(conf HealthCheckConfig, tlsConf *tls.Config) *healthChecker {
+	return &healthChecker{
+		conf:      conf,
+		tlsConfig: tlsConf,
+		client: &http.Client{
+			Timeout:   conf.Timeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConf},
+		},
+		conns: map[string]*grpc.ClientConn{},
+	}
+}
+
+func (c *healthChecker) check(ctx context.Context, e *endpoint) error {
+	if c.conf.Type == "grpc" {
+		return c.checkGRPC(ctx, e)
+	}
+	return c.checkHTTP(ctx, e)
+}
+
+func (c *healthChecker) checkHTTP(ctx context.Context, e *endpoint) error {
+	scheme := e.url.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, scheme+"://"+e.url.Host+c.conf.Path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "shield-health-check")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 400 {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func (c *healthChecker) checkGRPC(ctx context.Context, e *endpoint) error {
+	conn, err := c.conn(e.url.Host, e.url.Scheme == "https")
+	if err != nil {
+		return err
+	}
+
+	res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: c.conf.Service})
+	if err != nil {
+		return err
+	}
+	if res.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("service is %s", res.Status)
+	}
+	return nil
+}
+
+// conn returns a connection kept open between checks, grpc reconnects it
+// if the endpoint goes away
+func (c *healthChecker) conn(host string, secure bool) (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if conn, ok := c.conns[host]; ok {
+		return conn, nil
+	}
+	creds := grpc.WithInsecure()
+	if secure {
+		tlsConf := &tls.Config{}
+		if c.tlsConfig != nil {
+			tlsConf = c.tlsConfig.Clone()
+		}
+		creds = grpc.WithTransportCredentials(credentials.NewTLS(tlsConf))
+	}
+	conn, err := grpc.Dial(host, creds)
+	if err != nil {
+		return nil, err
+	}
+	c.conns[host] = conn
+	return conn, nil
+}
+
+func (c *healthChecker) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for host, conn := range c.conns {
+		conn.Close()
+		delete(c.conns, host)
+	}
+	c.client.CloseIdleConnections()
+}
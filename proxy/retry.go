@@ -0,0 +1,308 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// maxReplayBodySize is the largest request body kept to be sent again
+	// on retries, larger requests are not retried
+	maxReplayBodySize = 1 << 20
+
+	retryBudgetWindow = 10 * time.Second
+)
+
+var (
+	errAttemptReplaced      = errors.New("request attempt was replaced by a retry")
+	errBodyNotReplayable    = errors.New("request body is too large to be replayed")
+	defaultRetryStatuses    = []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+	defaultRetryGRPCCodes   = []string{"unavailable"}
+	defaultRetryBackoff     = 25 * time.Millisecond
+	defaultRetryBudget      = 0.2
+	defaultMinRetriesPerSec = 3
+)
+
+// RetryConfig of a backend, only idempotent http requests and grpc calls
+// failing with one of GRPCCodes are retried
+type RetryConfig struct {
+	// Attempts is the number of retries after the first attempt
+	Attempts      int           `mapstructure:"attempts"`
+	PerTryTimeout time.Duration `mapstructure:"per_try_timeout"`
+	Backoff       time.Duration `mapstructure:"backoff"`
+
+	// StatusCodes of http responses which are retried, connection failures
+	// are always retried
+	StatusCodes []int `mapstructure:"status_codes"`
+
+	// GRPCCodes like unavailable or resource_exhausted of grpc calls which
+	// are retried, connection failures are retried as unavailable and per try
+	// timeouts as deadline_exceeded
+	GRPCCodes []string `mapstructure:"grpc_codes"`
+
+	// BudgetRatio caps retries to a ratio of requests of the backend over
+	// the last ten seconds, MinRetriesPerSecond are allowed regardless
+	BudgetRatio         float64 `mapstructure:"budget_ratio"`
+	MinRetriesPerSecond int     `mapstructure:"min_retries_per_second"`
+
+	grpcCodes map[codes.Code]bool
+}
+
+func parseRetryConfig(rawConfig map[string]interface{}) (RetryConfig, error) {
+	conf := RetryConfig{}
+	if err := decodeConfig(rawConfig, &conf); err != nil {
+		return conf, err
+	}
+	if conf.Attempts < 0 {
+		return conf, errors.New("retry attempts can't be negative")
+	}
+	if conf.Backoff <= 0 {
+		conf.Backoff = defaultRetryBackoff
+	}
+	if len(conf.StatusCodes) == 0 {
+		conf.StatusCodes = defaultRetryStatuses
+	}
+	if len(conf.GRPCCodes) == 0 {
+		conf.GRPCCodes = defaultRetryGRPCCodes
+	}
+	if conf.BudgetRatio <= 0 {
+		conf.BudgetRatio = defaultRetryBudget
+	}
+	if conf.MinRetriesPerSecond <= 0 {
+		conf.MinRetriesPerSecond = defaultMinRetriesPerSec
+	}
+
+	conf.grpcCodes = map[codes.Code]bool{}
+	for _, name := range conf.GRPCCodes {
+		code, ok := grpcCodeByName(name)
+		if !ok {
+			return conf, fmt.Errorf("unknown grpc code %s", name)
+		}
+		conf.grpcCodes[code] = true
+	}
+	return conf, nil
+}
+
+// grpcCodeByName accepts names like unavailable, deadline_exceeded or
+// DEADLINE_EXCEEDED
+func grpcCodeByName(name string) (codes.Code, bool) {
+	normalized := strings.ToLower(strings.ReplaceAll(name, "_", ""))
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		if strings.ToLower(code.String()) == normalized {
+			return code, true
+		}
+	}
+	return codes.Unknown, false
+}
+
+// retryOn tells if the outcome of an attempt is worth another one
+func (c RetryConfig) retryOn(res *http.Response, err error, isGRPC bool) bool {
+	if err != nil {
+		if errors.Is(err, ErrNoEndpoints) || errors.Is(err, errBodyNotReplayable) {
+			return false
+		}
+		if !isGRPC {
+			return true
+		}
+		if errors.Is(err, ErrBackendTimeout) {
+			return c.grpcCodes[codes.DeadlineExceeded]
+		}
+		return c.grpcCodes[codes.Unavailable]
+	}
+
+	if isGRPC {
+		code, ok := grpcStatus(res)
+		return ok && c.grpcCodes[code]
+	}
+	for _, status := range c.StatusCodes {
+		if res.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff grows exponentially with attempts and is jittered so retries of
+// concurrent requests don't hit the backend at once
+func (c RetryConfig) backoff(attempt int) time.Duration {
+	if attempt > 3 {
+		attempt = 3
+	}
+	d := c.Backoff << uint(attempt)
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryBudget keeps retries from multiplying the load of a struggling
+// backend, retries are allowed up to a ratio of requests in a time window
+type retryBudget struct {
+	ratio      float64
+	minRetries int
+
+	mu          sync.Mutex
+	windowStart time.Time
+	requests    int
+	retries     int
+}
+
+func newRetryBudget(conf RetryConfig) *retryBudget {
+	return &retryBudget{
+		ratio:      conf.BudgetRatio,
+		minRetries: conf.MinRetriesPerSecond * int(retryBudgetWindow/time.Second),
+	}
+}
+
+func (b *retryBudget) roll(now time.Time) {
+	if now.Sub(b.windowStart) >= retryBudgetWindow {
+		b.windowStart = now
+		b.requests = 0
+		b.retries = 0
+	}
+}
+
+func (b *retryBudget) request() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll(time.Now())
+	b.requests++
+}
+
+// withdraw takes a retry out of the budget, false is returned if the budget
+// is exhausted
+func (b *retryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.roll(time.Now())
+
+	allowed := int(b.ratio * float64(b.requests))
+	if allowed < b.minRetries {
+		allowed = b.minRetries
+	}
+	if b.retries >= allowed {
+		return false
+	}
+	b.retries++
+	return true
+}
+
+// replayBody records the request body as it is read by an attempt so the
+// next attempt can send it again. The body is not read ahead, streams are
+// sent as they come, and recording stops at maxReplayBodySize.
+type replayBody struct {
+	src io.ReadCloser
+
+	// readMu is held while reading src which may block on the client
+	readMu sync.Mutex
+
+	mu         sync.Mutex
+	buf        []byte
+	consumed   int
+	overflow   bool
+	generation int
+}
+
+func newReplayBody(src io.ReadCloser) *replayBody {
+	return &replayBody{src: src}
+}
+
+// attempt returns the body of a new attempt, reads of previous attempts fail
+// from now on
+func (b *replayBody) attempt() io.ReadCloser {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.generation++
+	return &attemptBody{body: b, generation: b.generation}
+}
+
+func (b *replayBody) replayable() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !b.overflow
+}
+
+type attemptBody struct {
+	body       *replayBody
+	generation int
+	offset     int
+}
+
+func (a *attemptBody) Read(p []byte) (int, error) {
+	b := a.body
+	for {
+		b.mu.Lock()
+		if a.generation != b.generation {
+			b.mu.Unlock()
+			return 0, errAttemptReplaced
+		}
+		if a.offset < len(b.buf) {
+			n := copy(p, b.buf[a.offset:])
+			a.offset += n
+			b.mu.Unlock()
+			return n, nil
+		}
+		if a.offset != b.consumed && b.overflow {
+			b.mu.Unlock()
+			return 0, errBodyNotReplayable
+		}
+		b.mu.Unlock()
+
+		b.readMu.Lock()
+		b.mu.Lock()
+		stale := a.offset != b.consumed
+		b.mu.Unlock()
+		if stale {
+			// another attempt read src meanwhile, serve it from buf
+			b.readMu.Unlock()
+			continue
+		}
+
+		n, err := b.src.Read(p)
+
+		b.mu.Lock()
+		b.consumed += n
+		if !b.overflow {
+			if len(b.buf)+n > maxReplayBodySize {
+				b.overflow = true
+				b.buf = nil
+			} else {
+				b.buf = append(b.buf, p[:n]...)
+			}
+		}
+		replaced := a.generation != b.generation
+		if !replaced {
+			a.offset += n
+		}
+		b.mu.Unlock()
+		b.readMu.Unlock()
+
+		if replaced {
+			return 0, errAttemptReplaced
+		}
+		return n, err
+	}
+}
+
+// Close is a no-op, the request body is closed by the proxy once the
+// request is done as it may be replayed until then
+func (a *attemptBody) Close() error {
+	return nil
+}
@@ -1,16 +1,15 @@
 package proxy
 
 import (
-	"crypto/tls"
-	"net"
 	"net/http"
-	"time"
 
 	"github.com/odpf/shield/hook"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/tracing"
 
 	"github.com/odpf/salt/log"
-
-	"golang.org/x/net/http2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type h2cTransportWrapper struct {
@@ -20,9 +19,8 @@ type h2cTransportWrapper struct {
 	// this is because &http2.Transport is not supporting
 	// proxy for http & h2
 	// Reference: https://sourcegraph.com/github.com/tsenart/vegeta/-/blob/lib/attack.go?L206:6#tab=references
-
-	httpTransport *http.Transport
-	grpcTransport *http2.Transport
+	// transports picks between them, and h2 for grpc to https targets
+	transports *transports
 
 	log  log.Logger
 	hook hook.Service
@@ -37,36 +35,65 @@ func (t *h2cTransportWrapper) RoundTrip(req *http.Request) (*http.Response, erro
 		"scheme", req.URL.Scheme, "protocol", req.Proto)
 
 	req.Header.Del("Accept-Encoding")
-	var transport http.RoundTripper = t.httpTransport
-	if req.Header.Get("Content-Type") == "application/grpc" {
-		transport = t.grpcTransport
-	}
 
-	res, err := transport.RoundTrip(req)
+	accessLog := middleware.ExtractAccessLog(req)
+	accessLog.UpstreamStarted()
+
+	// the backend continues the trace from its client span
+	parentCtx := req.Context()
+	ctx, span := tracing.Tracer().Start(parentCtx, "backend",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", req.Method),
+			attribute.String("http.url", req.URL.String()),
+		),
+	)
+	req = req.WithContext(ctx)
+	tracing.InjectHeaders(ctx, req.Header)
+
+	var res *http.Response
+	var err error
+	if upstream, ok := backendUpstream(req); ok {
+		res, err = upstream.roundTrip(upstream.transport(t.transports), req)
+	} else {
+		res, err = t.transports.RoundTrip(req)
+	}
 	if err != nil {
+		accessLog.UpstreamDone(0)
+		tracing.EndSpan(span, err)
+		t.log.Warn("backend request failed", "host", req.URL.Host, "path", req.URL.Path, "err", err)
 		return res, err
 	}
+	accessLog.UpstreamDone(res.StatusCode)
+	tracing.SetHTTPStatus(span, res.StatusCode)
+	span.End()
 
-	return t.hook.ServeHook(res, nil)
+	defer accessLog.HooksDone()
+	_, hooksSpan := tracing.Tracer().Start(parentCtx, "hooks")
+	if res.Request != nil {
+		// calls made by hooks are traced under the hooks span
+		res.Request = res.Request.WithContext(trace.ContextWithSpan(res.Request.Context(), hooksSpan))
+	}
+	res, err = t.hook.ServeHook(res, nil)
+	tracing.EndSpan(hooksSpan, err)
+	return res, err
+}
+
+// backendUpstream returns the upstream of the matched rule if its backend
+// has one compiled
+func backendUpstream(req *http.Request) (*Upstream, bool) {
+	rule, ok := middleware.ExtractRule(req)
+	if !ok {
+		return nil, false
+	}
+	upstream, ok := rule.Backend.Compiled.(*Upstream)
+	return upstream, ok
 }
 
 func NewH2cRoundTripper(log log.Logger, hook hook.Service) http.RoundTripper {
 	return &h2cTransportWrapper{
-		httpTransport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout:   10 * time.Second,
-				KeepAlive: 1 * time.Minute,
-			}).DialContext,
-			DisableCompression: true,
-		},
-		grpcTransport: &http2.Transport{
-			DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
-				return net.Dial(network, addr)
-			},
-			AllowHTTP:          true,
-			DisableCompression: true,
-		},
-		log:  log,
-		hook: hook,
+		transports: newTransports(TimeoutConfig{}, nil),
+		log:        log,
+		hook:       hook,
 	}
 }
@@ -0,0 +1,62 @@
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/odpf/shield/hook"
+
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type spanHook struct {
+	hook.Service
+	spanContext trace.SpanContext
+}
+
+func (h *spanHook) ServeHook(res *http.Response, err error) (*http.Response, error) {
+	h.spanContext = trace.SpanContextFromContext(res.Request.Context())
+	return res, err
+}
+
+func TestRoundTripTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	hooks := &spanHook{}
+	ctx, parent := otel.Tracer("test").Start(httptest.NewRequest(http.MethodGet, "/", nil).Context(), "proxy")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	res, err := NewH2cRoundTripper(log.NewNoop(), hooks).RoundTrip(req)
+	assert.NoError(t, err)
+	res.Body.Close()
+	parent.End()
+
+	spans := map[string]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	backend, hooksSpan := spans["backend"], spans["hooks"]
+	assert.Equal(t, parent.SpanContext().SpanID(), backend.Parent.SpanID())
+	assert.Equal(t, parent.SpanContext().SpanID(), hooksSpan.Parent.SpanID())
+	assert.Equal(t, "Error", backend.Status.Code.String())
+
+	// the backend continues the trace from the backend span, calls made by
+	// hooks are traced under the hooks span
+	assert.Contains(t, traceparent, backend.SpanContext.SpanID().String())
+	assert.Equal(t, hooksSpan.SpanContext.SpanID(), hooks.spanContext.SpanID())
+}
@@ -0,0 +1,171 @@
+package proxy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/odpf/shield/config"
+)
+
+const (
+	ClientAuthRequire  = "require"
+	ClientAuthOptional = "optional"
+
+	ClientIdentityEmail      = "email"
+	ClientIdentityCommonName = "common_name"
+)
+
+// NewServerTLSConfig returns the tls config of a proxy listener, client
+// certificates are verified if a client CA is configured
+func NewServerTLSConfig(conf config.ServiceTLS) (*tls.Config, error) {
+	if conf.CertFile == "" || conf.KeyFile == "" {
+		return nil, errors.New("cert_file and key_file are required")
+	}
+	cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate: %w", err)
+	}
+	tlsConf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+
+	switch conf.ClientIdentity {
+	case "", ClientIdentityEmail, ClientIdentityCommonName:
+	default:
+		return nil, fmt.Errorf("unknown client_identity %s", conf.ClientIdentity)
+	}
+	if conf.ClientCAFile == "" {
+		if conf.ClientIdentity != "" {
+			return nil, errors.New("client_ca_file is required to take identity from client certificates")
+		}
+		return tlsConf, nil
+	}
+
+	if tlsConf.ClientCAs, err = loadCertPool(conf.ClientCAFile); err != nil {
+		return nil, err
+	}
+	switch conf.ClientAuth {
+	case "", ClientAuthRequire:
+		tlsConf.ClientAuth = tls.RequireAndVerifyClientCert
+	case ClientAuthOptional:
+		tlsConf.ClientAuth = tls.VerifyClientCertIfGiven
+	default:
+		return nil, fmt.Errorf("unknown client_auth %s", conf.ClientAuth)
+	}
+	return tlsConf, nil
+}
+
+// ClientCertIdentity sets the identity header from the verified client
+// certificate of the connection. The header sent by the client is dropped
+// so it can't claim an identity without a certificate.
+func ClientCertIdentity(identityHeader, source string, next http.Handler) http.Handler {
+	if source == "" {
+		return next
+	}
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.Header.Del(identityHeader)
+		if identity := clientCertIdentity(req, source); identity != "" {
+			req.Header.Set(identityHeader, identity)
+		}
+		next.ServeHTTP(rw, req)
+	})
+}
+
+func clientCertIdentity(req *http.Request, source string) string {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	cert := req.TLS.VerifiedChains[0][0]
+	switch source {
+	case ClientIdentityEmail:
+		if len(cert.EmailAddresses) > 0 {
+			return cert.EmailAddresses[0]
+		}
+	case ClientIdentityCommonName:
+		return cert.Subject.CommonName
+	}
+	return ""
+}
+
+type BackendTLSConfig struct {
+	// CAFile verifies backend certificates instead of the system CAs
+	CAFile string `mapstructure:"ca_file"`
+
+	// CertFile and KeyFile are the client certificate presented to backends
+	// requiring mTLS
+	CertFile string `mapstructure:"cert_file"`
+	KeyFile  string `mapstructure:"key_file"`
+
+	// ServerName overrides the SNI and the name the certificate is verified
+	// against, the target host by default
+	ServerName string `mapstructure:"server_name"`
+
+	InsecureSkipVerify bool `mapstructure:"insecure_skip_verify"`
+}
+
+func parseBackendTLSConfig(rawConfig map[string]interface{}) (BackendTLSConfig, error) {
+	conf := BackendTLSConfig{}
+	if err := decodeConfig(rawConfig, &conf); err != nil {
+		return conf, err
+	}
+	if (conf.CertFile == "") != (conf.KeyFile == "") {
+		return conf, errors.New("cert_file and key_file must be set together")
+	}
+	return conf, nil
+}
+
+// BackendClientTLSConfig returns the config used to connect to https targets
+// of a backend with the given tls block
+func BackendClientTLSConfig(rawConfig map[string]interface{}) (*tls.Config, error) {
+	conf, err := parseBackendTLSConfig(rawConfig)
+	if err != nil {
+		return nil, err
+	}
+	return conf.clientConfig()
+}
+
+// clientConfig loads the files of the config, nil is returned if nothing
+// is configured so transports use their defaults
+func (c BackendTLSConfig) clientConfig() (*tls.Config, error) {
+	if c == (BackendTLSConfig{}) {
+		return nil, nil
+	}
+	tlsConf := &tls.Config{
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if c.CAFile != "" {
+		pool, err := loadCertPool(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConf.RootCAs = pool
+	}
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConf, nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	return pool, nil
+}
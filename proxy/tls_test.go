@@ -0,0 +1,273 @@
+package proxy
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/odpf/shield/config"
+	"github.com/odpf/shield/structs"
+
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// testPKI is a CA along with a server certificate for localhost and a
+// client certificate, all written as pem files to dir
+type testPKI struct {
+	dir                   string
+	caFile                string
+	serverCert, serverKey string
+	clientCert, clientKey string
+}
+
+func newTestPKI(t *testing.T) testPKI {
+	dir := t.TempDir()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "shield test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	assert.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	assert.NoError(t, err)
+
+	issue := func(name string, template *x509.Certificate) (string, string) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(t, err)
+		template.NotBefore = ca.NotBefore
+		template.NotAfter = ca.NotAfter
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		assert.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		assert.NoError(t, err)
+		return writePEM(t, dir, name+".crt", "CERTIFICATE", der), writePEM(t, dir, name+".key", "EC PRIVATE KEY", keyDER)
+	}
+
+	pki := testPKI{dir: dir, caFile: writePEM(t, dir, "ca.crt", "CERTIFICATE", caDER)}
+	pki.serverCert, pki.serverKey = issue("server", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost", "books.internal"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	pki.clientCert, pki.clientKey = issue("client", &x509.Certificate{
+		SerialNumber:   big.NewInt(3),
+		Subject:        pkix.Name{CommonName: "books-service"},
+		EmailAddresses: []string{"books@odpf.io"},
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return pki
+}
+
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	path := filepath.Join(dir, name)
+	assert.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+	return path
+}
+
+// newTLSBackend starts a backend serving the test server certificate which
+// requires client certificates issued by the test CA
+func newTLSBackend(t *testing.T, pki testPKI, handler http.HandlerFunc) *httptest.Server {
+	cert, err := tls.LoadX509KeyPair(pki.serverCert, pki.serverKey)
+	assert.NoError(t, err)
+	clientCAs, err := loadCertPool(pki.caFile)
+	assert.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(handler)
+	server.EnableHTTP2 = true
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	server.StartTLS()
+	return server
+}
+
+func TestUpstreamTLS(t *testing.T) {
+	pki := newTestPKI(t)
+	server := newTLSBackend(t, pki, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Proto", r.Proto)
+		w.Header().Set("X-Client", r.TLS.PeerCertificates[0].Subject.CommonName)
+	})
+	defer server.Close()
+
+	mTLS := map[string]interface{}{"ca_file": pki.caFile, "cert_file": pki.clientCert, "key_file": pki.clientKey}
+	send := func(upstream *Upstream, contentType string) (*http.Response, error) {
+		req, _ := http.NewRequest(http.MethodPost, server.URL, nil)
+		req.Header.Set("Content-Type", contentType)
+		res, err := upstream.roundTrip(upstream.transport(newTransports(TimeoutConfig{}, nil)), req)
+		if err == nil {
+			res.Body.Close()
+		}
+		return res, err
+	}
+
+	t.Run("presents client certificate", func(t *testing.T) {
+		b := NewBalancer(log.NewNoop())
+		defer b.Close()
+		upstream := compileUpstream(t, b, structs.Backend{Namespace: "a", Targets: []string{server.URL}, TLS: mTLS})
+
+		res, err := send(upstream, "application/json")
+		assert.NoError(t, err)
+		assert.Equal(t, "books-service", res.Header.Get("X-Client"))
+	})
+
+	t.Run("sends grpc as h2 over tls", func(t *testing.T) {
+		b := NewBalancer(log.NewNoop())
+		defer b.Close()
+		upstream := compileUpstream(t, b, structs.Backend{Namespace: "a", Targets: []string{server.URL}, TLS: mTLS})
+
+		res, err := send(upstream, "application/grpc")
+		assert.NoError(t, err)
+		assert.Equal(t, "HTTP/2.0", res.Header.Get("X-Proto"))
+	})
+
+	t.Run("verifies against server name", func(t *testing.T) {
+		b := NewBalancer(log.NewNoop())
+		defer b.Close()
+		conf := map[string]interface{}{"server_name": "books.internal"}
+		for k, v := range mTLS {
+			conf[k] = v
+		}
+		upstream := compileUpstream(t, b, structs.Backend{Namespace: "a", Targets: []string{server.URL}, TLS: conf})
+		_, err := send(upstream, "application/json")
+		assert.NoError(t, err)
+
+		conf["server_name"] = "authors.internal"
+		upstream = compileUpstream(t, b, structs.Backend{Namespace: "a", Targets: []string{server.URL}, TLS: conf})
+		_, err = send(upstream, "application/json")
+		assert.Error(t, err)
+	})
+
+	t.Run("fails without client certificate", func(t *testing.T) {
+		b := NewBalancer(log.NewNoop())
+		defer b.Close()
+		upstream := compileUpstream(t, b, structs.Backend{Namespace: "a", Targets: []string{server.URL},
+			TLS: map[string]interface{}{"ca_file": pki.caFile}})
+
+		_, err := send(upstream, "application/json")
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects incomplete config", func(t *testing.T) {
+		b := NewBalancer(log.NewNoop())
+		defer b.Close()
+		_, err := b.CompileBackend(structs.Backend{Namespace: "a", Targets: []string{server.URL},
+			TLS: map[string]interface{}{"cert_file": pki.clientCert}})
+		assert.Error(t, err)
+		_, err = b.CompileBackend(structs.Backend{Namespace: "a", Targets: []string{server.URL},
+			TLS: map[string]interface{}{"ca_file": filepath.Join(pki.dir, "missing.crt")}})
+		assert.Error(t, err)
+	})
+}
+
+func TestListenerTLS(t *testing.T) {
+	pki := newTestPKI(t)
+	const identityHeader = "X-Shield-Email"
+
+	start := func(conf config.ServiceTLS) *httptest.Server {
+		tlsConf, err := NewServerTLSConfig(conf)
+		assert.NoError(t, err)
+		server := httptest.NewUnstartedServer(ClientCertIdentity(identityHeader, conf.ClientIdentity,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Identity", r.Header.Get(identityHeader))
+			})))
+		server.TLS = tlsConf
+		server.StartTLS()
+		return server
+	}
+	client := func(withCert bool) *http.Client {
+		roots, err := loadCertPool(pki.caFile)
+		assert.NoError(t, err)
+		tlsConf := &tls.Config{RootCAs: roots}
+		if withCert {
+			cert, err := tls.LoadX509KeyPair(pki.clientCert, pki.clientKey)
+			assert.NoError(t, err)
+			tlsConf.Certificates = []tls.Certificate{cert}
+		}
+		return &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConf}}
+	}
+	get := func(c *http.Client, url, spoofed string) (*http.Response, error) {
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		if spoofed != "" {
+			req.Header.Set(identityHeader, spoofed)
+		}
+		res, err := c.Do(req)
+		if err == nil {
+			res.Body.Close()
+		}
+		return res, err
+	}
+
+	t.Run("takes identity from client certificate", func(t *testing.T) {
+		for source, expected := range map[string]string{
+			ClientIdentityEmail:      "books@odpf.io",
+			ClientIdentityCommonName: "books-service",
+		} {
+			server := start(config.ServiceTLS{CertFile: pki.serverCert, KeyFile: pki.serverKey,
+				ClientCAFile: pki.caFile, ClientIdentity: source})
+			res, err := get(client(true), server.URL, "admin@odpf.io")
+			assert.NoError(t, err)
+			assert.Equal(t, expected, res.Header.Get("X-Identity"), source)
+			server.Close()
+		}
+	})
+
+	t.Run("requires client certificate by default", func(t *testing.T) {
+		server := start(config.ServiceTLS{CertFile: pki.serverCert, KeyFile: pki.serverKey, ClientCAFile: pki.caFile})
+		defer server.Close()
+		_, err := get(client(false), server.URL, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("drops identity header without certificate", func(t *testing.T) {
+		server := start(config.ServiceTLS{CertFile: pki.serverCert, KeyFile: pki.serverKey, ClientCAFile: pki.caFile,
+			ClientAuth: ClientAuthOptional, ClientIdentity: ClientIdentityEmail})
+		defer server.Close()
+		res, err := get(client(false), server.URL, "admin@odpf.io")
+		assert.NoError(t, err)
+		assert.Equal(t, "", res.Header.Get("X-Identity"))
+	})
+
+	t.Run("keeps identity header without client identity", func(t *testing.T) {
+		server := start(config.ServiceTLS{CertFile: pki.serverCert, KeyFile: pki.serverKey})
+		defer server.Close()
+		res, err := get(client(false), server.URL, "admin@odpf.io")
+		assert.NoError(t, err)
+		assert.Equal(t, "admin@odpf.io", res.Header.Get("X-Identity"))
+	})
+
+	t.Run("rejects invalid config", func(t *testing.T) {
+		_, err := NewServerTLSConfig(config.ServiceTLS{CertFile: pki.serverCert})
+		assert.Error(t, err)
+		_, err = NewServerTLSConfig(config.ServiceTLS{CertFile: pki.serverCert, KeyFile: pki.serverKey,
+			ClientIdentity: ClientIdentityEmail})
+		assert.Error(t, err)
+		_, err = NewServerTLSConfig(config.ServiceTLS{CertFile: pki.serverCert, KeyFile: pki.serverKey,
+			ClientCAFile: pki.caFile, ClientAuth: "sometimes"})
+		assert.Error(t, err)
+	})
+}
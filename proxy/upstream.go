@@ -0,0 +1,362 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/odpf/salt/log"
+	"github.com/odpf/shield/middleware"
+
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	defaultConnectTimeout = 10 * time.Second
+	defaultIdleTimeout    = 90 * time.Second
+)
+
+var (
+	ErrCircuitOpen    = errors.New("circuit breaker of backend is open")
+	ErrBackendTimeout = errors.New("backend request timed out")
+)
+
+type TimeoutConfig struct {
+	// Connect bounds dialing a backend endpoint
+	Connect time.Duration `mapstructure:"connect"`
+
+	// Request bounds the whole request including all retries and reading the
+	// response, no limit if not set
+	Request time.Duration `mapstructure:"request"`
+
+	// Idle is how long an unused http/1 connection to the backend is kept
+	// open, http2.Transport of the x/net version in use has no idle timeout
+	Idle time.Duration `mapstructure:"idle"`
+}
+
+func parseTimeoutConfig(rawConfig map[string]interface{}) (TimeoutConfig, error) {
+	conf := TimeoutConfig{}
+	if err := decodeConfig(rawConfig, &conf); err != nil {
+		return conf, err
+	}
+	if conf.Connect < 0 || conf.Request < 0 || conf.Idle < 0 {
+		return conf, errors.New("timeouts can't be negative")
+	}
+	return conf, nil
+}
+
+// Upstream is a compiled backend, it sends requests to the backend
+// endpoints within the timeouts, retries and circuit breaker of the backend
+type Upstream struct {
+	log  log.Logger
+	name string
+
+	// pool is nil if the backend has a single target, the director already
+	// points requests to it
+	pool     *Pool
+	timeouts TimeoutConfig
+	retries  RetryConfig
+	budget   *retryBudget
+	breaker  *circuitBreaker
+
+	// transports are nil if the backend uses the default connect and idle
+	// timeouts and tls
+	transports *transports
+}
+
+func newUpstream(logger log.Logger, name string, conf upstreamConfig, tlsConf *tls.Config, pool *Pool) *Upstream {
+	upstream := &Upstream{
+		log:      logger,
+		name:     name,
+		pool:     pool,
+		timeouts: conf.Timeouts,
+		retries:  conf.Retries,
+	}
+	if conf.Retries.Attempts > 0 {
+		upstream.budget = newRetryBudget(conf.Retries)
+	}
+	if conf.CircuitBreaker.enabled {
+		upstream.breaker = newCircuitBreaker(conf.CircuitBreaker)
+	}
+	if conf.Timeouts.Connect > 0 || conf.Timeouts.Idle > 0 || tlsConf != nil {
+		upstream.transports = newTransports(conf.Timeouts, tlsConf)
+	}
+	return upstream
+}
+
+func (u *Upstream) start() {
+	if u.pool != nil {
+		u.pool.start()
+	}
+}
+
+func (u *Upstream) stop() {
+	if u.pool != nil {
+		u.pool.stop()
+	}
+	if u.transports != nil {
+		u.transports.closeIdleConnections()
+	}
+}
+
+// transport returns the transports of the upstream if it has its own
+// timeouts or tls, the given one otherwise
+func (u *Upstream) transport(fallback http.RoundTripper) http.RoundTripper {
+	if u.transports == nil {
+		return fallback
+	}
+	return u.transports
+}
+
+// roundTrip sends the request to an endpoint, retrying on another attempt
+// if the response or error is retryable and the retry budget allows it
+func (u *Upstream) roundTrip(transport http.RoundTripper, req *http.Request) (*http.Response, error) {
+	isGRPC := isGRPCRequest(req)
+
+	ctx, cancelRequest := context.WithCancel(req.Context())
+	if u.timeouts.Request > 0 {
+		ctx, cancelRequest = context.WithTimeout(req.Context(), u.timeouts.Request)
+	}
+
+	retryable := u.budget != nil && (isGRPC || isIdempotent(req.Method))
+	var body *replayBody
+	if retryable {
+		u.budget.request()
+		if req.Body != nil && req.Body != http.NoBody {
+			body = newReplayBody(req.Body)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if u.breaker != nil && !u.breaker.allow() {
+			cancelRequest()
+			return nil, ErrCircuitOpen
+		}
+
+		attemptCtx, cancelAttempt := ctx, context.CancelFunc(func() {})
+		if u.retries.PerTryTimeout > 0 {
+			attemptCtx, cancelAttempt = context.WithTimeout(ctx, u.retries.PerTryTimeout)
+		}
+		outreq := req.Clone(attemptCtx)
+		if body != nil {
+			outreq.Body = body.attempt()
+		}
+
+		res, err := u.send(req.Context(), transport, outreq)
+		if err != nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("%w: %s", ErrBackendTimeout, err)
+		}
+		if u.breaker != nil {
+			if req.Context().Err() != nil {
+				// the client went away, it says nothing about the backend
+				u.breaker.release()
+			} else if u.breaker.record(isBackendFailure(res, err)) {
+				u.log.Warn("backend circuit breaker opened", "backend", u.name,
+					"duration", u.breaker.conf.OpenDuration)
+			}
+		}
+
+		if retryable && attempt < u.retries.Attempts && ctx.Err() == nil &&
+			u.retries.retryOn(res, err, isGRPC) && (body == nil || body.replayable()) && u.budget.withdraw() {
+			if res != nil {
+				res.Body.Close()
+			}
+			cancelAttempt()
+			u.log.Debug("retrying backend request", "backend", u.name, "attempt", attempt+1, "err", err)
+			if sleepContext(ctx, u.retries.backoff(attempt)) != nil {
+				cancelRequest()
+				return nil, fmt.Errorf("%w: %s", ErrBackendTimeout, ctx.Err())
+			}
+			continue
+		}
+
+		if err != nil {
+			cancelAttempt()
+			cancelRequest()
+			return nil, err
+		}
+		res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: func() {
+			cancelAttempt()
+			cancelRequest()
+		}}
+		return res, nil
+	}
+}
+
+// send points the request to an endpoint of the pool if the backend is
+// balanced and sends it, clientCtx is the context of the client request
+func (u *Upstream) send(clientCtx context.Context, transport http.RoundTripper, req *http.Request) (*http.Response, error) {
+	if u.pool == nil {
+		return transport.RoundTrip(req)
+	}
+
+	picked, err := u.pool.pick(req)
+	if err != nil {
+		return nil, err
+	}
+	picked.direct(req)
+	picked.begin()
+
+	res, err := transport.RoundTrip(req)
+	if clientCtx.Err() != nil {
+		// the client went away, it says nothing about the endpoint
+		picked.release()
+		return res, err
+	}
+	if picked.end(res, err, u.pool.config.Ejection) {
+		u.log.Warn("backend endpoint ejected", "backend", u.name, "endpoint", picked.url.Host,
+			"duration", u.pool.config.Ejection.Duration)
+	}
+	return res, err
+}
+
+// cancelOnClose releases the request context once the response is read
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
+
+// transports sends requests over the transport matching the protocol and
+// scheme of the request. http.Transport can't proxy h2c, grpc goes over
+// http2.Transport either as h2c to http targets or h2 to https targets.
+type transports struct {
+	http    *http.Transport
+	grpc    *http2.Transport
+	grpcTLS *http2.Transport
+}
+
+// newTransports returns transports within timeouts, tlsConf is used for
+// https targets and may be nil to verify them against the system CAs
+func newTransports(timeouts TimeoutConfig, tlsConf *tls.Config) *transports {
+	return &transports{
+		http:    newHTTPTransport(timeouts, tlsConf),
+		grpc:    newGRPCTransport(timeouts),
+		grpcTLS: newGRPCTLSTransport(timeouts, tlsConf),
+	}
+}
+
+func (t *transports) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch {
+	case !isGRPCRequest(req):
+		return t.http.RoundTrip(req)
+	case req.URL.Scheme == "https":
+		return t.grpcTLS.RoundTrip(req)
+	default:
+		return t.grpc.RoundTrip(req)
+	}
+}
+
+func (t *transports) closeIdleConnections() {
+	t.http.CloseIdleConnections()
+	t.grpc.CloseIdleConnections()
+	t.grpcTLS.CloseIdleConnections()
+}
+
+func newHTTPTransport(timeouts TimeoutConfig, tlsConf *tls.Config) *http.Transport {
+	return &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   durationOr(timeouts.Connect, defaultConnectTimeout),
+			KeepAlive: 1 * time.Minute,
+		}).DialContext,
+		TLSClientConfig:     tlsConf,
+		TLSHandshakeTimeout: durationOr(timeouts.Connect, defaultConnectTimeout),
+		IdleConnTimeout:     durationOr(timeouts.Idle, defaultIdleTimeout),
+		DisableCompression:  true,
+	}
+}
+
+// newGRPCTransport returns a h2c transport, it dials plaintext connections
+// even though http2.Transport calls it DialTLS
+func newGRPCTransport(timeouts TimeoutConfig) *http2.Transport {
+	dialer := &net.Dialer{Timeout: durationOr(timeouts.Connect, defaultConnectTimeout)}
+	return &http2.Transport{
+		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			return dialer.Dial(network, addr)
+		},
+		AllowHTTP:          true,
+		DisableCompression: true,
+	}
+}
+
+// newGRPCTLSTransport returns a transport negotiating h2 over tls
+func newGRPCTLSTransport(timeouts TimeoutConfig, tlsConf *tls.Config) *http2.Transport {
+	dialer := &net.Dialer{Timeout: durationOr(timeouts.Connect, defaultConnectTimeout)}
+	return &http2.Transport{
+		DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+			// cfg is tlsConf with the server name and h2 protocol filled in
+			conn, err := tls.DialWithDialer(dialer, network, addr, cfg)
+			if err != nil {
+				return nil, err
+			}
+			if proto := conn.ConnectionState().NegotiatedProtocol; proto != http2.NextProtoTLS {
+				conn.Close()
+				return nil, fmt.Errorf("backend negotiated %q instead of h2", proto)
+			}
+			return conn, nil
+		},
+		TLSClientConfig:    tlsConf,
+		DisableCompression: true,
+	}
+}
+
+func durationOr(d, fallback time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return fallback
+}
+
+// isGRPCRequest is true for application/grpc and application/grpc+<codec>
+// content types, grpc-web requests are plain http and aren't matched
+func isGRPCRequest(req *http.Request) bool {
+	return middleware.IsGRPCContentType(req.Header.Get("Content-Type"))
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// grpcStatus returns the status of a trailers-only grpc response, which is
+// how grpc servers fail a call before sending any message
+func grpcStatus(res *http.Response) (codes.Code, bool) {
+	value := res.Header.Get("Grpc-Status")
+	if value == "" {
+		return codes.OK, false
+	}
+	code, err := strconv.Atoi(value)
+	if err != nil {
+		return codes.Unknown, true
+	}
+	return codes.Code(code), true
+}
+
+// isBackendFailure tells if the backend failed to serve a request, failures
+// count towards endpoint ejection and the circuit breaker
+func isBackendFailure(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	code, ok := grpcStatus(res)
+	return ok && (code == codes.Unavailable || code == codes.DeadlineExceeded)
+}
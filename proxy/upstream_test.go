@@ -0,0 +1,221 @@
+package proxy
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/odpf/shield/structs"
+
+	"github.com/odpf/salt/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func compileUpstream(t *testing.T, b *Balancer, backend structs.Backend) *Upstream {
+	compiled, err := b.CompileBackend(backend)
+	assert.NoError(t, err)
+	upstream, ok := compiled.(*Upstream)
+	assert.True(t, ok)
+	return upstream
+}
+
+// flakyBackend fails the first failures requests with the given status
+func flakyBackend(failures int64, fail func(w http.ResponseWriter)) (*httptest.Server, *int64) {
+	var calls int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if atomic.AddInt64(&calls, 1) <= failures {
+			fail(w)
+			return
+		}
+		w.Write(body)
+	}))
+	return server, &calls
+}
+
+func TestUpstreamRetries(t *testing.T) {
+	transport := newHTTPTransport(TimeoutConfig{}, nil)
+	retries := map[string]interface{}{"attempts": 2, "backoff": "1ms"}
+	unavailable := func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) }
+
+	t.Run("retries idempotent requests and replays the body", func(t *testing.T) {
+		server, calls := flakyBackend(2, unavailable)
+		defer server.Close()
+		b := NewBalancer(log.NewNoop())
+		defer b.Close()
+		upstream := compileUpstream(t, b, structs.Backend{Namespace: "a", Targets: []string{server.URL}, Retries: retries})
+
+		req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("book"))
+		res, err := upstream.roundTrip(transport, req)
+		assert.NoError(t, err)
+		body, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "book", string(body))
+		assert.Equal(t, int64(3), atomic.LoadInt64(calls))
+	})
+
+	t.Run("doesn't retry non idempotent requests", func(t *testing.T) {
+		server, calls := flakyBackend(1, unavailable)
+		defer server.Close()
+		b := NewBalancer(log.NewNoop())
+		defer b.Close()
+		upstream := compileUpstream(t, b, structs.Backend{Namespace: "a", Targets: []string{server.URL}, Retries: retries})
+
+		req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("book"))
+		res, err := upstream.roundTrip(transport, req)
+		assert.NoError(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, int64(1), atomic.LoadInt64(calls))
+	})
+
+	t.Run("retries grpc calls on configured codes", func(t *testing.T) {
+		for code, expectedCalls := range map[codes.Code]int64{codes.Unavailable: 2, codes.Internal: 1} {
+			code := code
+			server, calls := flakyBackend(1, func(w http.ResponseWriter) {
+				w.Header().Set("Content-Type", "application/grpc")
+				w.Header().Set("Grpc-Status", strconv.Itoa(int(code)))
+			})
+			b := NewBalancer(log.NewNoop())
+			upstream := compileUpstream(t, b, structs.Backend{Namespace: "a", Targets: []string{server.URL}, Retries: retries})
+
+			req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("message"))
+			req.Header.Set("Content-Type", "application/grpc")
+			res, err := upstream.roundTrip(transport, req)
+			assert.NoError(t, err)
+			res.Body.Close()
+			assert.Equal(t, expectedCalls, atomic.LoadInt64(calls), code.String())
+
+			b.Close()
+			server.Close()
+		}
+	})
+
+	t.Run("request timeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}))
+		defer server.Close()
+		b := NewBalancer(log.NewNoop())
+		defer b.Close()
+		upstream := compileUpstream(t, b, structs.Backend{Namespace: "a", Targets: []string{server.URL},
+			Timeouts: map[string]interface{}{"request": "20ms"}})
+
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		_, err := upstream.roundTrip(transport, req)
+		assert.True(t, errors.Is(err, ErrBackendTimeout))
+	})
+}
+
+func TestRetryBudget(t *testing.T) {
+	budget := newRetryBudget(RetryConfig{BudgetRatio: 0.5, MinRetriesPerSecond: 0})
+	for i := 0; i < 4; i++ {
+		budget.request()
+	}
+	assert.True(t, budget.withdraw())
+	assert.True(t, budget.withdraw())
+	assert.False(t, budget.withdraw())
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	server, _ := flakyBackend(2, func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) })
+	defer server.Close()
+	b := NewBalancer(log.NewNoop())
+	defer b.Close()
+	upstream := compileUpstream(t, b, structs.Backend{Namespace: "a", Targets: []string{server.URL},
+		CircuitBreaker: map[string]interface{}{"consecutive_failures": 2, "open_duration": "50ms"}})
+	transport := newHTTPTransport(TimeoutConfig{}, nil)
+
+	send := func() (*http.Response, error) {
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		res, err := upstream.roundTrip(transport, req)
+		if err == nil {
+			res.Body.Close()
+		}
+		return res, err
+	}
+
+	for i := 0; i < 2; i++ {
+		res, err := send()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadGateway, res.StatusCode)
+	}
+	_, err := send()
+	assert.True(t, errors.Is(err, ErrCircuitOpen))
+
+	// a successful probe closes the breaker
+	time.Sleep(60 * time.Millisecond)
+	res, err := send()
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	_, err = send()
+	assert.NoError(t, err)
+}
+
+func TestHandleProxyError(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/library.v1.Books/Get", nil)
+	req.Header.Set("Content-Type", "application/grpc")
+	w := httptest.NewRecorder()
+	handleProxyError(w, req, ErrCircuitOpen)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, strconv.Itoa(int(codes.Unavailable)), w.Header().Get("Grpc-Status"))
+	assert.Equal(t, ErrCircuitOpen.Error(), w.Header().Get("Grpc-Message"))
+
+	w = httptest.NewRecorder()
+	handleProxyError(w, httptest.NewRequest(http.MethodGet, "/", nil), ErrBackendTimeout)
+	assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+}
+
+type directorFunc func(*http.Request)
+
+func (f directorFunc) Direct(req *http.Request) { f(req) }
+
+func TestH2cConcurrentRequests(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer backend.Close()
+
+	// run with -race, the reverse proxy is shared by requests
+	h2c := NewH2c(http.DefaultTransport, directorFunc(func(req *http.Request) {
+		req.URL.Scheme = "http"
+		req.URL.Host = strings.TrimPrefix(backend.URL, "http://")
+	}))
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			w := httptest.NewRecorder()
+			h2c.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/books/"+strconv.Itoa(i), nil))
+			assert.Equal(t, "/books/"+strconv.Itoa(i), w.Body.String())
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestIsGRPCRequest(t *testing.T) {
+	for contentType, want := range map[string]bool{
+		"application/grpc":           true,
+		"application/grpc+proto":     true,
+		"application/grpc+json":      true,
+		"application/grpc-web":       false,
+		"application/grpc-web+proto": false,
+		"application/grpc-web-text":  false,
+		"application/json":           false,
+		"":                           false,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/library.v1.Books/Get", nil)
+		req.Header.Set("Content-Type", contentType)
+		assert.Equal(t, want, isGRPCRequest(req), contentType)
+	}
+}
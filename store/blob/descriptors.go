@@ -0,0 +1,120 @@
+package blob
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+
+	"github.com/odpf/salt/log"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/pkg/body_extractor"
+	"github.com/odpf/shield/structs"
+	"github.com/pkg/errors"
+)
+
+// descriptorCache keeps proto descriptors resolved during a load, descriptor
+// sets are read once per load even if many backends use them
+type descriptorCache struct {
+	sets        map[string]*body_extractor.DescriptorSet
+	reflections map[string]*body_extractor.Reflection
+
+	// previous are reflections of the last load, reused if still configured
+	previous map[string]*body_extractor.Reflection
+}
+
+func newDescriptorCache(previous map[string]*body_extractor.Reflection) *descriptorCache {
+	return &descriptorCache{
+		sets:        map[string]*body_extractor.DescriptorSet{},
+		reflections: map[string]*body_extractor.Reflection{},
+		previous:    previous,
+	}
+}
+
+// swap returns reflections used by the load and closes the ones which are
+// not configured anymore
+func (c *descriptorCache) swap(logger log.Logger, previous map[string]*body_extractor.Reflection) map[string]*body_extractor.Reflection {
+	for target, reflection := range previous {
+		if c.reflections[target] != reflection {
+			if err := reflection.Close(); err != nil {
+				logger.Warn("failed to close reflection client", "target", target, "err", err)
+			}
+		}
+	}
+	return c.reflections
+}
+
+// resolveDescriptors sets descriptors of backends of a ruleset from their
+// proto config, descriptor sets are read from the rules bucket
+func (repo *RuleRepository) resolveDescriptors(ctx context.Context, s *Ruleset, cache *descriptorCache, version *versionHash) error {
+	for ruleIdx := range s.Rules {
+		for backendIdx := range s.Rules[ruleIdx].Backends {
+			backend := &s.Rules[ruleIdx].Backends[backendIdx]
+			conf := backend.Proto
+			switch {
+			case conf.DescriptorSet != "" && conf.Reflection:
+				return fmt.Errorf("backend %s: only one of descriptor_set and reflection can be set", backend.Name)
+			case conf.DescriptorSet != "":
+				set, ok := cache.sets[conf.DescriptorSet]
+				if !ok {
+					raw, err := repo.bucket.ReadAll(ctx, conf.DescriptorSet)
+					if err != nil {
+						return errors.Wrap(err, "bucket.ReadAll: "+conf.DescriptorSet)
+					}
+					if set, err = body_extractor.NewDescriptorSet(raw); err != nil {
+						return errors.Wrap(err, conf.DescriptorSet)
+					}
+					version.add(conf.DescriptorSet, raw)
+					cache.sets[conf.DescriptorSet] = set
+				}
+				backend.descriptors = set
+			case conf.Reflection:
+				target, err := url.Parse(backend.Target.first())
+				if err != nil || target.Host == "" {
+					return fmt.Errorf("backend %s: invalid target for reflection", backend.Name)
+				}
+				// backends with different tls blocks don't share a connection
+				secure := target.Scheme == "https"
+				key := target.Scheme + "://" + target.Host
+				if secure && len(backend.TLS) > 0 {
+					key += " " + fmt.Sprint(backend.TLS)
+				}
+				reflection, ok := cache.reflections[key]
+				if !ok {
+					if reflection, ok = cache.previous[key]; !ok {
+						var tlsConf *tls.Config
+						if secure && repo.backendTLSCompiler != nil {
+							if tlsConf, err = repo.backendTLSCompiler(backend.TLS); err != nil {
+								return fmt.Errorf("backend %s: invalid tls: %w", backend.Name, err)
+							}
+						}
+						reflection = body_extractor.NewReflection(target.Host, secure, tlsConf)
+					}
+					cache.reflections[key] = reflection
+				}
+				backend.descriptors = reflection
+			}
+		}
+	}
+	return nil
+}
+
+// readsProtoFieldNames tells if a grpc_payload attribute of the rule reads
+// fields by name
+func readsProtoFieldNames(rule *structs.Rule) bool {
+	byName := func(attr map[string]interface{}) bool {
+		path, _ := attr["path"].(string)
+		return fmt.Sprint(attr["type"]) == string(middleware.AttributeTypeGRPCPayload) && path != ""
+	}
+	for _, spec := range rule.Middlewares {
+		if middleware.HasAttribute(spec.Config, byName) {
+			return true
+		}
+	}
+	for _, spec := range rule.Hooks {
+		if middleware.HasAttribute(spec.Config, byName) {
+			return true
+		}
+	}
+	return middleware.HasAttribute(rule.Backend.LoadBalancer, byName)
+}
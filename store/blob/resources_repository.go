@@ -50,6 +50,11 @@ type ResourcesRepository struct {
 	cron   *cron.Cron
 	bucket store.Bucket
 	cached []structs.Resource
+
+	// loading is serialized between cron, file watch and manual reloads
+	loadMu     sync.Mutex
+	status     statusTracker
+	closeWatch func() error
 }
 
 func (repo *ResourcesRepository) GetAll(ctx context.Context) ([]structs.Resource, error) {
@@ -91,6 +96,18 @@ func (repo *ResourcesRepository) GetRelationsForNamespace(ctx context.Context, n
 }
 
 func (repo *ResourcesRepository) refresh(ctx context.Context) error {
+	repo.loadMu.Lock()
+	defer repo.loadMu.Unlock()
+
+	if err := repo.load(ctx); err != nil {
+		repo.status.failed(err)
+		return err
+	}
+	return nil
+}
+
+func (repo *ResourcesRepository) load(ctx context.Context) error {
+	version := newVersionHash()
 	var resources []structs.Resource
 
 	// get all items
@@ -114,6 +131,7 @@ func (repo *ResourcesRepository) refresh(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "bucket.ReadAll: "+obj.Key)
 		}
+		version.add(obj.Key, fileBytes)
 
 		var resourceBackends ResourceBackends
 		if err := yaml.Unmarshal(fileBytes, &resourceBackends); err != nil {
@@ -136,7 +154,8 @@ func (repo *ResourcesRepository) refresh(ctx context.Context) error {
 	repo.mu.Lock()
 	repo.cached = resources
 	repo.mu.Unlock()
-	repo.log.Debug("resource config cache refreshed", "resource_config_count", len(repo.cached))
+	repo.status.loaded(version.sum(), len(resources), nil)
+	repo.log.Debug("resource config cache refreshed", "resource_config_count", len(repo.cached), "version", version.sum())
 	return nil
 }
 
@@ -157,7 +176,32 @@ func (repo *ResourcesRepository) InitCache(ctx context.Context, refreshDelay tim
 	return repo.refresh(ctx)
 }
 
+// Reload refreshes the cache right away
+func (repo *ResourcesRepository) Reload(ctx context.Context) error {
+	return repo.refresh(ctx)
+}
+
+// Status returns version, load time and last error of the loaded resource configs
+func (repo *ResourcesRepository) Status() LoadStatus {
+	return repo.status.get()
+}
+
+// Watch reloads the cache as soon as files change in dir, used for file:// buckets
+func (repo *ResourcesRepository) Watch(ctx context.Context, dir string) error {
+	closeWatch, err := watchDir(ctx, repo.log, dir, repo.refresh)
+	if err != nil {
+		return err
+	}
+	repo.closeWatch = closeWatch
+	return nil
+}
+
 func (repo *ResourcesRepository) Close() error {
+	if repo.closeWatch != nil {
+		if err := repo.closeWatch(); err != nil {
+			repo.log.Warn("failed to close file watcher", "err", err)
+		}
+	}
 	<-repo.cron.Stop().Done()
 	return repo.bucket.Close()
 }
@@ -2,6 +2,7 @@ package blob
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"regexp"
 	"strings"
@@ -13,6 +14,10 @@ import (
 	"github.com/robfig/cron/v3"
 
 	"github.com/ghodss/yaml"
+	"github.com/odpf/shield/hook"
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/middleware/rulematch"
+	"github.com/odpf/shield/pkg/body_extractor"
 	"github.com/odpf/shield/store"
 	"github.com/odpf/shield/structs"
 	"github.com/pkg/errors"
@@ -28,19 +33,79 @@ type Rule struct {
 }
 
 type Backend struct {
-	Name      string     `yaml:"name"`
-	Target    string     `yaml:"target"`
-	Methods   []string   `yaml:"methods"`
-	Frontends []Frontend `yaml:"frontends"`
-	Prefix    string     `yaml:"prefix"`
+	Name         string                 `yaml:"name"`
+	Target       Targets                `yaml:"target"`
+	Methods      []string               `yaml:"methods"`
+	Frontends    []Frontend             `yaml:"frontends"`
+	Prefix       string                 `yaml:"prefix"`
+	LoadBalancer map[string]interface{} `json:"load_balancer" yaml:"load_balancer"`
+
+	Timeouts       map[string]interface{} `yaml:"timeouts"`
+	Retries        map[string]interface{} `yaml:"retries"`
+	CircuitBreaker map[string]interface{} `json:"circuit_breaker" yaml:"circuit_breaker"`
+	TLS            map[string]interface{} `json:"tls" yaml:"tls"`
+
+	Proto ProtoConfig `json:"proto" yaml:"proto"`
+
+	// descriptors are resolved from Proto while loading rules
+	descriptors body_extractor.MethodResolver
+}
+
+// ProtoConfig is where message types of grpc methods of a backend are
+// resolved from, grpc_payload attributes can then read fields by name
+type ProtoConfig struct {
+	// DescriptorSet is the path of a FileDescriptorSet in the rules bucket
+	DescriptorSet string `json:"descriptor_set" yaml:"descriptor_set"`
+
+	// Reflection resolves message types with grpc server reflection of the
+	// first target of the backend
+	Reflection bool `json:"reflection" yaml:"reflection"`
+}
+
+// Targets is either a single url or a list of urls requests are balanced over
+type Targets []string
+
+func (t *Targets) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*t = Targets{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(b, &list); err != nil {
+		return errors.New("target must be a url or a list of urls")
+	}
+	*t = list
+	return nil
+}
+
+// first returns the url used when the backend isn't balanced
+func (t Targets) first() string {
+	if len(t) == 0 {
+		return ""
+	}
+	return t[0]
 }
 
 type Frontend struct {
+	Name        string       `yaml:"name"`
 	Action      string       `yaml:"action"`
 	Path        string       `yaml:"path"`
 	Method      string       `yaml:"method"`
 	Middlewares []Middleware `yaml:"middlewares"`
 	Hooks       []Hook       `yaml:"hooks"`
+
+	// MaxBodySize bounds request bodies buffered for payload attributes
+	MaxBodySize int64 `json:"max_body_size" yaml:"max_body_size"`
+
+	// GraphQL matches requests to the path by the graphql operation
+	GraphQL GraphQL `yaml:"graphql"`
+}
+
+type GraphQL struct {
+	Operation string   `yaml:"operation"`
+	Fields    []string `yaml:"fields"`
 }
 
 type Middleware struct {
@@ -60,6 +125,20 @@ type RuleRepository struct {
 	cron   *cron.Cron
 	bucket store.Bucket
 	cached []structs.Ruleset
+	router *rulematch.Router
+
+	// loading is serialized between cron, file watch and manual reloads
+	loadMu     sync.Mutex
+	status     statusTracker
+	closeWatch func() error
+
+	// reflections are kept across loads so services are resolved once
+	reflections map[string]*body_extractor.Reflection
+
+	ruleCompilers      []structs.RuleCompiler
+	backendCompiler    structs.BackendCompiler
+	backendTLSCompiler structs.BackendTLSCompiler
+	loadListeners      []func(rulesets []structs.Ruleset)
 }
 
 func (repo *RuleRepository) GetAll(ctx context.Context) ([]structs.Ruleset, error) {
@@ -75,8 +154,40 @@ func (repo *RuleRepository) GetAll(ctx context.Context) ([]structs.Ruleset, erro
 	return repo.cached, err
 }
 
+// GetRouter returns rules compiled for matching requests, router is rebuilt
+// on every refresh
+func (repo *RuleRepository) GetRouter(ctx context.Context) (*rulematch.Router, error) {
+	repo.mu.Lock()
+	currentRouter := repo.router
+	repo.mu.Unlock()
+	if repo.cron != nil && currentRouter != nil {
+		return currentRouter, nil
+	}
+
+	if err := repo.refresh(ctx); err != nil {
+		return nil, err
+	}
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return repo.router, nil
+}
+
 func (repo *RuleRepository) refresh(ctx context.Context) error {
+	repo.loadMu.Lock()
+	defer repo.loadMu.Unlock()
+
+	if err := repo.load(ctx); err != nil {
+		repo.status.failed(err)
+		return err
+	}
+	return nil
+}
+
+func (repo *RuleRepository) load(ctx context.Context) error {
+	version := newVersionHash()
 	var ruleset []structs.Ruleset
+	var warnings []string
+	descriptors := newDescriptorCache(repo.reflections)
 
 	// get all items
 	it := repo.bucket.List(&blob.ListOptions{})
@@ -99,6 +210,7 @@ func (repo *RuleRepository) refresh(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "bucket.ReadAll: "+obj.Key)
 		}
+		version.add(obj.Key, fileBytes)
 
 		var s Ruleset
 		if err := yaml.Unmarshal(fileBytes, &s); err != nil {
@@ -107,6 +219,11 @@ func (repo *RuleRepository) refresh(ctx context.Context) error {
 		if len(s.Rules) == 0 {
 			continue
 		}
+		if err := repo.resolveDescriptors(ctx, &s, descriptors, version); err != nil {
+			repo.log.Error("failed to resolve proto descriptors", "file", obj.Key, "err", err)
+			warnings = append(warnings, "skipped invalid rule file "+obj.Key)
+			continue
+		}
 
 		// transforming yaml parse ruleset to clean iterable ruleset in middlewares
 		targetRuleSet := structs.Ruleset{}
@@ -131,10 +248,28 @@ func (repo *RuleRepository) refresh(ctx context.Context) error {
 
 					targetRuleSet.Rules = append(targetRuleSet.Rules, structs.Rule{
 						Frontend: structs.Frontend{
+							Name:   frontend.Name,
 							URL:    frontend.Path,
 							Method: frontend.Method,
+							Body:   structs.RequestBody{MaxSize: frontend.MaxBodySize},
+							GraphQL: structs.GraphQLMatch{
+								Operation: frontend.GraphQL.Operation,
+								Fields:    frontend.GraphQL.Fields,
+							},
+						},
+						Backend: structs.Backend{
+							URL:          backend.Target.first(),
+							Namespace:    backend.Name,
+							Prefix:       backend.Prefix,
+							Targets:      backend.Target,
+							LoadBalancer: backend.LoadBalancer,
+
+							Timeouts:       backend.Timeouts,
+							Retries:        backend.Retries,
+							CircuitBreaker: backend.CircuitBreaker,
+							TLS:            backend.TLS,
+							Descriptors:    backend.descriptors,
 						},
-						Backend:     structs.Backend{URL: backend.Target, Namespace: backend.Name, Prefix: backend.Prefix},
 						Middlewares: middlewares,
 						Hooks:       hooks,
 					})
@@ -154,20 +289,116 @@ func (repo *RuleRepository) refresh(ctx context.Context) error {
 			}
 		}
 
+		// decode and validate middleware and hook configs once per rule
+		for ruleIdx := range targetRuleSet.Rules {
+			if err := repo.compile(&targetRuleSet.Rules[ruleIdx]); err != nil {
+				rxParsingSuccess = false
+				repo.log.Error("invalid rule configuration", "file", obj.Key,
+					"url", targetRuleSet.Rules[ruleIdx].Frontend.URL, "err", err)
+			}
+		}
+
 		if rxParsingSuccess {
 			ruleset = append(ruleset, targetRuleSet)
 		} else {
 			repo.log.Warn("skipping rule set due to parsing errors", "content", string(fileBytes))
+			warnings = append(warnings, "skipped invalid rule file "+obj.Key)
 		}
 	}
 
+	router, err := rulematch.NewRouter(ruleset)
+	if err != nil {
+		repo.log.Error("failed to compile rule routes", "err", err)
+		warnings = append(warnings, err.Error())
+	}
+
 	repo.mu.Lock()
 	repo.cached = ruleset
+	repo.router = router
 	repo.mu.Unlock()
-	repo.log.Debug("rule cache refreshed", "ruleset_count", len(repo.cached))
+	repo.reflections = descriptors.swap(repo.log, repo.reflections)
+
+	for _, listener := range repo.loadListeners {
+		listener(ruleset)
+	}
+
+	var loadWarnings error
+	if len(warnings) > 0 {
+		loadWarnings = errors.New(strings.Join(warnings, "; "))
+	}
+	repo.status.loaded(version.sum(), router.Len(), loadWarnings)
+	repo.log.Debug("rule cache refreshed", "ruleset_count", len(repo.cached), "version", version.sum())
+	return nil
+}
+
+func (repo *RuleRepository) compile(rule *structs.Rule) error {
+	if repo.backendCompiler != nil {
+		compiled, err := repo.backendCompiler(rule.Backend)
+		if err != nil {
+			return errors.Wrapf(err, "backend %s", rule.Backend.Namespace)
+		}
+		rule.Backend.Compiled = compiled
+	}
+
+	// the body is streamed unless a payload attribute has to be extracted
+	if rule.Frontend.Body.MaxSize < 0 {
+		return errors.New("max_body_size can't be negative")
+	}
+	switch rule.Frontend.GraphQL.Operation {
+	case "", "query", "mutation", "subscription":
+	default:
+		return errors.Errorf("unknown graphql operation %q", rule.Frontend.GraphQL.Operation)
+	}
+	rule.Frontend.Body.Buffered = rule.Frontend.GraphQL.Enabled()
+	for _, spec := range rule.Middlewares {
+		rule.Frontend.Body.Buffered = rule.Frontend.Body.Buffered || middleware.ReadsRequestPayload(spec.Config)
+	}
+	for _, spec := range rule.Hooks {
+		rule.Frontend.Body.Buffered = rule.Frontend.Body.Buffered || hook.ReadsRequestPayload(spec.Config)
+	}
+	// consistent hashing may hash on a payload attribute
+	rule.Frontend.Body.Buffered = rule.Frontend.Body.Buffered || middleware.ReadsRequestPayload(rule.Backend.LoadBalancer)
+	if rule.Backend.Descriptors == nil && readsProtoFieldNames(rule) {
+		return errors.New("grpc_payload attributes with path need proto descriptors of the backend")
+	}
+
+	for _, compiler := range repo.ruleCompilers {
+		if err := compiler(rule); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// RegisterRuleCompiler adds a compiler run for every rule while loading
+// rules, e.g. to decode middleware and hook configs and chain them. A rule
+// file with a rule failing to compile is skipped.
+// Should be called before InitCache
+func (repo *RuleRepository) RegisterRuleCompiler(compiler structs.RuleCompiler) {
+	repo.ruleCompilers = append(repo.ruleCompilers, compiler)
+}
+
+// RegisterBackendCompiler sets the compiler used for backends while loading
+// rules, a rule file with an invalid backend is skipped
+// Should be called before InitCache
+func (repo *RuleRepository) RegisterBackendCompiler(compiler structs.BackendCompiler) {
+	repo.backendCompiler = compiler
+}
+
+// RegisterBackendTLSCompiler sets the compiler of backend tls blocks, used
+// to connect to https backends resolving descriptors with reflection
+// Should be called before InitCache
+func (repo *RuleRepository) RegisterBackendTLSCompiler(compiler structs.BackendTLSCompiler) {
+	repo.backendTLSCompiler = compiler
+}
+
+// RegisterLoadListener calls fn with the rulesets every time they are loaded,
+// e.g. to release what was compiled for rules which are gone
+// Should be called before InitCache
+func (repo *RuleRepository) RegisterLoadListener(fn func(rulesets []structs.Ruleset)) {
+	repo.loadListeners = append(repo.loadListeners, fn)
+}
+
 func (repo *RuleRepository) InitCache(ctx context.Context, refreshDelay time.Duration) error {
 	repo.cron = cron.New(cron.WithChain(
 		cron.SkipIfStillRunning(cron.DefaultLogger),
@@ -185,8 +416,38 @@ func (repo *RuleRepository) InitCache(ctx context.Context, refreshDelay time.Dur
 	return repo.refresh(ctx)
 }
 
+// Reload refreshes the cache right away
+func (repo *RuleRepository) Reload(ctx context.Context) error {
+	return repo.refresh(ctx)
+}
+
+// Status returns version, load time and last error of the loaded rules
+func (repo *RuleRepository) Status() LoadStatus {
+	return repo.status.get()
+}
+
+// Watch reloads the cache as soon as files change in dir, used for file:// buckets
+func (repo *RuleRepository) Watch(ctx context.Context, dir string) error {
+	closeWatch, err := watchDir(ctx, repo.log, dir, repo.refresh)
+	if err != nil {
+		return err
+	}
+	repo.closeWatch = closeWatch
+	return nil
+}
+
 func (repo *RuleRepository) Close() error {
+	if repo.closeWatch != nil {
+		if err := repo.closeWatch(); err != nil {
+			repo.log.Warn("failed to close file watcher", "err", err)
+		}
+	}
 	<-repo.cron.Stop().Done()
+	repo.loadMu.Lock()
+	for _, reflection := range repo.reflections {
+		reflection.Close()
+	}
+	repo.loadMu.Unlock()
 	return repo.bucket.Close()
 }
 
@@ -195,5 +456,7 @@ func NewRuleRepository(logger log.Logger, b store.Bucket) *RuleRepository {
 		log:    logger,
 		bucket: b,
 		mu:     new(sync.Mutex),
+
+		reflections: map[string]*body_extractor.Reflection{},
 	}
 }
@@ -0,0 +1,148 @@
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/odpf/salt/log"
+)
+
+// watchDebounce groups file events fired together while a file is being written
+const watchDebounce = 500 * time.Millisecond
+
+// LoadStatus describes the version of configs currently loaded by a repository
+type LoadStatus struct {
+	// Version is the sha256 of all loaded file names and their content
+	Version  string    `json:"version"`
+	LoadedAt time.Time `json:"loaded_at"`
+	Count    int       `json:"count"`
+
+	LastError   string    `json:"last_error,omitempty"`
+	LastErrorAt time.Time `json:"last_error_at,omitempty"`
+
+	// Loads and Failures count refreshes since start
+	Loads    int `json:"loads"`
+	Failures int `json:"failures"`
+}
+
+type statusTracker struct {
+	mu     sync.RWMutex
+	status LoadStatus
+}
+
+func (t *statusTracker) get() LoadStatus {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.status
+}
+
+func (t *statusTracker) loaded(version string, count int, warnings error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.status.Version = version
+	t.status.LoadedAt = time.Now().UTC()
+	t.status.Count = count
+	t.status.Loads++
+	if warnings != nil {
+		t.status.LastError = warnings.Error()
+		t.status.LastErrorAt = t.status.LoadedAt
+		return
+	}
+	// errors of earlier loads are fixed once everything loads
+	t.status.LastError = ""
+	t.status.LastErrorAt = time.Time{}
+}
+
+func (t *statusTracker) failed(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.status.LastError = err.Error()
+	t.status.LastErrorAt = time.Now().UTC()
+	t.status.Failures++
+}
+
+// versionHash accumulates loaded files into a version
+type versionHash struct {
+	h hash.Hash
+}
+
+func newVersionHash() *versionHash {
+	return &versionHash{h: sha256.New()}
+}
+
+func (v *versionHash) add(key string, content []byte) {
+	v.h.Write([]byte(key))
+	v.h.Write([]byte{0})
+	v.h.Write(content)
+	v.h.Write([]byte{0})
+}
+
+func (v *versionHash) sum() string {
+	return hex.EncodeToString(v.h.Sum(nil))
+}
+
+// watchDir calls reload whenever a file under dir is created, written, renamed
+// or removed, returned func stops watching
+func watchDir(ctx context.Context, logger log.Logger, dir string, reload func(ctx context.Context) error) (func() error, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	// fsnotify doesn't watch nested directories on its own
+	if err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return watcher.Add(path)
+		}
+		return nil
+	}); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	go func() {
+		var debounce <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&fsnotify.Create == fsnotify.Create {
+					if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+						if err := watcher.Add(event.Name); err != nil {
+							logger.Warn("failed to watch directory", "dir", event.Name, "err", err)
+						}
+					}
+				}
+				if event.Op == fsnotify.Chmod {
+					continue
+				}
+				debounce = time.After(watchDebounce)
+			case <-debounce:
+				debounce = nil
+				logger.Info("config files changed, reloading", "dir", dir)
+				if err := reload(ctx); err != nil {
+					logger.Warn("failed to reload on file change", "dir", dir, "err", err)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Warn("file watcher error", "dir", dir, "err", err)
+			}
+		}
+	}()
+	return watcher.Close, nil
+}
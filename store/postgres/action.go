@@ -41,7 +41,7 @@ func (s Store) GetAction(ctx context.Context, id string) (model.Action, error) {
 func (s Store) selectAction(ctx context.Context, id string, txn *sqlx.Tx) (model.Action, error) {
 	var fetchedAction Action
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "selectAction", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedAction, getActionQuery, id)
 	})
 
@@ -67,7 +67,7 @@ func (s Store) CreateAction(ctx context.Context, actionToCreate model.Action) (m
 	var newAction Action
 
 	nsId := utils.DefaultStringIfEmpty(actionToCreate.Namespace.Id, actionToCreate.NamespaceId)
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "CreateAction", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &newAction, createActionQuery, actionToCreate.Id, actionToCreate.Name, nsId)
 	})
 
@@ -85,7 +85,7 @@ func (s Store) CreateAction(ctx context.Context, actionToCreate model.Action) (m
 
 func (s Store) ListActions(ctx context.Context) ([]model.Action, error) {
 	var fetchedActions []Action
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListActions", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedActions, listActionsQuery)
 	})
 
@@ -114,7 +114,7 @@ func (s Store) ListActions(ctx context.Context) ([]model.Action, error) {
 func (s Store) UpdateAction(ctx context.Context, toUpdate model.Action) (model.Action, error) {
 	var updatedAction Action
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "UpdateAction", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &updatedAction, updateActionQuery, toUpdate.Id, toUpdate.Name, toUpdate.NamespaceId)
 	})
 
@@ -42,7 +42,7 @@ var (
 
 func (s Store) GetGroup(ctx context.Context, id string) (model.Group, error) {
 	var fetchedGroup Group
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "GetGroup", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedGroup, getGroupsQuery, id)
 	})
 
@@ -71,7 +71,7 @@ func (s Store) CreateGroup(ctx context.Context, grp model.Group) (model.Group, e
 	}
 
 	var newGroup Group
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "CreateGroup", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &newGroup, createGroupsQuery, grp.Name, grp.Slug, grp.OrganizationId, marshaledMetadata)
 	})
 
@@ -96,7 +96,7 @@ func (s Store) ListGroups(ctx context.Context, org model.Organization) ([]model.
 	}
 
 	query = query + ";"
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListGroups", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedGroups, query)
 	})
 
@@ -129,7 +129,7 @@ func (s Store) UpdateGroup(ctx context.Context, toUpdate model.Group) (model.Gro
 	}
 
 	var updatedGroup Group
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "UpdateGroup", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &updatedGroup, updateGroupQuery, toUpdate.Id, toUpdate.Name, toUpdate.Slug, toUpdate.Organization.Id, marshaledMetadata)
 	})
 
@@ -154,7 +154,7 @@ func (s Store) ListGroupUsers(ctx context.Context, groupId string, roleId string
 	}
 
 	var fetchedUsers []User
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListGroupUsers", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedUsers, listGroupUsersQuery, groupId, role)
 	})
 
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS relations_object_idx;
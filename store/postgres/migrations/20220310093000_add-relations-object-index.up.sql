@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS relations_object_idx ON relations (object_namespace_id, object_id);
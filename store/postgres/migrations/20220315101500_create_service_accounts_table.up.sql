@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS service_accounts
+(
+    id         uuid        PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    name       varchar     NOT NULL,
+    org_id     uuid        NOT NULL        REFERENCES organizations(id),
+    project_id uuid                        REFERENCES projects(id),
+    metadata   jsonb,
+    created_at timestamptz NOT NULL        DEFAULT NOW(),
+    updated_at timestamptz NOT NULL        DEFAULT NOW(),
+    deleted_at timestamptz
+);
+
+-- names of deleted service accounts can be reused
+CREATE UNIQUE INDEX IF NOT EXISTS service_accounts_org_id_name_idx ON service_accounts (org_id, name) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id                 uuid        PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    name               varchar     NOT NULL,
+    service_account_id uuid        NOT NULL        REFERENCES service_accounts(id) ON DELETE CASCADE,
+    secret_hash        varchar     NOT NULL,
+    expires_at         timestamptz,
+    revoked_at         timestamptz,
+    created_at         timestamptz NOT NULL        DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_service_account_id_idx ON api_keys (service_account_id);
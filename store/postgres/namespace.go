@@ -38,7 +38,7 @@ func (s Store) GetNamespace(ctx context.Context, id string) (model.Namespace, er
 func (s Store) selectNamespace(ctx context.Context, id string, txn *sqlx.Tx) (model.Namespace, error) {
 	var fetchedNamespace Namespace
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "selectNamespace", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedNamespace, getNamespaceQuery, id)
 	})
 
@@ -62,7 +62,7 @@ func (s Store) selectNamespace(ctx context.Context, id string, txn *sqlx.Tx) (mo
 
 func (s Store) CreateNamespace(ctx context.Context, namespaceToCreate model.Namespace) (model.Namespace, error) {
 	var newNamespace Namespace
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "CreateNamespace", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &newNamespace, createNamespaceQuery, namespaceToCreate.Id, namespaceToCreate.Name)
 	})
 
@@ -80,7 +80,7 @@ func (s Store) CreateNamespace(ctx context.Context, namespaceToCreate model.Name
 
 func (s Store) ListNamespaces(ctx context.Context) ([]model.Namespace, error) {
 	var fetchedNamespaces []Namespace
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListNamespaces", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedNamespaces, listNamespacesQuery)
 	})
 
@@ -109,7 +109,7 @@ func (s Store) ListNamespaces(ctx context.Context) ([]model.Namespace, error) {
 func (s Store) UpdateNamespace(ctx context.Context, id string, toUpdate model.Namespace) (model.Namespace, error) {
 	var updatedNamespace Namespace
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "UpdateNamespace", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &updatedNamespace, updateNamespaceQuery, id, toUpdate.Id, toUpdate.Name)
 	})
 
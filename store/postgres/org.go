@@ -39,7 +39,7 @@ var (
 
 func (s Store) GetOrg(ctx context.Context, id string) (model.Organization, error) {
 	var fetchedOrg Organization
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "GetOrg", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedOrg, getOrganizationsQuery, id)
 	})
 
@@ -68,7 +68,7 @@ func (s Store) CreateOrg(ctx context.Context, orgToCreate model.Organization) (m
 	}
 
 	var newOrg Organization
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "CreateOrg", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &newOrg, createOrganizationQuery, orgToCreate.Name, orgToCreate.Slug, marshaledMetadata)
 	})
 
@@ -86,7 +86,7 @@ func (s Store) CreateOrg(ctx context.Context, orgToCreate model.Organization) (m
 
 func (s Store) ListOrg(ctx context.Context) ([]model.Organization, error) {
 	var fetchedOrgs []Organization
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListOrg", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedOrgs, listOrganizationsQuery)
 	})
 
@@ -120,7 +120,7 @@ func (s Store) UpdateOrg(ctx context.Context, toUpdate model.Organization) (mode
 		return model.Organization{}, fmt.Errorf("%w: %s", parseErr, err)
 	}
 
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "UpdateOrg", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &updatedOrg, updateOrganizationQuery, toUpdate.Id, toUpdate.Name, toUpdate.Slug, marshaledMetadata)
 	})
 
@@ -139,7 +139,7 @@ func (s Store) UpdateOrg(ctx context.Context, toUpdate model.Organization) (mode
 func (s Store) ListOrgAdmins(ctx context.Context, id string) ([]model.User, error) {
 	var fetchedUsers []User
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListOrgAdmins", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedUsers, listOrganizationAdmins, id)
 	})
 
@@ -47,7 +47,7 @@ func (s Store) GetPolicy(ctx context.Context, id string) (model.Policy, error) {
 func (s Store) selectPolicy(ctx context.Context, id string) (model.Policy, error) {
 	var fetchedPolicy Policy
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "selectPolicy", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedPolicy, getPolicyQuery, id)
 	})
 
@@ -71,7 +71,7 @@ func (s Store) selectPolicy(ctx context.Context, id string) (model.Policy, error
 
 func (s Store) ListPolicies(ctx context.Context) ([]model.Policy, error) {
 	var fetchedPolicies []Policy
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListPolicies", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedPolicies, listPolicyQuery)
 	})
 
@@ -100,7 +100,7 @@ func (s Store) CreatePolicy(ctx context.Context, policyToCreate model.Policy) ([
 	actionId := utils.DefaultStringIfEmpty(policyToCreate.Action.Id, policyToCreate.ActionId)
 	nsId := utils.DefaultStringIfEmpty(policyToCreate.Namespace.Id, policyToCreate.NamespaceId)
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "CreatePolicy", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &newPolicy, createPolicyQuery, nsId, roleId, sql.NullString{String: actionId, Valid: actionId != ""})
 	})
 	if err != nil {
@@ -112,7 +112,7 @@ func (s Store) CreatePolicy(ctx context.Context, policyToCreate model.Policy) ([
 func (s Store) UpdatePolicy(ctx context.Context, id string, toUpdate model.Policy) ([]model.Policy, error) {
 	var updatedPolicy Policy
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "UpdatePolicy", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &updatedPolicy, updatePolicyQuery, id, toUpdate.NamespaceId, toUpdate.RoleId, sql.NullString{String: toUpdate.ActionId, Valid: toUpdate.ActionId != ""})
 	})
 
@@ -31,7 +31,7 @@ const (
 
 func (s Store) GetProject(ctx context.Context, id string) (model.Project, error) {
 	var fetchedProject Project
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "GetProject", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedProject, getProjectsQuery, id)
 	})
 
@@ -64,7 +64,7 @@ func (s Store) CreateProject(ctx context.Context, projectToCreate model.Project)
 	}
 
 	var newProject Project
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "CreateProject", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &newProject, createProjectQuery, projectToCreate.Name, projectToCreate.Slug, projectToCreate.Organization.Id, marshaledMetadata)
 	})
 
@@ -82,7 +82,7 @@ func (s Store) CreateProject(ctx context.Context, projectToCreate model.Project)
 
 func (s Store) ListProject(ctx context.Context) ([]model.Project, error) {
 	var fetchedProjects []Project
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListProject", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedProjects, listProjectQuery)
 	})
 
@@ -116,7 +116,7 @@ func (s Store) UpdateProject(ctx context.Context, toUpdate model.Project) (model
 		return model.Project{}, fmt.Errorf("%w: %s", parseErr, err)
 	}
 
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "UpdateProject", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &updatedProject, updateProjectQuery, toUpdate.Id, toUpdate.Name, toUpdate.Slug, toUpdate.Organization.Id, marshaledMetadata)
 	})
 
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/odpf/shield/internal/ratelimit"
+)
+
+type RateLimitBucket struct {
+	Tokens  float64 `db:"tokens"`
+	Allowed bool    `db:"allowed"`
+}
+
+const (
+	// refilledTokens are tokens of an existing bucket refilled since it was
+	// last updated, $2 is the burst and $3 the refill rate per second
+	refilledTokens = `LEAST($2::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at) * $3::float8)`
+
+	// a bucket is surely full after burst / rate seconds without requests
+	bucketFullAt = `now() + make_interval(secs => $2::float8 / $3::float8)`
+
+	takeRateLimitTokenQuery = `INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at, full_at)
+		VALUES ($1, $2::float8 - 1, true, now(), ` + bucketFullAt + `)
+		ON CONFLICT (key) DO UPDATE SET
+			tokens = ` + refilledTokens + ` - CASE WHEN ` + refilledTokens + ` >= 1 THEN 1 ELSE 0 END,
+			allowed = ` + refilledTokens + ` >= 1,
+			updated_at = now(),
+			full_at = ` + bucketFullAt + `
+		RETURNING tokens, allowed;`
+	deleteFullRateLimitBucketsQuery = `DELETE FROM rate_limit_buckets WHERE full_at < now();`
+)
+
+// TakeRateLimitToken takes a token in a single statement so instances
+// sharing the database share limits, time is taken from the database clock
+func (s Store) TakeRateLimitToken(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	var bucket RateLimitBucket
+	err := s.DB.WithTimeout(ctx, "TakeRateLimitToken", func(ctx context.Context) error {
+		return s.DB.GetContext(ctx, &bucket, takeRateLimitTokenQuery, key, limit.Burst, limit.PerSecond())
+	})
+	if err != nil {
+		return ratelimit.Result{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+	return limit.Result(bucket.Tokens, bucket.Allowed), nil
+}
+
+func (s Store) DeleteFullRateLimitBuckets(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "DeleteFullRateLimitBuckets", func(ctx context.Context) error {
+		_, err := s.DB.ExecContext(ctx, deleteFullRateLimitBucketsQuery)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %s", dbErr, err)
+	}
+	return nil
+}
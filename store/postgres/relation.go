@@ -105,6 +105,33 @@ const (
 		       updated_at 
 		FROM relations 
 		WHERE subject_namespace_id=$1 AND subject_id=$2 AND object_namespace_id=$3 AND object_id=$4 AND (role_id IS NULL OR role_id = $5) AND (namespace_id IS NULL OR namespace_id = $6);`
+	listObjectRelationsQuery = `
+		SELECT 
+		       id, 
+		       subject_namespace_id, 
+		       subject_id, 
+		       object_namespace_id, 
+		       object_id, 
+		       role_id,
+		       namespace_id,
+		       created_at, 
+		       updated_at 
+		FROM relations 
+		WHERE object_namespace_id=$1 AND object_id=$2 AND REPLACE(COALESCE(namespace_id, role_id), '-', '_') = $3;`
+	listResourceRelationsQuery = `
+		SELECT 
+		       id, 
+		       subject_namespace_id, 
+		       subject_id, 
+		       object_namespace_id, 
+		       object_id, 
+		       role_id,
+		       namespace_id,
+		       created_at, 
+		       updated_at 
+		FROM relations 
+		WHERE (object_namespace_id=$1 AND object_id=$2) OR (subject_namespace_id=$1 AND subject_id=$2);`
+	listObjectIdsQuery = `SELECT DISTINCT object_id FROM relations WHERE object_namespace_id=$1 ORDER BY object_id;`
 	deleteRelationById = `DELETE FROM relations WHERE id = $1;`
 )
 
@@ -121,7 +148,7 @@ func (s Store) CreateRelation(ctx context.Context, relationToCreate model.Relati
 		roleId = ""
 	}
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "CreateRelation", func(ctx context.Context) error {
 		return s.DB.GetContext(
 			ctx,
 			&newRelation,
@@ -150,7 +177,7 @@ func (s Store) CreateRelation(ctx context.Context, relationToCreate model.Relati
 
 func (s Store) ListRelations(ctx context.Context) ([]model.Relation, error) {
 	var fetchedRelations []Relation
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListRelations", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedRelations, listRelationQuery)
 	})
 
@@ -178,7 +205,7 @@ func (s Store) ListRelations(ctx context.Context) ([]model.Relation, error) {
 
 func (s Store) GetRelation(ctx context.Context, id string) (model.Relation, error) {
 	var fetchedRelation Relation
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "GetRelation", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedRelation, getRelationsQuery, id)
 	})
 
@@ -204,8 +231,69 @@ func (s Store) GetRelation(ctx context.Context, id string) (model.Relation, erro
 	return transformedRelation, nil
 }
 
+// ListObjectRelations returns relations on the object where the role or namespace
+// matches the relation name as it appears in the authz schema
+func (s Store) ListObjectRelations(ctx context.Context, objectNamespaceId, objectId, relationName string) ([]model.Relation, error) {
+	var fetchedRelations []Relation
+	err := s.DB.WithTimeout(ctx, "ListObjectRelations", func(ctx context.Context) error {
+		return s.DB.SelectContext(ctx, &fetchedRelations, listObjectRelationsQuery, objectNamespaceId, objectId, relationName)
+	})
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return []model.Relation{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var transformedRelations []model.Relation
+	for _, r := range fetchedRelations {
+		transformedRelation, err := transformToRelation(r)
+		if err != nil {
+			return []model.Relation{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		transformedRelations = append(transformedRelations, transformedRelation)
+	}
+
+	return transformedRelations, nil
+}
+
+// ListResourceRelations returns relations in which the resource is either
+// the object or the subject
+func (s Store) ListResourceRelations(ctx context.Context, namespaceId, id string) ([]model.Relation, error) {
+	var fetchedRelations []Relation
+	err := s.DB.WithTimeout(ctx, "ListResourceRelations", func(ctx context.Context) error {
+		return s.DB.SelectContext(ctx, &fetchedRelations, listResourceRelationsQuery, namespaceId, id)
+	})
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return []model.Relation{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var transformedRelations []model.Relation
+	for _, r := range fetchedRelations {
+		transformedRelation, err := transformToRelation(r)
+		if err != nil {
+			return []model.Relation{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		transformedRelations = append(transformedRelations, transformedRelation)
+	}
+
+	return transformedRelations, nil
+}
+
+// ListObjectIds returns ids of objects in the namespace having any relation
+func (s Store) ListObjectIds(ctx context.Context, objectNamespaceId string) ([]string, error) {
+	var ids []string
+	err := s.DB.WithTimeout(ctx, "ListObjectIds", func(ctx context.Context) error {
+		return s.DB.SelectContext(ctx, &ids, listObjectIdsQuery, objectNamespaceId)
+	})
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", dbErr, err)
+	}
+	return ids, nil
+}
+
 func (s Store) DeleteRelationById(ctx context.Context, id string) error {
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "DeleteRelationById", func(ctx context.Context) error {
 		result, err := s.DB.ExecContext(ctx, deleteRelationById, id)
 		if err == nil {
 			count, err := result.RowsAffected()
@@ -233,7 +321,7 @@ func (s Store) GetRelationByFields(ctx context.Context, rel model.Relation) (mod
 		roleId = ""
 	}
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "GetRelationByFields", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx,
 			&fetchedRelation,
 			getRelationByFieldsQuery,
@@ -281,7 +369,7 @@ func (s Store) UpdateRelation(ctx context.Context, id string, toUpdate model.Rel
 		roleId = ""
 	}
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "UpdateRelation", func(ctx context.Context) error {
 		return s.DB.GetContext(
 			ctx,
 			&updatedRelation,
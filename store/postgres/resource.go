@@ -9,6 +9,8 @@ import (
 
 	"github.com/odpf/shield/internal/resource"
 	"github.com/odpf/shield/model"
+
+	"github.com/lib/pq"
 )
 
 type Resource struct {
@@ -60,6 +62,26 @@ const (
 			created_at,
 			updated_at
 		FROM resources`
+	listResourcesByIdsQuery = `
+		SELECT
+			id,
+		    name,
+			project_id,
+			group_id,
+			org_id,
+			namespace_id,
+		    user_id,
+			created_at,
+			updated_at
+		FROM resources
+		WHERE id = ANY($1)
+		ORDER BY id`
+	listResourceIdsByOrgQuery = `
+		SELECT id
+		FROM resources
+		WHERE org_id = $1 AND id = ANY($2)
+		ORDER BY id
+		LIMIT $3`
 	getResourcesQuery = `
 		SELECT
 			id,
@@ -83,6 +105,7 @@ const (
 		    user_id = $7
 		WHERE id = $1
 		`
+	deleteResourceQuery = `DELETE FROM resources WHERE id = $1`
 )
 
 func (s Store) CreateResource(ctx context.Context, resourceToCreate model.Resource) (model.Resource, error) {
@@ -91,7 +114,7 @@ func (s Store) CreateResource(ctx context.Context, resourceToCreate model.Resour
 	userId := sql.NullString{String: resourceToCreate.UserId, Valid: resourceToCreate.UserId != ""}
 	groupId := sql.NullString{String: resourceToCreate.GroupId, Valid: resourceToCreate.GroupId != ""}
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "CreateResource", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &newResource, createResourceQuery, resourceToCreate.Id, resourceToCreate.Name, resourceToCreate.ProjectId, groupId, resourceToCreate.OrganizationId, resourceToCreate.NamespaceId, userId)
 	})
 
@@ -110,7 +133,7 @@ func (s Store) CreateResource(ctx context.Context, resourceToCreate model.Resour
 
 func (s Store) ListResources(ctx context.Context) ([]model.Resource, error) {
 	var fetchedResources []Resource
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListResources", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedResources, listResourcesQuery)
 	})
 
@@ -136,9 +159,47 @@ func (s Store) ListResources(ctx context.Context) ([]model.Resource, error) {
 	return transformedResources, nil
 }
 
+// ListResourcesByIds returns resources having the ids, ids which don't
+// exist are skipped
+func (s Store) ListResourcesByIds(ctx context.Context, ids []string) ([]model.Resource, error) {
+	var fetchedResources []Resource
+	err := s.DB.WithTimeout(ctx, "ListResourcesByIds", func(ctx context.Context) error {
+		return s.DB.SelectContext(ctx, &fetchedResources, listResourcesByIdsQuery, pq.StringArray(ids))
+	})
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return []model.Resource{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	transformedResources := []model.Resource{}
+	for _, r := range fetchedResources {
+		transformedResource, err := transformToResource(r)
+		if err != nil {
+			return []model.Resource{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+
+		transformedResources = append(transformedResources, transformedResource)
+	}
+
+	return transformedResources, nil
+}
+
+// ListResourceIdsByOrg returns up to limit of the ids, in order, which are
+// resources of the organization
+func (s Store) ListResourceIdsByOrg(ctx context.Context, orgId string, ids []string, limit int) ([]string, error) {
+	orgIds := []string{}
+	err := s.DB.WithTimeout(ctx, "ListResourceIdsByOrg", func(ctx context.Context) error {
+		return s.DB.SelectContext(ctx, &orgIds, listResourceIdsByOrgQuery, orgId, pq.StringArray(ids), limit)
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return []string{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+	return orgIds, nil
+}
+
 func (s Store) GetResource(ctx context.Context, id string) (model.Resource, error) {
 	var fetchedResource Resource
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "GetResource", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedResource, getResourcesQuery, id)
 	})
 
@@ -170,7 +231,7 @@ func (s Store) UpdateResource(ctx context.Context, id string, toUpdate model.Res
 	userId := sql.NullString{String: toUpdate.UserId, Valid: toUpdate.UserId != ""}
 	groupId := sql.NullString{String: toUpdate.GroupId, Valid: toUpdate.GroupId != ""}
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "UpdateResource", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &updatedResource, updateResourceQuery, id, toUpdate.Name, toUpdate.ProjectId, groupId, toUpdate.OrganizationId, toUpdate.NamespaceId, userId)
 	})
 
@@ -192,6 +253,27 @@ func (s Store) UpdateResource(ctx context.Context, id string, toUpdate model.Res
 	return toUpdate, nil
 }
 
+// DeleteResource removes the resource row, relations of the resource are
+// removed separately
+func (s Store) DeleteResource(ctx context.Context, id string) error {
+	var count int64
+	err := s.DB.WithTimeout(ctx, "DeleteResource", func(ctx context.Context) error {
+		result, err := s.DB.ExecContext(ctx, deleteResourceQuery, id)
+		if err != nil {
+			return err
+		}
+		count, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %s", dbErr, err)
+	}
+	if count == 0 {
+		return resource.ResourceDoesntExist
+	}
+	return nil
+}
+
 func transformToResource(from Resource) (model.Resource, error) {
 	// TODO: remove *Id
 	return model.Resource{
@@ -33,7 +33,7 @@ const roleJoinStatement = `JOIN namespaces on namespaces.id = r.namespace_id`
 var (
 	createRoleQuery = `INSERT into roles(id, name, types, namespace_id, metadata) 
 		values($1, $2, $3, $4, $5) 
-		ON CONFLICT (id) DO UPDATE SET name=$2
+		ON CONFLICT (id) DO UPDATE SET name=$2, types=$3
 		RETURNING id;`
 	getRoleQuery    = fmt.Sprintf(`SELECT %s FROM roles r %s WHERE r.id = $1`, roleSelectStatement, roleJoinStatement)
 	listRolesQuery  = fmt.Sprintf(`SELECT %s FROM roles r %s`, roleSelectStatement, roleJoinStatement)
@@ -42,7 +42,7 @@ var (
 
 func (s Store) GetRole(ctx context.Context, id string) (model.Role, error) {
 	var fetchedRole Role
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "GetRole", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedRole, getRoleQuery, id)
 	})
 
@@ -71,14 +71,14 @@ func (s Store) CreateRole(ctx context.Context, roleToCreate model.Role) (model.R
 
 	nsId := utils.DefaultStringIfEmpty(roleToCreate.Namespace.Id, roleToCreate.NamespaceId)
 
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "CreateRole", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &newRole, createRoleQuery, roleToCreate.Id, roleToCreate.Name, pq.StringArray(roleToCreate.Types), nsId, marshaledMetadata)
 	})
 	if err != nil {
 		return model.Role{}, fmt.Errorf("%w: %s", dbErr, err)
 	}
 
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "CreateRole", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedRole, getRoleQuery, newRole.Id)
 	})
 
@@ -98,7 +98,7 @@ func (s Store) CreateRole(ctx context.Context, roleToCreate model.Role) (model.R
 
 func (s Store) ListRoles(ctx context.Context) ([]model.Role, error) {
 	var fetchedRoles []Role
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListRoles", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedRoles, listRolesQuery)
 	})
 	if errors.Is(err, sql.ErrNoRows) {
@@ -129,7 +129,7 @@ func (s Store) UpdateRole(ctx context.Context, toUpdate model.Role) (model.Role,
 		return model.Role{}, fmt.Errorf("%w: %s", parseErr, err)
 	}
 
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "UpdateRole", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &updatedRole, updateRoleQuery, toUpdate.Id, toUpdate.Name, pq.StringArray(toUpdate.Types), toUpdate.NamespaceId, marshaledMetadata)
 	})
 
@@ -139,7 +139,7 @@ func (s Store) UpdateRole(ctx context.Context, toUpdate model.Role) (model.Role,
 		return model.Role{}, fmt.Errorf("%w: %s", dbErr, err)
 	}
 
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "UpdateRole", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &fetchedRole, getRoleQuery, updatedRole.Id)
 	})
 
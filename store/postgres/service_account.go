@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/odpf/shield/internal/serviceaccount"
+	"github.com/odpf/shield/model"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type ServiceAccount struct {
+	Id        string         `db:"id"`
+	Name      string         `db:"name"`
+	OrgId     string         `db:"org_id"`
+	ProjectId sql.NullString `db:"project_id"`
+	Metadata  []byte         `db:"metadata"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+type APIKey struct {
+	Id               string       `db:"id"`
+	Name             string       `db:"name"`
+	ServiceAccountId string       `db:"service_account_id"`
+	SecretHash       string       `db:"secret_hash"`
+	ExpiresAt        sql.NullTime `db:"expires_at"`
+	RevokedAt        sql.NullTime `db:"revoked_at"`
+	CreatedAt        time.Time    `db:"created_at"`
+}
+
+const (
+	serviceAccountColumns        = `id, name, org_id, project_id, metadata, created_at, updated_at`
+	apiKeyColumns                = `id, name, service_account_id, secret_hash, expires_at, revoked_at, created_at`
+	getServiceAccountQuery       = `SELECT ` + serviceAccountColumns + ` FROM service_accounts WHERE id = $1 AND deleted_at IS NULL;`
+	createServiceAccountQuery    = `INSERT INTO service_accounts(name, org_id, project_id, metadata) values($1, $2, $3, $4) RETURNING ` + serviceAccountColumns + `;`
+	listServiceAccountsQuery     = `SELECT ` + serviceAccountColumns + ` FROM service_accounts WHERE deleted_at IS NULL;`
+	listOrgServiceAccountsQuery  = `SELECT ` + serviceAccountColumns + ` FROM service_accounts WHERE org_id = $1 AND deleted_at IS NULL;`
+	deleteServiceAccountQuery    = `UPDATE service_accounts SET deleted_at = now() WHERE id = $1 AND deleted_at IS NULL;`
+	revokeServiceAccountKeyQuery = `UPDATE api_keys SET revoked_at = now() WHERE service_account_id = $1 AND revoked_at IS NULL;`
+	getAPIKeyQuery               = `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = $1;`
+	createAPIKeyQuery            = `INSERT INTO api_keys(name, service_account_id, secret_hash, expires_at) values($1, $2, $3, $4) RETURNING ` + apiKeyColumns + `;`
+	listAPIKeysQuery             = `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE service_account_id = $1 ORDER BY created_at;`
+	revokeAPIKeyQuery            = `UPDATE api_keys SET revoked_at = COALESCE(revoked_at, now()) WHERE id = $1 RETURNING ` + apiKeyColumns + `;`
+	expireAPIKeyQuery            = `UPDATE api_keys SET expires_at = $2 WHERE id = $1 RETURNING ` + apiKeyColumns + `;`
+)
+
+func (s Store) GetServiceAccount(ctx context.Context, id string) (model.ServiceAccount, error) {
+	var fetched ServiceAccount
+	err := s.DB.WithTimeout(ctx, "GetServiceAccount", func(ctx context.Context) error {
+		return s.DB.GetContext(ctx, &fetched, getServiceAccountQuery, id)
+	})
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ServiceAccount{}, serviceaccount.ServiceAccountDoesntExist
+	} else if err != nil && isInvalidUUIDErr(err) {
+		return model.ServiceAccount{}, serviceaccount.InvalidUUID
+	} else if err != nil {
+		return model.ServiceAccount{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	transformed, err := transformToServiceAccount(fetched)
+	if err != nil {
+		return model.ServiceAccount{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+	return transformed, nil
+}
+
+func (s Store) CreateServiceAccount(ctx context.Context, serviceAccount model.ServiceAccount) (model.ServiceAccount, error) {
+	marshaledMetadata, err := json.Marshal(serviceAccount.Metadata)
+	if err != nil {
+		return model.ServiceAccount{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+
+	projectId := sql.NullString{String: serviceAccount.ProjectId, Valid: serviceAccount.ProjectId != ""}
+	var created ServiceAccount
+	err = s.DB.WithTimeout(ctx, "CreateServiceAccount", func(ctx context.Context) error {
+		return s.DB.GetContext(ctx, &created, createServiceAccountQuery, serviceAccount.Name, serviceAccount.OrganizationId, projectId, marshaledMetadata)
+	})
+	if err != nil && isInvalidUUIDErr(err) {
+		return model.ServiceAccount{}, serviceaccount.InvalidUUID
+	} else if err != nil {
+		return model.ServiceAccount{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	transformed, err := transformToServiceAccount(created)
+	if err != nil {
+		return model.ServiceAccount{}, fmt.Errorf("%w: %s", parseErr, err)
+	}
+	return transformed, nil
+}
+
+func (s Store) ListServiceAccounts(ctx context.Context, orgId string) ([]model.ServiceAccount, error) {
+	var fetched []ServiceAccount
+	err := s.DB.WithTimeout(ctx, "ListServiceAccounts", func(ctx context.Context) error {
+		if orgId == "" {
+			return s.DB.SelectContext(ctx, &fetched, listServiceAccountsQuery)
+		}
+		return s.DB.SelectContext(ctx, &fetched, listOrgServiceAccountsQuery, orgId)
+	})
+	if err != nil && isInvalidUUIDErr(err) {
+		return []model.ServiceAccount{}, serviceaccount.InvalidUUID
+	} else if err != nil {
+		return []model.ServiceAccount{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var transformed []model.ServiceAccount
+	for _, sa := range fetched {
+		t, err := transformToServiceAccount(sa)
+		if err != nil {
+			return []model.ServiceAccount{}, fmt.Errorf("%w: %s", parseErr, err)
+		}
+		transformed = append(transformed, t)
+	}
+	return transformed, nil
+}
+
+// DeleteServiceAccount soft deletes the service account and revokes all its keys
+func (s Store) DeleteServiceAccount(ctx context.Context, id string) error {
+	return s.DB.WithTimeout(ctx, "DeleteServiceAccount", func(ctx context.Context) error {
+		return s.DB.WithTxn(ctx, "DeleteServiceAccount", sql.TxOptions{}, func(txn *sqlx.Tx) error {
+			result, err := txn.ExecContext(ctx, deleteServiceAccountQuery, id)
+			if err != nil && isInvalidUUIDErr(err) {
+				return serviceaccount.InvalidUUID
+			} else if err != nil {
+				return fmt.Errorf("%w: %s", dbErr, err)
+			}
+			if count, err := result.RowsAffected(); err == nil && count == 0 {
+				return serviceaccount.ServiceAccountDoesntExist
+			}
+
+			if _, err := txn.ExecContext(ctx, revokeServiceAccountKeyQuery, id); err != nil {
+				return fmt.Errorf("%w: %s", dbErr, err)
+			}
+			return nil
+		})
+	})
+}
+
+func (s Store) GetAPIKey(ctx context.Context, id string) (model.APIKey, error) {
+	return s.getAPIKey(ctx, getAPIKeyQuery, id)
+}
+
+func (s Store) CreateAPIKey(ctx context.Context, key model.APIKey) (model.APIKey, error) {
+	return s.getAPIKey(ctx, createAPIKeyQuery, key.Name, key.ServiceAccountId, key.SecretHash, nullTime(key.ExpiresAt))
+}
+
+func (s Store) RevokeAPIKey(ctx context.Context, id string) (model.APIKey, error) {
+	return s.getAPIKey(ctx, revokeAPIKeyQuery, id)
+}
+
+func (s Store) ExpireAPIKey(ctx context.Context, id string, expiresAt time.Time) (model.APIKey, error) {
+	return s.getAPIKey(ctx, expireAPIKeyQuery, id, nullTime(expiresAt))
+}
+
+func (s Store) ListAPIKeys(ctx context.Context, serviceAccountId string) ([]model.APIKey, error) {
+	var fetched []APIKey
+	err := s.DB.WithTimeout(ctx, "ListAPIKeys", func(ctx context.Context) error {
+		return s.DB.SelectContext(ctx, &fetched, listAPIKeysQuery, serviceAccountId)
+	})
+	if err != nil && isInvalidUUIDErr(err) {
+		return []model.APIKey{}, serviceaccount.InvalidUUID
+	} else if err != nil {
+		return []model.APIKey{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+
+	var transformed []model.APIKey
+	for _, k := range fetched {
+		transformed = append(transformed, transformToAPIKey(k))
+	}
+	return transformed, nil
+}
+
+func (s Store) getAPIKey(ctx context.Context, query string, args ...interface{}) (model.APIKey, error) {
+	var fetched APIKey
+	err := s.DB.WithTimeout(ctx, "getAPIKey", func(ctx context.Context) error {
+		return s.DB.GetContext(ctx, &fetched, query, args...)
+	})
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.APIKey{}, serviceaccount.APIKeyDoesntExist
+	} else if err != nil && isInvalidUUIDErr(err) {
+		return model.APIKey{}, serviceaccount.InvalidUUID
+	} else if err != nil {
+		return model.APIKey{}, fmt.Errorf("%w: %s", dbErr, err)
+	}
+	return transformToAPIKey(fetched), nil
+}
+
+func isInvalidUUIDErr(err error) bool {
+	// TODO: this uuid syntax is a error defined in db, not in library
+	// need to look into better ways to implement this
+	return strings.HasPrefix(err.Error(), "pq: invalid input syntax for type uuid")
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func transformToServiceAccount(from ServiceAccount) (model.ServiceAccount, error) {
+	var unmarshalledMetadata map[string]string
+	if len(from.Metadata) > 0 {
+		if err := json.Unmarshal(from.Metadata, &unmarshalledMetadata); err != nil {
+			return model.ServiceAccount{}, err
+		}
+	}
+
+	return model.ServiceAccount{
+		Id:             from.Id,
+		Name:           from.Name,
+		OrganizationId: from.OrgId,
+		ProjectId:      from.ProjectId.String,
+		Metadata:       unmarshalledMetadata,
+		CreatedAt:      from.CreatedAt,
+		UpdatedAt:      from.UpdatedAt,
+	}, nil
+}
+
+func transformToAPIKey(from APIKey) model.APIKey {
+	return model.APIKey{
+		Id:               from.Id,
+		Name:             from.Name,
+		ServiceAccountId: from.ServiceAccountId,
+		SecretHash:       from.SecretHash,
+		ExpiresAt:        from.ExpiresAt.Time,
+		RevokedAt:        from.RevokedAt.Time,
+		CreatedAt:        from.CreatedAt,
+	}
+}
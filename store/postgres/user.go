@@ -58,7 +58,7 @@ func (s Store) GetUser(ctx context.Context, id string) (model.User, error) {
 func (s Store) selectUser(ctx context.Context, id string, forUpdate bool, txn *sqlx.Tx) (model.User, error) {
 	var fetchedUser User
 
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "selectUser", func(ctx context.Context) error {
 		if forUpdate {
 			return txn.GetContext(ctx, &fetchedUser, selectUserForUpdateQuery, id)
 		} else {
@@ -91,7 +91,7 @@ func (s Store) CreateUser(ctx context.Context, userToCreate model.User) (model.U
 	}
 
 	var newUser User
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "CreateUser", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &newUser, createUserQuery, userToCreate.Name, userToCreate.Email, marshaledMetadata)
 	})
 
@@ -109,7 +109,7 @@ func (s Store) CreateUser(ctx context.Context, userToCreate model.User) (model.U
 
 func (s Store) ListUsers(ctx context.Context) ([]model.User, error) {
 	var fetchedUsers []User
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListUsers", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedUsers, listUsersQuery)
 	})
 
@@ -146,7 +146,7 @@ func (s Store) GetUsersByIds(ctx context.Context, userIds []string) ([]model.Use
 
 	query = s.DB.Rebind(query)
 
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "GetUsersByIds", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedUsers, query, args...)
 	})
 
@@ -180,7 +180,7 @@ func (s Store) UpdateUser(ctx context.Context, toUpdate model.User) (model.User,
 		return model.User{}, fmt.Errorf("%w: %s", parseErr, err)
 	}
 
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "UpdateUser", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &updatedUser, updateUserQuery, toUpdate.Id, toUpdate.Name, toUpdate.Email, marshaledMetadata)
 	})
 
@@ -203,7 +203,7 @@ func (s Store) GetCurrentUser(ctx context.Context, email string) (model.User, er
 
 func (s Store) getUserWithEmailID(ctx context.Context, email string) (model.User, error) {
 	var userSelf User
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "getUserWithEmailID", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &userSelf, getCurrentUserQuery, email)
 	})
 
@@ -229,7 +229,7 @@ func (s Store) UpdateCurrentUser(ctx context.Context, toUpdate model.User) (mode
 		return model.User{}, fmt.Errorf("%w: %s", parseErr, err)
 	}
 
-	err = s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err = s.DB.WithTimeout(ctx, "UpdateCurrentUser", func(ctx context.Context) error {
 		return s.DB.GetContext(ctx, &updatedUser, updateCurrentUserQuery, toUpdate.Email, toUpdate.Name, marshaledMetadata)
 	})
 
@@ -253,7 +253,7 @@ func (s Store) ListUserGroups(ctx context.Context, userId string, roleId string)
 	}
 
 	var fetchedGroups []Group
-	err := s.DB.WithTimeout(ctx, func(ctx context.Context) error {
+	err := s.DB.WithTimeout(ctx, "ListUserGroups", func(ctx context.Context) error {
 		return s.DB.SelectContext(ctx, &fetchedGroups, listUserGroupsQuery, userId, role)
 	})
 
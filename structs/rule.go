@@ -1,6 +1,7 @@
 package structs
 
 import (
+	"crypto/tls"
 	"net/http"
 	"regexp"
 )
@@ -14,11 +15,21 @@ type Rule struct {
 	Backend     Backend         `yaml:"backend"`
 	Middlewares MiddlewareSpecs `yaml:"middlewares"`
 	Hooks       HookSpecs       `yaml:"hooks"`
+
+	// MiddlewareChain and HookChain run middlewares and hooks of the rule in
+	// the order they are declared, populated while loading rules if a rule
+	// compiler builds them
+	MiddlewareChain http.Handler `yaml:"-"`
+	HookChain       interface{}  `yaml:"-"`
 }
 
 type MiddlewareSpec struct {
 	Name   string                 `yaml:"name"`
 	Config map[string]interface{} `yaml:"config"`
+
+	// Compiled is the decoded and validated Config, populated while loading
+	// rules if a compiler is registered for the middleware
+	Compiled interface{} `yaml:"-"`
 }
 
 type MiddlewareSpecs []MiddlewareSpec
@@ -26,8 +37,20 @@ type MiddlewareSpecs []MiddlewareSpec
 type HookSpec struct {
 	Name   string                 `yaml:"name"`
 	Config map[string]interface{} `yaml:"config"`
+
+	// Compiled is the decoded and validated Config, populated while loading
+	// rules if a compiler is registered for the hook
+	Compiled interface{} `yaml:"-"`
 }
 
+// RuleCompiler compiles what is needed to serve requests matching the rule
+// once while loading rules
+type RuleCompiler func(rule *Rule) error
+
+// ConfigCompiler decodes, validates and compiles a middleware or hook config
+// so it doesn't have to be done on every request
+type ConfigCompiler func(config map[string]interface{}) (interface{}, error)
+
 type HookSpecs []HookSpec
 
 func (m MiddlewareSpecs) Get(name string) (MiddlewareSpec, bool) {
@@ -49,18 +72,83 @@ func (m HookSpecs) Get(name string) (HookSpec, bool) {
 }
 
 type Frontend struct {
+	// Name identifies the frontend in access logs
+	Name string `yaml:"name"`
+
 	URL   string         `yaml:"url"`
 	URLRx *regexp.Regexp `yaml:"-"`
 
 	Method string `yaml:"method"`
+
+	// Body is how the request body is read before middlewares run
+	Body RequestBody `yaml:"body"`
+
+	// GraphQL narrows the rule to graphql operations sent to the path
+	GraphQL GraphQLMatch `yaml:"graphql"`
+}
+
+// GraphQLMatch matches graphql requests by the operation they execute, the
+// body of such rules is always buffered to parse the query document
+type GraphQLMatch struct {
+	// Operation is one of query, mutation or subscription, any if empty
+	Operation string `yaml:"operation"`
+
+	// Fields the operation may select at the top level, an operation
+	// selecting any other field doesn't match. Any if empty.
+	Fields []string `yaml:"fields"`
+}
+
+// Enabled tells if requests are matched by graphql operation
+func (g GraphQLMatch) Enabled() bool {
+	return g.Operation != "" || len(g.Fields) > 0
+}
+
+// RequestBody is streamed to the backend unless a middleware or hook of the
+// rule reads attributes from the payload
+type RequestBody struct {
+	// Buffered is set while loading rules if the payload is read
+	Buffered bool `yaml:"-"`
+
+	// MaxSize of a buffered body in bytes, only the first message of grpc
+	// streams is buffered and bounded
+	MaxSize int64 `yaml:"max_size"`
 }
 
 type Backend struct {
 	URL       string `yaml:"url"`
 	Namespace string `yaml:"namespace"`
 	Prefix    string `yaml:"prefix"`
+
+	// Targets are the endpoints requests are balanced over, URL is the
+	// first of them
+	Targets      []string               `yaml:"targets"`
+	LoadBalancer map[string]interface{} `yaml:"load_balancer"`
+
+	Timeouts       map[string]interface{} `yaml:"timeouts"`
+	Retries        map[string]interface{} `yaml:"retries"`
+	CircuitBreaker map[string]interface{} `yaml:"circuit_breaker"`
+
+	// TLS used to connect to https targets
+	TLS map[string]interface{} `yaml:"tls"`
+
+	// Descriptors resolves message types of grpc methods of the backend so
+	// payload fields can be read by name, populated while loading rules
+	Descriptors interface{} `yaml:"-"`
+
+	// Compiled is the upstream of the backend, populated while loading rules
+	// if a backend compiler is registered
+	Compiled interface{} `yaml:"-"`
 }
 
+// BackendCompiler validates targets, load balancer, timeout, retry, circuit
+// breaker and tls configs of a backend and returns what the proxy uses to
+// send requests to it
+type BackendCompiler func(backend Backend) (interface{}, error)
+
+// BackendTLSCompiler returns the client tls config of a backend from its tls
+// block, nil if the defaults are used
+type BackendTLSCompiler func(config map[string]interface{}) (*tls.Config, error)
+
 type RuleMatcher interface {
 	Match(req *http.Request) (*Rule, error)
 }
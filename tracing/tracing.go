@@ -0,0 +1,240 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/odpf/shield/config"
+	"github.com/odpf/shield/middleware"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	instrumentationName = "github.com/odpf/shield"
+
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// Init sets the global tracer provider exporting spans as configured and
+// propagates W3C trace context, returned func flushes pending spans. Spans
+// aren't recorded and trace context isn't touched if tracing is disabled.
+func Init(ctx context.Context, conf config.TracingConfig) (func(context.Context) error, error) {
+	if !conf.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch conf.Exporter {
+	case ExporterOTLP, "":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(conf.OTLP.Endpoint)}
+		if conf.OTLP.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		err = fmt.Errorf("unknown exporter %q", conf.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tracing: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", conf.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
+
+// Tracer starts spans with the global tracer provider
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// EndSpan marks the span failed if err isn't nil and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// SetHTTPStatus keeps the status code of a response, server errors fail the
+// span
+func SetHTTPStatus(span trace.Span, code int) {
+	span.SetAttributes(attribute.Int("http.status_code", code))
+	if code >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(code))
+	}
+}
+
+// InjectHeaders adds trace context of ctx to outgoing request headers
+func InjectHeaders(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// Proxy starts the server span of requests to a proxy service, continuing
+// the trace of incoming trace context. The span is named after the rule the
+// request matched, read from its access log record.
+func Proxy(service string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+		ctx, span := Tracer().Start(ctx, "proxy "+service,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("shield.service", service),
+				attribute.String("http.method", req.Method),
+				attribute.String("http.target", req.URL.Path),
+			),
+		)
+		defer span.End()
+
+		req = req.WithContext(ctx)
+		record := middleware.EnsureAccessLog(req)
+		writer := middleware.NewResponseWriter(rw)
+
+		next.ServeHTTP(writer, req)
+
+		if record.Rule != "" {
+			span.SetName(fmt.Sprintf("proxy %s %s", service, record.Rule))
+			span.SetAttributes(
+				attribute.String("shield.rule", record.Rule),
+				attribute.String("shield.backend", record.Backend),
+			)
+		}
+		if record.User != "" {
+			span.SetAttributes(attribute.String("enduser.id", record.User))
+		}
+		if record.Decision != "" {
+			span.SetAttributes(attribute.String("authz.decision", record.Decision))
+		}
+		SetHTTPStatus(span, writer.Status())
+	})
+}
+
+// UnaryClientInterceptor starts a client span for every call and propagates
+// its trace context to the server
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := startClientSpan(ctx, method)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		endClientSpan(span, err)
+		return err
+	}
+}
+
+// StreamClientInterceptor starts a client span for every stream, which ends
+// once the stream is read to the end or fails
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := startClientSpan(ctx, method)
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			endClientSpan(span, err)
+			return nil, err
+		}
+		return &tracedStream{ClientStream: stream, span: span}, nil
+	}
+}
+
+func startClientSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	service, method := splitMethod(fullMethod)
+	ctx, span := Tracer().Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		),
+	)
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+func endClientSpan(span trace.Span, err error) {
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+	EndSpan(span, err)
+}
+
+// splitMethod splits /package.Service/Method
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "", fullMethod
+}
+
+type tracedStream struct {
+	grpc.ClientStream
+	span trace.Span
+	once sync.Once
+}
+
+func (s *tracedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(func() {
+			if errors.Is(err, io.EOF) {
+				endClientSpan(s.span, nil)
+				return
+			}
+			endClientSpan(s.span, err)
+		})
+	}
+	return err
+}
+
+// metadataCarrier injects trace context into grpc metadata
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
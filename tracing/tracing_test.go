@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/odpf/shield/middleware"
+	"github.com/odpf/shield/structs"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const incomingTraceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func setupExporter() *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return exporter
+}
+
+func attributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	values := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes {
+		values[attr.Key] = attr.Value
+	}
+	return values
+}
+
+func TestProxy(t *testing.T) {
+	exporter := setupExporter()
+
+	handler := Proxy("library", http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		middleware.ExtractAccessLog(req).MatchRule(&structs.Rule{
+			Frontend: structs.Frontend{Name: "list books"},
+			Backend:  structs.Backend{Namespace: "library"},
+		})
+		middleware.ExtractAccessLog(req).Deny("no action allowed on resource b1", nil)
+		assert.True(t, trace.SpanContextFromContext(req.Context()).IsValid())
+		rw.WriteHeader(http.StatusUnauthorized)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/api/books", nil)
+	req.Header.Set("traceparent", incomingTraceparent)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "proxy library list books", span.Name)
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent.SpanID().String())
+	attrs := attributes(span)
+	assert.Equal(t, "library", attrs["shield.backend"].AsString())
+	assert.Equal(t, middleware.DecisionDeny, attrs["authz.decision"].AsString())
+	assert.Equal(t, int64(http.StatusUnauthorized), attrs["http.status_code"].AsInt64())
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	exporter := setupExporter()
+
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return status.Error(grpccodes.Unavailable, "spicedb unavailable")
+	}
+	err := UnaryClientInterceptor()(context.Background(), "/authzed.api.v1.PermissionsService/CheckPermission", nil, nil, nil, invoker)
+	assert.Error(t, err)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "authzed.api.v1.PermissionsService/CheckPermission", span.Name)
+	assert.Equal(t, codes.Error, span.Status.Code)
+	assert.Equal(t, "CheckPermission", attributes(span)["rpc.method"].AsString())
+	assert.Equal(t, "Unavailable", attributes(span)["rpc.grpc.status_code"].AsString())
+
+	// the server continues the trace from the client span
+	traceparent := outgoing.Get("traceparent")
+	assert.Len(t, traceparent, 1)
+	assert.Contains(t, traceparent[0], span.SpanContext.SpanID().String())
+}